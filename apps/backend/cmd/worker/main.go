@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/smart-hmm/smart-hmm/internal/app"
 	"github.com/smart-hmm/smart-hmm/internal/config"
@@ -41,15 +42,86 @@ func main() {
 
 	queue := container.Infrastructures.QueueService
 
-	emailWorker := worker.NewSendEmailWorker(container.Infrastructures.MailService)
-	opts := queueports.ConsumeOptions{
-		Prefetch:    20,
-		Concurrency: 5,
-		RetryLimit:  5,
+	// Each topic has its own options. Jobs that are not safe to run twice
+	// at once for a tenant take one message at a time.
+	consumers := []struct {
+		topic   string
+		handler queueports.HandlerFunc
+		opts    queueports.ConsumeOptions
+	}{
+		{
+			topic:   worker.SendEmailTopic,
+			handler: worker.NewSendEmailWorker(container.Infrastructures.MailService).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 20, Concurrency: 5, RetryLimit: 5},
+		},
+		{
+			topic:   worker.CalculatePayrollRunTopic,
+			handler: worker.NewCalculatePayrollRunWorker(container.Usecases.CalculatePayrollRun).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 1, Concurrency: 1, RetryLimit: 3},
+		},
+		{
+			// Payslips of different runs render independently.
+			topic:   worker.GeneratePayslipsTopic,
+			handler: worker.NewGeneratePayslipsWorker(container.Usecases.GeneratePayslips).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 2, Concurrency: 2, RetryLimit: 3},
+		},
+		{
+			// Certificate batches render independently.
+			topic:   worker.GenerateTaxCertificatesTopic,
+			handler: worker.NewGenerateTaxCertificatesWorker(container.Usecases.GenerateTaxCertificates).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 2, Concurrency: 2, RetryLimit: 3},
+		},
+		{
+			topic:   worker.SendContractRemindersTopic,
+			handler: worker.NewSendContractRemindersWorker(container.Usecases.SendContractReminders).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 1, Concurrency: 1, RetryLimit: 3},
+		},
+		{
+			topic:   worker.ImportEmployeesTopic,
+			handler: worker.NewImportEmployeesWorker(container.Usecases.RunEmployeeImport).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 1, Concurrency: 1, RetryLimit: 1},
+		},
+		{
+			// Exports only read and write their own file.
+			topic:   worker.ExportEmployeesTopic,
+			handler: worker.NewExportEmployeesWorker(container.Usecases.RunEmployeeExport).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 2, Concurrency: 2, RetryLimit: 3},
+		},
+		{
+			topic:   worker.RevokeOffboardedSessionsTopic,
+			handler: worker.NewRevokeOffboardedSessionsWorker(container.Usecases.RevokeOffboardedSessions).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 1, Concurrency: 1, RetryLimit: 3},
+		},
+		{
+			topic:   worker.IssueProbationEvaluationsTopic,
+			handler: worker.NewIssueProbationEvaluationsWorker(container.Usecases.IssueProbationEvaluations).Handle,
+			opts:    queueports.ConsumeOptions{Prefetch: 1, Concurrency: 1, RetryLimit: 3},
+		},
 	}
 
 	slog.Info("Consuming with workers...")
-	queue.ConsumeWithWorkers(ctx, worker.SendEmailTopic, emailWorker.Handle, opts)
+	for _, c := range consumers {
+		if err := queue.ConsumeWithWorkers(ctx, c.topic, c.handler, c.opts); err != nil {
+			slog.Fatalf("consume %s: %v", c.topic, err)
+		}
+	}
+
+	go worker.ScheduleContractReminders(
+		ctx,
+		queue,
+		time.Duration(cfg.ContractReminder.IntervalHours)*time.Hour,
+		cfg.ContractReminder.DaysBefore,
+	)
+	go worker.ScheduleSessionRevocations(
+		ctx,
+		queue,
+		time.Duration(cfg.Offboarding.IntervalHours)*time.Hour,
+	)
+	go worker.ScheduleProbationEvaluations(
+		ctx,
+		queue,
+		time.Duration(cfg.Probation.IntervalHours)*time.Hour,
+	)
 
 	<-ctx.Done()
 	log.Println("worker exited safely")
@@ -17,24 +17,41 @@ type Container struct {
 
 func buildRouter(handlers Handlers, infras *Infrastructures) *chi.Mux {
 	return httprouter.GetRouter(httprouter.Args{
-		UserHandler:           handlers.User,
-		AttendanceHandler:     handlers.Attendance,
-		PayrollHandler:        handlers.Payroll,
-		DepartmentHandler:     handlers.Department,
-		EmployeeHandler:       handlers.Employee,
-		EmailTemplateHandler:  handlers.EmailTemplate,
-		LeaveRequestHandler:   handlers.LeaveRequest,
-		LeaveTypeHandler:      handlers.LeaveType,
-		SystemSettingsHandler: handlers.SystemSettings,
-		UserSettingsHandler:   handlers.UserSettings,
-		AuthHandler:           handlers.Auth,
-		UploadHandler:         handlers.Upload,
-		FileHandler:           handlers.File,
-		DocumentHandler:       handlers.Document,
-		AIHandler:             handlers.AI,
-		TokenService:          infras.TokenService,
-		TenantHandler:         handlers.Tenant,
-		MetadataHandler:       handlers.Metadata,
+		UserHandler:             handlers.User,
+		AttendanceHandler:       handlers.Attendance,
+		PayrollHandler:          handlers.Payroll,
+		BankAccountHandler:      handlers.BankAccount,
+		CompensationHandler:     handlers.Compensation,
+		EmploymentHandler:       handlers.Employment,
+		ContractHandler:         handlers.Contract,
+		OffboardingHandler:      handlers.Offboarding,
+		OnboardingHandler:       handlers.Onboarding,
+		ProbationHandler:        handlers.Probation,
+		OrgChartHandler:         handlers.OrgChart,
+		PositionHandler:         handlers.Position,
+		ProfileChangeHandler:    handlers.ProfileChange,
+		DependentHandler:        handlers.Dependent,
+		EmergencyContactHandler: handlers.EmergencyContact,
+		EmployeeImportHandler:   handlers.EmployeeImport,
+		EmployeeExportHandler:   handlers.EmployeeExport,
+		CustomFieldHandler:      handlers.CustomField,
+		SalaryComponentHandler:  handlers.SalaryComponent,
+		StatutoryHandler:        handlers.Statutory,
+		DepartmentHandler:       handlers.Department,
+		EmployeeHandler:         handlers.Employee,
+		EmailTemplateHandler:    handlers.EmailTemplate,
+		LeaveRequestHandler:     handlers.LeaveRequest,
+		LeaveTypeHandler:        handlers.LeaveType,
+		SystemSettingsHandler:   handlers.SystemSettings,
+		UserSettingsHandler:     handlers.UserSettings,
+		AuthHandler:             handlers.Auth,
+		UploadHandler:           handlers.Upload,
+		FileHandler:             handlers.File,
+		DocumentHandler:         handlers.Document,
+		AIHandler:               handlers.AI,
+		TokenService:            infras.TokenService,
+		TenantHandler:           handlers.Tenant,
+		MetadataHandler:         handlers.Metadata,
 	})
 }
 
@@ -4,15 +4,32 @@ import (
 	aihandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/ai"
 	attendancehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/attendance"
 	authhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/auth"
+	bankaccounthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/bank_account"
+	compensationhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/compensation"
+	contracthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/contract"
+	customfieldhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/custom_field"
 	departmenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/department"
+	dependenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/dependent"
 	documenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/document"
 	emailtemplatehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/email_template"
+	emergencycontacthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/emergency_contact"
 	employeehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee"
+	employeeexporthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee_export"
+	employeeimporthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee_import"
+	employmenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employment"
 	filehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/file"
 	leaverequesthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/leave_request"
 	leavetypehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/leave_type"
 	metadatahandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/metadata"
+	offboardinghandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/offboarding"
+	onboardinghandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/onboarding"
+	orgcharthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/org_chart"
 	payrollhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/payroll"
+	positionhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/position"
+	probationhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/probation"
+	profilechangehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/profile_change"
+	salarycomponenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/salary_component"
+	statutoryhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/statutory"
 	systemsettingshandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/system_settings"
 	tenanthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/tenant"
 	uploadhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/upload"
@@ -21,32 +38,180 @@ import (
 )
 
 type Handlers struct {
-	User           *userhandler.UserHandler
-	Attendance     *attendancehandler.AttendanceHandler
-	Payroll        *payrollhandler.PayrollHandler
-	Department     *departmenthandler.DepartmentHandler
-	Employee       *employeehandler.EmployeeHandler
-	EmailTemplate  *emailtemplatehandler.EmailTemplateHandler
-	LeaveRequest   *leaverequesthandler.LeaveRequestHandler
-	LeaveType      *leavetypehandler.LeaveTypeHandler
-	SystemSettings *systemsettingshandler.SystemSettingsHandler
-	UserSettings   *usersettingshandler.UserSettingsHandler
-	Auth           *authhandler.AuthHandler
-	Upload         *uploadhandler.UploadHandler
-	File           *filehandler.FileHandler
-	Document       *documenthandler.DocumentHandler
-	AI             *aihandler.AIHandler
-	Tenant         *tenanthandler.TenantHandler
-	Metadata       *metadatahandler.MetadataHandler
+	User             *userhandler.UserHandler
+	Attendance       *attendancehandler.AttendanceHandler
+	Payroll          *payrollhandler.PayrollHandler
+	BankAccount      *bankaccounthandler.BankAccountHandler
+	Compensation     *compensationhandler.CompensationHandler
+	Employment       *employmenthandler.EmploymentHandler
+	Contract         *contracthandler.ContractHandler
+	Offboarding      *offboardinghandler.OffboardingHandler
+	Onboarding       *onboardinghandler.OnboardingHandler
+	Probation        *probationhandler.ProbationHandler
+	OrgChart         *orgcharthandler.OrgChartHandler
+	Position         *positionhandler.PositionHandler
+	ProfileChange    *profilechangehandler.ProfileChangeHandler
+	Dependent        *dependenthandler.DependentHandler
+	EmergencyContact *emergencycontacthandler.EmergencyContactHandler
+	EmployeeImport   *employeeimporthandler.EmployeeImportHandler
+	EmployeeExport   *employeeexporthandler.EmployeeExportHandler
+	CustomField      *customfieldhandler.CustomFieldHandler
+	SalaryComponent  *salarycomponenthandler.SalaryComponentHandler
+	Statutory        *statutoryhandler.StatutoryHandler
+	Department       *departmenthandler.DepartmentHandler
+	Employee         *employeehandler.EmployeeHandler
+	EmailTemplate    *emailtemplatehandler.EmailTemplateHandler
+	LeaveRequest     *leaverequesthandler.LeaveRequestHandler
+	LeaveType        *leavetypehandler.LeaveTypeHandler
+	SystemSettings   *systemsettingshandler.SystemSettingsHandler
+	UserSettings     *usersettingshandler.UserSettingsHandler
+	Auth             *authhandler.AuthHandler
+	Upload           *uploadhandler.UploadHandler
+	File             *filehandler.FileHandler
+	Document         *documenthandler.DocumentHandler
+	AI               *aihandler.AIHandler
+	Tenant           *tenanthandler.TenantHandler
+	Metadata         *metadatahandler.MetadataHandler
 }
 
 func buildHandlers(uc Usecases, repo Repositories) Handlers {
 	return Handlers{
 		User:       userhandler.NewUserHandler(uc.RegisterUserUsecase, repo.User),
 		Attendance: attendancehandler.NewAttendanceHandler(uc.ClockIn, uc.ClockOut, repo.Attendance),
-		Payroll:    payrollhandler.NewPayrollHandler(uc.GeneratePayroll, repo.Payroll),
-		Department: departmenthandler.NewDepartmentHandler(uc.CreateDepartment, uc.UpdateDepartment, repo.Department),
-		Employee:   employeehandler.NewEmployeeHandler(uc.CreateEmployee, uc.UpdateEmployee, uc.OnboardEmployee, repo.Employee),
+		Payroll: payrollhandler.NewPayrollHandler(
+			uc.CreatePayrollRun,
+			uc.RecalculatePayrollRun,
+			uc.ApprovePayrollRun,
+			uc.MarkPayrollRunPaid,
+			uc.RegeneratePayslips,
+			uc.GetPayslipDownloadURL,
+			uc.ListMyPayslips,
+			uc.UpdatePayslipPreference,
+			uc.ExportBankFile,
+			uc.ComputeRetroAdjustments,
+			uc.GetTaxYear,
+			uc.ExportTaxDeclaration,
+			uc.RequestTaxCertificates,
+			uc.ListTaxCertificates,
+			uc.GetTaxCertificateDownloadURL,
+			uc.GetPayrollRecord,
+			uc.ListEmployeePayrollRecords,
+			uc.ListPeriodPayrollRecords,
+			uc.ListRunRecords,
+			repo.PayrollRun,
+			repo.Adjustment,
+		),
+		BankAccount: bankaccounthandler.NewBankAccountHandler(
+			uc.CreateBankAccount,
+			uc.UpdateBankAccount,
+			uc.DeleteBankAccount,
+			uc.ListBankAccounts,
+		),
+		Compensation: compensationhandler.NewCompensationHandler(
+			uc.RecordSalaryChange,
+			uc.ListSalaryHistory,
+		),
+		Employment: employmenthandler.NewEmploymentHandler(
+			uc.ChangeJob,
+			uc.ListEmploymentHistory,
+			uc.ListDepartmentMembersOn,
+			uc.ListJobChanges,
+		),
+		Contract: contracthandler.NewContractHandler(
+			uc.CreateContract,
+			uc.UpdateContract,
+			uc.DeleteContract,
+			uc.RenewContract,
+			uc.ListContracts,
+			uc.ListExpiringContracts,
+		),
+		Offboarding: offboardinghandler.NewOffboardingHandler(
+			uc.StartOffboarding,
+			uc.GetOffboarding,
+			uc.GetEmployeeOffboarding,
+			uc.ListOffboardings,
+			uc.CompleteOffboardingTask,
+		),
+		Onboarding: onboardinghandler.NewOnboardingHandler(
+			uc.CreateOnboardingTemplate,
+			uc.UpdateOnboardingTemplate,
+			uc.DeleteOnboardingTemplate,
+			uc.ListOnboardingTemplates,
+			uc.ListOnboardingTasks,
+			uc.ListMyOnboardingTasks,
+			uc.CompleteOnboardingTask,
+			uc.GetEmployeeOnboarding,
+		),
+		Probation: probationhandler.NewProbationHandler(
+			uc.GetProbationDashboard,
+			uc.ListProbationEvaluations,
+			uc.ListMyProbationEvaluations,
+			uc.GetProbationEvaluation,
+			uc.DecideProbationEvaluation,
+		),
+		OrgChart: orgcharthandler.NewOrgChartHandler(uc.GetOrgChart),
+		Position: positionhandler.NewPositionHandler(
+			uc.CreatePosition,
+			uc.UpdatePosition,
+			uc.GetPosition,
+			uc.ListPositions,
+			uc.FillPosition,
+			uc.VacatePosition,
+			uc.FreezePosition,
+			uc.GetHeadcountReport,
+		),
+		ProfileChange: profilechangehandler.NewProfileChangeHandler(
+			uc.SubmitProfileChanges,
+			uc.ListProfileChanges,
+			uc.ListMyProfileChanges,
+			uc.GetProfileChange,
+			uc.ReviewProfileChange,
+			uc.CancelProfileChange,
+		),
+		Dependent: dependenthandler.NewDependentHandler(
+			uc.CreateDependent,
+			uc.UpdateDependent,
+			uc.DeleteDependent,
+			uc.ListDependents,
+		),
+		EmergencyContact: emergencycontacthandler.NewEmergencyContactHandler(
+			uc.CreateEmergencyContact,
+			uc.UpdateEmergencyContact,
+			uc.DeleteEmergencyContact,
+			uc.ListEmergencyContacts,
+			uc.ListMyEmergencyContacts,
+			uc.CreateMyEmergencyContact,
+			uc.UpdateMyEmergencyContact,
+			uc.DeleteMyEmergencyContact,
+		),
+		EmployeeImport: employeeimporthandler.NewEmployeeImportHandler(
+			uc.DryRunEmployeeImport,
+			uc.RequestEmployeeImport,
+			uc.GetEmployeeImport,
+			uc.ListEmployeeImports,
+		),
+		CustomField: customfieldhandler.NewCustomFieldHandler(
+			uc.CreateCustomField,
+			uc.UpdateCustomField,
+			uc.DeleteCustomField,
+			uc.ListCustomFields,
+			uc.VisibleCustomFields,
+		),
+		EmployeeExport: employeeexporthandler.NewEmployeeExportHandler(
+			uc.ExportEmployees,
+			uc.GetEmployeeExport,
+			uc.ListEmployeeExports,
+		),
+		SalaryComponent: salarycomponenthandler.NewSalaryComponentHandler(
+			uc.CreateSalaryComponent,
+			uc.UpdateSalaryComponent,
+			uc.DeleteSalaryComponent,
+			uc.GetSalaryComponent,
+			uc.ListSalaryComponents,
+		),
+		Statutory:  statutoryhandler.NewStatutoryHandler(uc.GetStatutoryProfile, uc.UpdateStatutoryProfile),
+		Department: departmenthandler.NewDepartmentHandler(uc.CreateDepartment, uc.UpdateDepartment, uc.MoveDepartment, uc.GetDepartmentTree, repo.Department),
+		Employee:   employeehandler.NewEmployeeHandler(uc.CreateEmployee, uc.UpdateEmployee, uc.OnboardEmployee, uc.VisibleCustomFields, uc.SearchEmployees, uc.SuggestEmployees, repo.Employee),
 		EmailTemplate: emailtemplatehandler.NewEmailTemplateHandler(
 			uc.CreateTemplate,
 			uc.CreateTemplateVersion,
@@ -10,6 +10,7 @@ import (
 	"github.com/smart-hmm/smart-hmm/internal/infrastructure/database"
 	"github.com/smart-hmm/smart-hmm/internal/infrastructure/llm"
 	resendmail "github.com/smart-hmm/smart-hmm/internal/infrastructure/mail/resend"
+	fpdfrenderer "github.com/smart-hmm/smart-hmm/internal/infrastructure/pdf/fpdf"
 	rabbitmqqueue "github.com/smart-hmm/smart-hmm/internal/infrastructure/queue/rabbitmq"
 	s3storage "github.com/smart-hmm/smart-hmm/internal/infrastructure/storage/s3"
 	jwtservice "github.com/smart-hmm/smart-hmm/internal/infrastructure/token/jwt"
@@ -17,7 +18,9 @@ import (
 	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
 	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
 	tokenports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/token"
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
 	"github.com/smart-hmm/smart-hmm/internal/pkg/logger"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/secret"
 )
 
 type Infrastructures struct {
@@ -28,6 +31,11 @@ type Infrastructures struct {
 	TokenService   tokenports.Service
 	StorageService storageports.StorageService
 
+	PayslipRenderer        payrolldomain.PayslipRenderer
+	TaxCertificateRenderer payrolldomain.TaxCertificateRenderer
+
+	Cipher *secret.Cipher
+
 	Redis *rediscache.RedisService
 
 	OllamaClient *llm.OllamaClient
@@ -76,6 +84,11 @@ func buildInfrastructures(ctx context.Context, cfg *config.Config) (*Infrastruct
 		cfg.S3.PublicURL,
 	)
 
+	cipher, err := secret.NewCipher(cfg.Secrets.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
 	ollamaClient := llm.NewOllamaClient("http://localhost:11434", "gemma2:9b", "nomic-embed-text")
 
 	infras := &Infrastructures{
@@ -86,6 +99,11 @@ func buildInfrastructures(ctx context.Context, cfg *config.Config) (*Infrastruct
 		TokenService:   tokenSvc,
 		StorageService: s3Storage,
 		OllamaClient:   ollamaClient,
+
+		PayslipRenderer:        fpdfrenderer.NewPayslipRenderer(cfg.Payslip.FontPath),
+		TaxCertificateRenderer: fpdfrenderer.NewTaxCertificateRenderer(cfg.Payslip.FontPath),
+
+		Cipher: cipher,
 	}
 
 	if infras.QueueService == nil {
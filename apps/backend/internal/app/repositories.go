@@ -4,63 +4,130 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	pgrepository "github.com/smart-hmm/smart-hmm/internal/infrastructure/repository/pg"
 	attendancerepository "github.com/smart-hmm/smart-hmm/internal/modules/attendance/repository"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
 	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
 	documentrepository "github.com/smart-hmm/smart-hmm/internal/modules/document/repository"
 	emailtemplaterepository "github.com/smart-hmm/smart-hmm/internal/modules/email_template/repository"
+	emergencycontactrepository "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/repository"
 	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
 	filerepository "github.com/smart-hmm/smart-hmm/internal/modules/file/repository"
 	leaverepository "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/repository"
 	leaverepositorytype "github.com/smart-hmm/smart-hmm/internal/modules/leave_type/repository"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
 	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
 	refreshtokenrepository "github.com/smart-hmm/smart-hmm/internal/modules/refresh_token/repository"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
 	systemsettingrepository "github.com/smart-hmm/smart-hmm/internal/modules/system/repository"
 	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
 	tenantmemberrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_member/repository"
 	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
 	usersettingrepository "github.com/smart-hmm/smart-hmm/internal/modules/user-setting/repository"
 	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/secret"
 )
 
 type Repositories struct {
-	Attendance     attendancerepository.AttendanceRepository
-	Payroll        payrollrepository.PayrollRepository
-	Department     departmentrepository.DepartmentRepository
-	Employee       employeerepository.EmployeeRepository
-	LeaveRequest   leaverepository.LeaveRequestRepository
-	LeaveType      leaverepositorytype.LeaveTypeRepository
-	EmailTemplate  emailtemplaterepository.EmailTemplateRepository
-	SystemSettings systemsettingrepository.SystemSettingRepository
-	UserSettings   usersettingrepository.UserSettingRepository
-	User           userrepository.UserRepository
-	RefreshToken   refreshtokenrepository.RefreshTokenRepository
-	File           filerepository.FileRepository
-	Document       documentrepository.DocumentRepository
-	Tenant         tenantrepository.TenantRepository
-	TenantMember   tenantmemberrepository.TenantMemberRepository
-	TenantProfile  tenantprofilerepository.TenantProfileRepository
+	Attendance          attendancerepository.AttendanceRepository
+	Payroll             payrollrepository.PayrollRepository
+	PayrollRun          payrollrepository.PayrollRunRepository
+	Payslip             payrollrepository.PayslipRepository
+	PayslipPref         payrollrepository.PayslipPreferenceRepository
+	BankExport          payrollrepository.BankExportRepository
+	TaxCertificate      payrollrepository.TaxCertificateRepository
+	Adjustment          payrollrepository.AdjustmentRepository
+	BankAccount         bankaccountrepository.BankAccountRepository
+	SalaryComponent     salarycomponentrepository.SalaryComponentRepository
+	StatutoryProfile    statutoryrepository.EmployeeProfileRepository
+	Department          departmentrepository.DepartmentRepository
+	Employee            employeerepository.EmployeeRepository
+	SalaryChange        compensationrepository.SalaryChangeRepository
+	JobRecord           employmentrepository.JobRecordRepository
+	Contract            contractrepository.ContractRepository
+	Offboarding         offboardingrepository.OffboardingRepository
+	OnboardingTemplate  onboardingrepository.TemplateRepository
+	OnboardingTask      onboardingrepository.TaskRepository
+	ProbationEvaluation probationrepository.EvaluationRepository
+	Position            positionrepository.PositionRepository
+	ProfileChange       profilechangerepository.ChangeRequestRepository
+	Dependent           dependentrepository.DependentRepository
+	EmergencyContact    emergencycontactrepository.EmergencyContactRepository
+	CustomField         customfieldrepository.DefinitionRepository
+	EmployeeImport      employeeimportrepository.ImportRepository
+	EmployeeExport      employeeexportrepository.ExportRepository
+	LeaveRequest        leaverepository.LeaveRequestRepository
+	LeaveType           leaverepositorytype.LeaveTypeRepository
+	EmailTemplate       emailtemplaterepository.EmailTemplateRepository
+	SystemSettings      systemsettingrepository.SystemSettingRepository
+	UserSettings        usersettingrepository.UserSettingRepository
+	User                userrepository.UserRepository
+	RefreshToken        refreshtokenrepository.RefreshTokenRepository
+	File                filerepository.FileRepository
+	Document            documentrepository.DocumentRepository
+	Tenant              tenantrepository.TenantRepository
+	TenantMember        tenantmemberrepository.TenantMemberRepository
+	TenantProfile       tenantprofilerepository.TenantProfileRepository
 }
 
-func buildRepositories(pool *pgxpool.Pool) Repositories {
+func buildRepositories(pool *pgxpool.Pool, cipher *secret.Cipher) Repositories {
 	return Repositories{
-		Attendance:     pgrepository.NewAttendancePostgresRepository(pool),
-		Payroll:        pgrepository.NewPayrollPostgresRepository(pool),
-		Department:     pgrepository.NewDepartmentPostgresRepository(pool),
-		Employee:       pgrepository.NewEmployeePostgresRepository(pool),
-		LeaveRequest:   pgrepository.NewLeaveRequestPostgresRepository(pool),
-		LeaveType:      pgrepository.NewLeaveTypePostgresRepository(pool),
-		EmailTemplate:  pgrepository.NewEmailTemplatePostgresRepository(pool),
-		SystemSettings: pgrepository.NewSystemSettingPostgresRepository(pool),
-		UserSettings:   pgrepository.NewUserSettingPostgresRepository(pool),
-		User:           pgrepository.NewUserPostgresRepository(pool),
-		RefreshToken:   pgrepository.NewRefreshTokenPostgresRepository(pool),
-		File:           pgrepository.NewFilePostgresRepository(pool),
-		Document:       pgrepository.NewDocumentPostgresRepository(pool),
-		Tenant:         pgrepository.NewTenantPostgresRepository(pool),
-		TenantMember:   pgrepository.NewTenantMemberPostgresRepository(pool),
-		TenantProfile:  pgrepository.NewTenantProfilePostgresRepository(pool),
+		Attendance:          pgrepository.NewAttendancePostgresRepository(pool),
+		Payroll:             pgrepository.NewPayrollPostgresRepository(pool),
+		PayrollRun:          pgrepository.NewPayrollRunPostgresRepository(pool),
+		Payslip:             pgrepository.NewPayslipPostgresRepository(pool),
+		PayslipPref:         pgrepository.NewPayslipPreferencePostgresRepository(pool),
+		BankExport:          pgrepository.NewPayrollBankExportPostgresRepository(pool),
+		TaxCertificate:      pgrepository.NewTaxCertificatePostgresRepository(pool),
+		Adjustment:          pgrepository.NewPayrollAdjustmentPostgresRepository(pool),
+		BankAccount:         pgrepository.NewBankAccountPostgresRepository(pool, cipher),
+		SalaryComponent:     pgrepository.NewSalaryComponentPostgresRepository(pool),
+		StatutoryProfile:    pgrepository.NewEmployeeStatutoryProfilePostgresRepository(pool),
+		Department:          pgrepository.NewDepartmentPostgresRepository(pool),
+		Employee:            pgrepository.NewEmployeePostgresRepository(pool),
+		SalaryChange:        pgrepository.NewSalaryChangePostgresRepository(pool),
+		JobRecord:           pgrepository.NewJobRecordPostgresRepository(pool),
+		Contract:            pgrepository.NewContractPostgresRepository(pool),
+		Offboarding:         pgrepository.NewOffboardingPostgresRepository(pool),
+		OnboardingTemplate:  pgrepository.NewOnboardingTemplatePostgresRepository(pool),
+		OnboardingTask:      pgrepository.NewOnboardingTaskPostgresRepository(pool),
+		ProbationEvaluation: pgrepository.NewProbationEvaluationPostgresRepository(pool),
+		Position:            pgrepository.NewPositionPostgresRepository(pool),
+		ProfileChange:       pgrepository.NewProfileChangePostgresRepository(pool, cipher),
+		Dependent:           pgrepository.NewDependentPostgresRepository(pool),
+		EmergencyContact:    pgrepository.NewEmergencyContactPostgresRepository(pool),
+		CustomField:         pgrepository.NewCustomFieldPostgresRepository(pool),
+		EmployeeImport:      pgrepository.NewEmployeeImportPostgresRepository(pool),
+		EmployeeExport:      pgrepository.NewEmployeeExportPostgresRepository(pool),
+		LeaveRequest:        pgrepository.NewLeaveRequestPostgresRepository(pool),
+		LeaveType:           pgrepository.NewLeaveTypePostgresRepository(pool),
+		EmailTemplate:       pgrepository.NewEmailTemplatePostgresRepository(pool),
+		SystemSettings:      pgrepository.NewSystemSettingPostgresRepository(pool),
+		UserSettings:        pgrepository.NewUserSettingPostgresRepository(pool),
+		User:                pgrepository.NewUserPostgresRepository(pool),
+		RefreshToken:        pgrepository.NewRefreshTokenPostgresRepository(pool),
+		File:                pgrepository.NewFilePostgresRepository(pool),
+		Document:            pgrepository.NewDocumentPostgresRepository(pool),
+		Tenant:              pgrepository.NewTenantPostgresRepository(pool),
+		TenantMember:        pgrepository.NewTenantMemberPostgresRepository(pool),
+		TenantProfile:       pgrepository.NewTenantProfilePostgresRepository(pool),
 	}
 }
 
 func provideDBPool(infras *Infrastructures) *pgxpool.Pool {
 	return infras.DB
 }
+
+func provideCipher(infras *Infrastructures) *secret.Cipher {
+	return infras.Cipher
+}
@@ -5,16 +5,38 @@ import (
 	aiusecase "github.com/smart-hmm/smart-hmm/internal/modules/ai/usecase"
 	attendanceusecase "github.com/smart-hmm/smart-hmm/internal/modules/attendance/usecase"
 	authusecase "github.com/smart-hmm/smart-hmm/internal/modules/auth/usecase"
+	bankaccountusecase "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/usecase"
+	compensationusecase "github.com/smart-hmm/smart-hmm/internal/modules/compensation/usecase"
+	contractusecase "github.com/smart-hmm/smart-hmm/internal/modules/contract/usecase"
+	customfieldusecase "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/usecase"
 	departmentusecase "github.com/smart-hmm/smart-hmm/internal/modules/department/usecase"
+	dependentusecase "github.com/smart-hmm/smart-hmm/internal/modules/dependent/usecase"
 	documentusecase "github.com/smart-hmm/smart-hmm/internal/modules/document/usecase"
 	emailtemplateusecase "github.com/smart-hmm/smart-hmm/internal/modules/email_template/usecase"
+	emergencycontactusecase "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/usecase"
 	employeeusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee/usecase"
+	employeeexportusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/usecase"
+	employeeimportusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/usecase"
+	employmentusecase "github.com/smart-hmm/smart-hmm/internal/modules/employment/usecase"
 	fileusecase "github.com/smart-hmm/smart-hmm/internal/modules/file/usecase"
 	leaverequestusecase "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/usecase"
 	leavetypeusecase "github.com/smart-hmm/smart-hmm/internal/modules/leave_type/usecase"
 	metadatausecase "github.com/smart-hmm/smart-hmm/internal/modules/metadata/usecase"
+	offboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/usecase"
+	onboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/usecase"
+	orgchartusecase "github.com/smart-hmm/smart-hmm/internal/modules/org_chart/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/bankfile"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/taxfile"
 	payrollusecase "github.com/smart-hmm/smart-hmm/internal/modules/payroll/usecase"
+	positionusecase "github.com/smart-hmm/smart-hmm/internal/modules/position/usecase"
+	probationusecase "github.com/smart-hmm/smart-hmm/internal/modules/probation/usecase"
+	profilechangeusecase "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/usecase"
 	refreshtokenusecase "github.com/smart-hmm/smart-hmm/internal/modules/refresh_token/usecase"
+	salarycomponentusecase "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/usecase"
+	statutoryrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules"
+	thrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules/th"
+	vnrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules/vn"
+	statutoryusecase "github.com/smart-hmm/smart-hmm/internal/modules/statutory/usecase"
 	storageusecase "github.com/smart-hmm/smart-hmm/internal/modules/storage/usecase"
 	systemsettingsusecase "github.com/smart-hmm/smart-hmm/internal/modules/system/usecase"
 	tenantusecase "github.com/smart-hmm/smart-hmm/internal/modules/tenant/usecase"
@@ -27,12 +49,121 @@ import (
 type Usecases struct {
 	ClockIn                      *attendanceusecase.ClockInUsecase
 	ClockOut                     *attendanceusecase.ClockOutUsecase
-	GeneratePayroll              *payrollusecase.GeneratePayrollUsecase
+	CreatePayrollRun             *payrollusecase.CreatePayrollRunUsecase
+	CalculatePayrollRun          *payrollusecase.CalculatePayrollRunUsecase
+	RecalculatePayrollRun        *payrollusecase.RecalculatePayrollRunUsecase
+	ApprovePayrollRun            *payrollusecase.ApprovePayrollRunUsecase
+	MarkPayrollRunPaid           *payrollusecase.MarkPayrollRunPaidUsecase
+	GeneratePayslips             *payrollusecase.GeneratePayslipsUsecase
+	RegeneratePayslips           *payrollusecase.RegeneratePayslipsUsecase
+	GetPayslipDownloadURL        *payrollusecase.GetPayslipDownloadURLUsecase
+	ListMyPayslips               *payrollusecase.ListMyPayslipsUsecase
+	GetPayrollRecord             *payrollusecase.GetPayrollRecordUsecase
+	ListEmployeePayrollRecords   *payrollusecase.ListEmployeePayrollRecordsUsecase
+	ListPeriodPayrollRecords     *payrollusecase.ListPeriodPayrollRecordsUsecase
+	ListRunRecords               *payrollusecase.ListRunRecordsUsecase
+	UpdatePayslipPreference      *payrollusecase.UpdatePayslipPreferenceUsecase
+	ExportBankFile               *payrollusecase.ExportBankFileUsecase
+	ComputeRetroAdjustments      *payrollusecase.ComputeRetroAdjustmentsUsecase
+	GetTaxYear                   *payrollusecase.GetTaxYearUsecase
+	ExportTaxDeclaration         *payrollusecase.ExportTaxDeclarationUsecase
+	RequestTaxCertificates       *payrollusecase.RequestTaxCertificatesUsecase
+	GenerateTaxCertificates      *payrollusecase.GenerateTaxCertificatesUsecase
+	ListTaxCertificates          *payrollusecase.ListTaxCertificatesUsecase
+	GetTaxCertificateDownloadURL *payrollusecase.GetTaxCertificateDownloadURLUsecase
+	CreateBankAccount            *bankaccountusecase.CreateBankAccountUsecase
+	UpdateBankAccount            *bankaccountusecase.UpdateBankAccountUsecase
+	DeleteBankAccount            *bankaccountusecase.DeleteBankAccountUsecase
+	ListBankAccounts             *bankaccountusecase.ListBankAccountsUsecase
+	RecordSalaryChange           *compensationusecase.RecordSalaryChangeUsecase
+	ListSalaryHistory            *compensationusecase.ListSalaryHistoryUsecase
+	ChangeJob                    *employmentusecase.ChangeJobUsecase
+	ListEmploymentHistory        *employmentusecase.ListEmploymentHistoryUsecase
+	ListDepartmentMembersOn      *employmentusecase.ListDepartmentMembersOnUsecase
+	ListJobChanges               *employmentusecase.ListJobChangesUsecase
+	CreateContract               *contractusecase.CreateContractUsecase
+	UpdateContract               *contractusecase.UpdateContractUsecase
+	DeleteContract               *contractusecase.DeleteContractUsecase
+	RenewContract                *contractusecase.RenewContractUsecase
+	ListContracts                *contractusecase.ListContractsUsecase
+	ListExpiringContracts        *contractusecase.ListExpiringContractsUsecase
+	SendContractReminders        *contractusecase.SendContractRemindersUsecase
+	StartOffboarding             *offboardingusecase.StartOffboardingUsecase
+	GetOffboarding               *offboardingusecase.GetOffboardingUsecase
+	GetEmployeeOffboarding       *offboardingusecase.GetEmployeeOffboardingUsecase
+	ListOffboardings             *offboardingusecase.ListOffboardingsUsecase
+	CompleteOffboardingTask      *offboardingusecase.CompleteTaskUsecase
+	RevokeOffboardedSessions     *offboardingusecase.RevokeDueSessionsUsecase
+	CreateOnboardingTemplate     *onboardingusecase.CreateTemplateUsecase
+	UpdateOnboardingTemplate     *onboardingusecase.UpdateTemplateUsecase
+	DeleteOnboardingTemplate     *onboardingusecase.DeleteTemplateUsecase
+	ListOnboardingTemplates      *onboardingusecase.ListTemplatesUsecase
+	ListOnboardingTasks          *onboardingusecase.ListTasksUsecase
+	ListMyOnboardingTasks        *onboardingusecase.ListMyTasksUsecase
+	CompleteOnboardingTask       *onboardingusecase.CompleteTaskUsecase
+	GetEmployeeOnboarding        *onboardingusecase.GetEmployeeOnboardingUsecase
+	GetProbationDashboard        *probationusecase.GetDashboardUsecase
+	ListProbationEvaluations     *probationusecase.ListEvaluationsUsecase
+	ListMyProbationEvaluations   *probationusecase.ListMyEvaluationsUsecase
+	GetProbationEvaluation       *probationusecase.GetEvaluationUsecase
+	DecideProbationEvaluation    *probationusecase.DecideEvaluationUsecase
+	IssueProbationEvaluations    *probationusecase.IssueEvaluationsUsecase
+	GetOrgChart                  *orgchartusecase.GetOrgChartUsecase
+	CreatePosition               *positionusecase.CreatePositionUsecase
+	UpdatePosition               *positionusecase.UpdatePositionUsecase
+	GetPosition                  *positionusecase.GetPositionUsecase
+	ListPositions                *positionusecase.ListPositionsUsecase
+	FillPosition                 *positionusecase.FillPositionUsecase
+	VacatePosition               *positionusecase.VacatePositionUsecase
+	FreezePosition               *positionusecase.FreezePositionUsecase
+	GetHeadcountReport           *positionusecase.GetHeadcountReportUsecase
+	SubmitProfileChanges         *profilechangeusecase.SubmitChangesUsecase
+	ListProfileChanges           *profilechangeusecase.ListChangeRequestsUsecase
+	ListMyProfileChanges         *profilechangeusecase.ListMyChangeRequestsUsecase
+	GetProfileChange             *profilechangeusecase.GetChangeRequestUsecase
+	ReviewProfileChange          *profilechangeusecase.ReviewChangeRequestUsecase
+	CancelProfileChange          *profilechangeusecase.CancelChangeRequestUsecase
+	CreateDependent              *dependentusecase.CreateDependentUsecase
+	UpdateDependent              *dependentusecase.UpdateDependentUsecase
+	DeleteDependent              *dependentusecase.DeleteDependentUsecase
+	ListDependents               *dependentusecase.ListDependentsUsecase
+	CreateEmergencyContact       *emergencycontactusecase.CreateEmergencyContactUsecase
+	UpdateEmergencyContact       *emergencycontactusecase.UpdateEmergencyContactUsecase
+	DeleteEmergencyContact       *emergencycontactusecase.DeleteEmergencyContactUsecase
+	ListEmergencyContacts        *emergencycontactusecase.ListEmergencyContactsUsecase
+	ListMyEmergencyContacts      *emergencycontactusecase.ListMyEmergencyContactsUsecase
+	CreateMyEmergencyContact     *emergencycontactusecase.CreateMyEmergencyContactUsecase
+	UpdateMyEmergencyContact     *emergencycontactusecase.UpdateMyEmergencyContactUsecase
+	DeleteMyEmergencyContact     *emergencycontactusecase.DeleteMyEmergencyContactUsecase
+	DryRunEmployeeImport         *employeeimportusecase.DryRunImportUsecase
+	RequestEmployeeImport        *employeeimportusecase.RequestImportUsecase
+	RunEmployeeImport            *employeeimportusecase.RunImportUsecase
+	GetEmployeeImport            *employeeimportusecase.GetImportUsecase
+	ListEmployeeImports          *employeeimportusecase.ListImportsUsecase
+	ExportEmployees              *employeeexportusecase.ExportEmployeesUsecase
+	RunEmployeeExport            *employeeexportusecase.RunExportUsecase
+	GetEmployeeExport            *employeeexportusecase.GetExportUsecase
+	ListEmployeeExports          *employeeexportusecase.ListExportsUsecase
+	CreateCustomField            *customfieldusecase.CreateDefinitionUsecase
+	UpdateCustomField            *customfieldusecase.UpdateDefinitionUsecase
+	DeleteCustomField            *customfieldusecase.DeleteDefinitionUsecase
+	ListCustomFields             *customfieldusecase.ListDefinitionsUsecase
+	VisibleCustomFields          *customfieldusecase.VisibleFieldsUsecase
+	CreateSalaryComponent        *salarycomponentusecase.CreateSalaryComponentUsecase
+	UpdateSalaryComponent        *salarycomponentusecase.UpdateSalaryComponentUsecase
+	DeleteSalaryComponent        *salarycomponentusecase.DeleteSalaryComponentUsecase
+	GetSalaryComponent           *salarycomponentusecase.GetSalaryComponentUsecase
+	GetStatutoryProfile          *statutoryusecase.GetEmployeeProfileUsecase
+	UpdateStatutoryProfile       *statutoryusecase.UpdateEmployeeProfileUsecase
+	ListSalaryComponents         *salarycomponentusecase.ListSalaryComponentsUsecase
 	CreateDepartment             *departmentusecase.CreateDepartmentUsecase
 	UpdateDepartment             *departmentusecase.UpdateDepartmentUsecase
+	MoveDepartment               *departmentusecase.MoveDepartmentUsecase
+	GetDepartmentTree            *departmentusecase.GetDepartmentTreeUsecase
 	CreateEmployee               *employeeusecase.CreateEmployeeUsecase
 	UpdateEmployee               *employeeusecase.UpdateEmployeeUsecase
-	DeleteEmployee               *employeeusecase.DeleteEmployeeUsecase
+	SearchEmployees              *employeeusecase.SearchEmployeesUsecase
+	SuggestEmployees             *employeeusecase.SuggestEmployeesUsecase
 	OnboardEmployee              *employeeusecase.OnboardEmployeeUsecase
 	CreateLeaveRequest           *leaverequestusecase.CreateLeaveRequestUsecase
 	GetLeaveRequest              *leaverequestusecase.GetLeaveRequest
@@ -89,27 +220,153 @@ type Usecases struct {
 }
 
 func buildUsecases(repo Repositories, infras *Infrastructures) Usecases {
-	createEmployee := employeeusecase.NewCreateEmployeeUsecase(repo.Employee)
-	updateEmployee := employeeusecase.NewUpdateEmployeeUsecase(repo.Employee)
-	deleteEmployee := employeeusecase.NewDeleteEmployeeUsecase(repo.Employee)
+	txManager := txmanager.NewPgxTxManager(infras.DB)
+	createEmployee := employeeusecase.NewCreateEmployeeUsecase(repo.Employee, repo.SalaryChange, repo.JobRecord, repo.CustomField, txManager)
+	updateEmployee := employeeusecase.NewUpdateEmployeeUsecase(repo.Employee, repo.CustomField, repo.User)
 	registerUser := userusecase.NewRegisterUserUsecase(repo.User)
+	assignOnboardingTasks := onboardingusecase.NewAssignTasksUsecase(repo.OnboardingTemplate, repo.OnboardingTask)
+	visibleCustomFields := customfieldusecase.NewVisibleFieldsUsecase(repo.CustomField, repo.User)
 	chunkTextUsecase := documentusecase.NewChunkTextUseCase()
 	embedChuckUsecase := aiusecase.NewEmbedChunkUseCase(infras.OllamaClient)
 	getTenantsByUserId := tenantmemberusecase.NewGetTenantsByUserIdUsecase(repo.TenantMember)
 	createRefreshToken := refreshtokenusecase.NewCreateRefreshTokenUsecase(repo.RefreshToken)
+	statutoryRules := statutoryrules.NewRegistry(vnrules.Pack{}, thrules.Pack{})
+	bankFormatters := bankfile.NewRegistry(bankfile.CSV{}, bankfile.Pain001{})
+	taxFormatters := taxfile.NewRegistry(taxfile.XML{}, taxfile.XLSX{})
+	getStatutoryProfile := statutoryusecase.NewGetEmployeeProfileUsecase(repo.StatutoryProfile)
 	rotateRefreshToken := refreshtokenusecase.NewRotateRefreshTokenUsecase(repo.RefreshToken)
-	txManager := txmanager.NewPgxTxManager(infras.DB)
+	onboardEmployee := employeeusecase.NewOnboardEmployeeUsecase(createEmployee, registerUser, assignOnboardingTasks, repo.Employee, repo.Department, repo.Tenant, infras.QueueService, txManager)
+	payAccess := payrollusecase.NewPayAccess(repo.User, repo.Employee, repo.TenantMember)
+	updateStatutoryProfile := statutoryusecase.NewUpdateEmployeeProfileUsecase(repo.StatutoryProfile, repo.User, getStatutoryProfile)
+	createBankAccount := bankaccountusecase.NewCreateBankAccountUsecase(repo.BankAccount, repo.User, txManager)
+	forceLogoutAll := refreshtokenusecase.NewForceLogoutAllUsecase(repo.RefreshToken)
+	startOffboarding := offboardingusecase.NewStartOffboardingUsecase(repo.Offboarding, repo.Employee, repo.JobRecord, repo.SalaryChange, repo.LeaveRequest, repo.LeaveType, txManager)
+	calculatePayrollRun := payrollusecase.NewCalculatePayrollRunUsecase(repo.PayrollRun, repo.Payroll, repo.Adjustment, repo.Employee, repo.SalaryChange, repo.SalaryComponent, repo.Attendance, repo.StatutoryProfile, repo.Dependent, repo.Offboarding, repo.TenantProfile, statutoryRules, txManager)
+	buildTaxYear := payrollusecase.NewBuildTaxYearUsecase(repo.PayrollRun, repo.Payroll, repo.Employee, repo.StatutoryProfile, repo.Dependent, repo.Tenant, repo.TenantProfile, statutoryRules)
+	createEmergencyContact := emergencycontactusecase.NewCreateEmergencyContactUsecase(repo.EmergencyContact)
+	updateEmergencyContact := emergencycontactusecase.NewUpdateEmergencyContactUsecase(repo.EmergencyContact)
+	deleteEmergencyContact := emergencycontactusecase.NewDeleteEmergencyContactUsecase(repo.EmergencyContact)
+	listEmergencyContacts := emergencycontactusecase.NewListEmergencyContactsUsecase(repo.EmergencyContact)
 
 	return Usecases{
 		ClockIn:                      attendanceusecase.NewClockInUsecase(repo.Attendance),
 		ClockOut:                     attendanceusecase.NewClockOutUsecase(repo.Attendance),
-		GeneratePayroll:              payrollusecase.NewGeneratePayrollUsecase(repo.Payroll),
+		CreatePayrollRun:             payrollusecase.NewCreatePayrollRunUsecase(repo.PayrollRun, repo.Employee, infras.QueueService),
+		CalculatePayrollRun:          calculatePayrollRun,
+		RecalculatePayrollRun:        payrollusecase.NewRecalculatePayrollRunUsecase(repo.PayrollRun, infras.QueueService),
+		ApprovePayrollRun:            payrollusecase.NewApprovePayrollRunUsecase(repo.PayrollRun, txManager, infras.QueueService, payAccess),
+		MarkPayrollRunPaid:           payrollusecase.NewMarkPayrollRunPaidUsecase(repo.PayrollRun, txManager, payAccess),
+		GeneratePayslips:             payrollusecase.NewGeneratePayslipsUsecase(repo.PayrollRun, repo.Payroll, repo.Payslip, repo.PayslipPref, repo.Employee, repo.LeaveType, repo.LeaveRequest, repo.Tenant, repo.TenantProfile, infras.PayslipRenderer, infras.StorageService),
+		RegeneratePayslips:           payrollusecase.NewRegeneratePayslipsUsecase(repo.PayrollRun, infras.QueueService),
+		GetPayslipDownloadURL:        payrollusecase.NewGetPayslipDownloadURLUsecase(repo.Payslip, payAccess, infras.StorageService),
+		ListMyPayslips:               payrollusecase.NewListMyPayslipsUsecase(repo.Payslip, repo.User),
+		UpdatePayslipPreference:      payrollusecase.NewUpdatePayslipPreferenceUsecase(repo.PayslipPref, payAccess),
+		GetPayrollRecord:             payrollusecase.NewGetPayrollRecordUsecase(repo.Payroll, payAccess),
+		ListEmployeePayrollRecords:   payrollusecase.NewListEmployeePayrollRecordsUsecase(repo.Payroll, payAccess),
+		ListPeriodPayrollRecords:     payrollusecase.NewListPeriodPayrollRecordsUsecase(repo.Payroll, payAccess),
+		ListRunRecords:               payrollusecase.NewListRunRecordsUsecase(repo.Payroll, repo.PayrollRun, payAccess),
+		ExportBankFile:               payrollusecase.NewExportBankFileUsecase(repo.PayrollRun, repo.Payroll, repo.BankExport, repo.BankAccount, repo.Employee, repo.Tenant, repo.TenantProfile, bankFormatters, payAccess),
+		ComputeRetroAdjustments:      payrollusecase.NewComputeRetroAdjustmentsUsecase(repo.PayrollRun, repo.Payroll, repo.Adjustment, repo.Employee, calculatePayrollRun, infras.QueueService, txManager),
+		GetTaxYear:                   payrollusecase.NewGetTaxYearUsecase(buildTaxYear, payAccess),
+		ExportTaxDeclaration:         payrollusecase.NewExportTaxDeclarationUsecase(buildTaxYear, taxFormatters, payAccess),
+		RequestTaxCertificates:       payrollusecase.NewRequestTaxCertificatesUsecase(buildTaxYear, infras.QueueService, payAccess),
+		GenerateTaxCertificates:      payrollusecase.NewGenerateTaxCertificatesUsecase(buildTaxYear, repo.TaxCertificate, repo.Tenant, repo.TenantProfile, infras.TaxCertificateRenderer, infras.StorageService),
+		ListTaxCertificates:          payrollusecase.NewListTaxCertificatesUsecase(repo.TaxCertificate, payAccess),
+		GetTaxCertificateDownloadURL: payrollusecase.NewGetTaxCertificateDownloadURLUsecase(repo.TaxCertificate, payAccess, infras.StorageService),
+		CreateBankAccount:            createBankAccount,
+		UpdateBankAccount:            bankaccountusecase.NewUpdateBankAccountUsecase(repo.BankAccount, repo.User, txManager),
+		DeleteBankAccount:            bankaccountusecase.NewDeleteBankAccountUsecase(repo.BankAccount, repo.User),
+		ListBankAccounts:             bankaccountusecase.NewListBankAccountsUsecase(repo.BankAccount, payAccess),
+		RecordSalaryChange:           compensationusecase.NewRecordSalaryChangeUsecase(repo.SalaryChange, repo.Employee),
+		ListSalaryHistory:            compensationusecase.NewListSalaryHistoryUsecase(repo.SalaryChange),
+		ChangeJob:                    employmentusecase.NewChangeJobUsecase(repo.JobRecord, repo.Employee, txManager),
+		ListEmploymentHistory:        employmentusecase.NewListEmploymentHistoryUsecase(repo.JobRecord),
+		ListDepartmentMembersOn:      employmentusecase.NewListDepartmentMembersOnUsecase(repo.JobRecord),
+		ListJobChanges:               employmentusecase.NewListJobChangesUsecase(repo.JobRecord),
+		CreateContract:               contractusecase.NewCreateContractUsecase(repo.Contract, repo.Employee),
+		UpdateContract:               contractusecase.NewUpdateContractUsecase(repo.Contract),
+		DeleteContract:               contractusecase.NewDeleteContractUsecase(repo.Contract),
+		RenewContract:                contractusecase.NewRenewContractUsecase(repo.Contract),
+		ListContracts:                contractusecase.NewListContractsUsecase(repo.Contract),
+		ListExpiringContracts:        contractusecase.NewListExpiringContractsUsecase(repo.Contract),
+		SendContractReminders:        contractusecase.NewSendContractRemindersUsecase(repo.Contract, txManager, infras.QueueService),
+		StartOffboarding:             startOffboarding,
+		GetOffboarding:               offboardingusecase.NewGetOffboardingUsecase(repo.Offboarding),
+		GetEmployeeOffboarding:       offboardingusecase.NewGetEmployeeOffboardingUsecase(repo.Offboarding),
+		ListOffboardings:             offboardingusecase.NewListOffboardingsUsecase(repo.Offboarding),
+		CompleteOffboardingTask:      offboardingusecase.NewCompleteTaskUsecase(repo.Offboarding),
+		RevokeOffboardedSessions:     offboardingusecase.NewRevokeDueSessionsUsecase(repo.Offboarding, forceLogoutAll, txManager),
+		CreateOnboardingTemplate:     onboardingusecase.NewCreateTemplateUsecase(repo.OnboardingTemplate),
+		UpdateOnboardingTemplate:     onboardingusecase.NewUpdateTemplateUsecase(repo.OnboardingTemplate),
+		DeleteOnboardingTemplate:     onboardingusecase.NewDeleteTemplateUsecase(repo.OnboardingTemplate),
+		ListOnboardingTemplates:      onboardingusecase.NewListTemplatesUsecase(repo.OnboardingTemplate),
+		ListOnboardingTasks:          onboardingusecase.NewListTasksUsecase(repo.OnboardingTask),
+		ListMyOnboardingTasks:        onboardingusecase.NewListMyTasksUsecase(repo.OnboardingTask, repo.User),
+		CompleteOnboardingTask:       onboardingusecase.NewCompleteTaskUsecase(repo.OnboardingTask, repo.User),
+		GetEmployeeOnboarding:        onboardingusecase.NewGetEmployeeOnboardingUsecase(repo.OnboardingTask),
+		GetProbationDashboard:        probationusecase.NewGetDashboardUsecase(repo.ProbationEvaluation),
+		ListProbationEvaluations:     probationusecase.NewListEvaluationsUsecase(repo.ProbationEvaluation),
+		ListMyProbationEvaluations:   probationusecase.NewListMyEvaluationsUsecase(repo.ProbationEvaluation, repo.User),
+		GetProbationEvaluation:       probationusecase.NewGetEvaluationUsecase(repo.ProbationEvaluation),
+		DecideProbationEvaluation:    probationusecase.NewDecideEvaluationUsecase(repo.ProbationEvaluation, repo.JobRecord, repo.Contract, repo.User, startOffboarding, txManager, infras.QueueService),
+		IssueProbationEvaluations:    probationusecase.NewIssueEvaluationsUsecase(repo.ProbationEvaluation, repo.Contract, txManager, infras.QueueService),
+		GetOrgChart:                  orgchartusecase.NewGetOrgChartUsecase(repo.Employee),
+		CreatePosition:               positionusecase.NewCreatePositionUsecase(repo.Position, repo.Department),
+		UpdatePosition:               positionusecase.NewUpdatePositionUsecase(repo.Position, repo.Department),
+		GetPosition:                  positionusecase.NewGetPositionUsecase(repo.Position),
+		ListPositions:                positionusecase.NewListPositionsUsecase(repo.Position),
+		FillPosition:                 positionusecase.NewFillPositionUsecase(repo.Position, repo.Employee),
+		VacatePosition:               positionusecase.NewVacatePositionUsecase(repo.Position),
+		FreezePosition:               positionusecase.NewFreezePositionUsecase(repo.Position),
+		GetHeadcountReport:           positionusecase.NewGetHeadcountReportUsecase(repo.Position, repo.Employee, repo.Department),
+		SubmitProfileChanges:         profilechangeusecase.NewSubmitChangesUsecase(repo.ProfileChange, repo.Employee, repo.User, repo.BankAccount, getStatutoryProfile, txManager),
+		ListProfileChanges:           profilechangeusecase.NewListChangeRequestsUsecase(repo.ProfileChange),
+		ListMyProfileChanges:         profilechangeusecase.NewListMyChangeRequestsUsecase(repo.ProfileChange, repo.Employee, repo.User),
+		GetProfileChange:             profilechangeusecase.NewGetChangeRequestUsecase(repo.ProfileChange),
+		ReviewProfileChange:          profilechangeusecase.NewReviewChangeRequestUsecase(repo.ProfileChange, repo.User, repo.BankAccount, getStatutoryProfile, updateStatutoryProfile, createBankAccount, txManager, infras.QueueService),
+		CancelProfileChange:          profilechangeusecase.NewCancelChangeRequestUsecase(repo.ProfileChange, repo.User, txManager),
+		CreateDependent:              dependentusecase.NewCreateDependentUsecase(repo.Dependent),
+		UpdateDependent:              dependentusecase.NewUpdateDependentUsecase(repo.Dependent),
+		DeleteDependent:              dependentusecase.NewDeleteDependentUsecase(repo.Dependent),
+		ListDependents:               dependentusecase.NewListDependentsUsecase(repo.Dependent),
+		CreateEmergencyContact:       createEmergencyContact,
+		UpdateEmergencyContact:       updateEmergencyContact,
+		DeleteEmergencyContact:       deleteEmergencyContact,
+		ListEmergencyContacts:        listEmergencyContacts,
+		ListMyEmergencyContacts:      emergencycontactusecase.NewListMyEmergencyContactsUsecase(listEmergencyContacts, repo.User),
+		CreateMyEmergencyContact:     emergencycontactusecase.NewCreateMyEmergencyContactUsecase(createEmergencyContact, repo.User),
+		UpdateMyEmergencyContact:     emergencycontactusecase.NewUpdateMyEmergencyContactUsecase(repo.EmergencyContact, updateEmergencyContact, repo.User),
+		DeleteMyEmergencyContact:     emergencycontactusecase.NewDeleteMyEmergencyContactUsecase(repo.EmergencyContact, deleteEmergencyContact, repo.User),
+		DryRunEmployeeImport:         employeeimportusecase.NewDryRunImportUsecase(infras.StorageService, repo.Department, repo.Employee, repo.CustomField),
+		RequestEmployeeImport:        employeeimportusecase.NewRequestImportUsecase(repo.EmployeeImport, infras.StorageService, repo.Department, repo.Employee, repo.CustomField, infras.QueueService),
+		RunEmployeeImport:            employeeimportusecase.NewRunImportUsecase(repo.EmployeeImport, infras.StorageService, repo.Department, repo.Employee, repo.CustomField, onboardEmployee),
+		GetEmployeeImport:            employeeimportusecase.NewGetImportUsecase(repo.EmployeeImport),
+		ListEmployeeImports:          employeeimportusecase.NewListImportsUsecase(repo.EmployeeImport),
+		ExportEmployees:              employeeexportusecase.NewExportEmployeesUsecase(repo.EmployeeExport, repo.Employee, visibleCustomFields, infras.QueueService),
+		RunEmployeeExport:            employeeexportusecase.NewRunExportUsecase(repo.EmployeeExport, repo.Employee, infras.StorageService),
+		GetEmployeeExport:            employeeexportusecase.NewGetExportUsecase(repo.EmployeeExport, infras.StorageService),
+		ListEmployeeExports:          employeeexportusecase.NewListExportsUsecase(repo.EmployeeExport),
+		CreateCustomField:            customfieldusecase.NewCreateDefinitionUsecase(repo.CustomField),
+		UpdateCustomField:            customfieldusecase.NewUpdateDefinitionUsecase(repo.CustomField),
+		DeleteCustomField:            customfieldusecase.NewDeleteDefinitionUsecase(repo.CustomField),
+		ListCustomFields:             customfieldusecase.NewListDefinitionsUsecase(repo.CustomField),
+		VisibleCustomFields:          visibleCustomFields,
+		CreateSalaryComponent:        salarycomponentusecase.NewCreateSalaryComponentUsecase(repo.SalaryComponent),
+		UpdateSalaryComponent:        salarycomponentusecase.NewUpdateSalaryComponentUsecase(repo.SalaryComponent),
+		DeleteSalaryComponent:        salarycomponentusecase.NewDeleteSalaryComponentUsecase(repo.SalaryComponent),
+		GetSalaryComponent:           salarycomponentusecase.NewGetSalaryComponentUsecase(repo.SalaryComponent),
+		GetStatutoryProfile:          getStatutoryProfile,
+		UpdateStatutoryProfile:       updateStatutoryProfile,
+		ListSalaryComponents:         salarycomponentusecase.NewListSalaryComponentsUsecase(repo.SalaryComponent),
 		CreateDepartment:             departmentusecase.NewCreateDepartmentUsecase(repo.Department),
 		UpdateDepartment:             departmentusecase.NewUpdateDepartmentUsecase(repo.Department),
+		MoveDepartment:               departmentusecase.NewMoveDepartmentUsecase(repo.Department, txManager),
+		GetDepartmentTree:            departmentusecase.NewGetDepartmentTreeUsecase(repo.Department, repo.JobRecord, repo.PayrollRun, repo.Payroll),
 		CreateEmployee:               createEmployee,
 		UpdateEmployee:               updateEmployee,
-		DeleteEmployee:               deleteEmployee,
-		OnboardEmployee:              employeeusecase.NewOnboardEmployeeUsecase(createEmployee, deleteEmployee, registerUser, infras.QueueService),
+		SearchEmployees:              employeeusecase.NewSearchEmployeesUsecase(repo.Employee),
+		SuggestEmployees:             employeeusecase.NewSuggestEmployeesUsecase(repo.Employee),
+		OnboardEmployee:              onboardEmployee,
 		CreateLeaveRequest:           leaverequestusecase.NewCreateLeaveRequestUsecase(repo.LeaveRequest),
 		GetLeaveRequest:              leaverequestusecase.NewGetLeaveRequest(repo.LeaveRequest),
 		ListLeaveByEmployee:          leaverequestusecase.NewListByEmployee(repo.LeaveRequest),
@@ -142,7 +399,7 @@ func buildUsecases(repo Repositories, infras *Infrastructures) Usecases {
 		MeUsecase:                    authusecase.NewMeUsecase(repo.User, repo.Employee),
 		RefreshToken:                 authusecase.NewRefreshTokenUsecase(repo.RefreshToken, infras.TokenService, rotateRefreshToken),
 		LogoutRefreshToken:           refreshtokenusecase.NewLogoutRefreshTokenUsecase(repo.RefreshToken),
-		ForceLogoutAllUsecase:        refreshtokenusecase.NewForceLogoutAllUsecase(repo.RefreshToken),
+		ForceLogoutAllUsecase:        forceLogoutAll,
 		GenPresignedURLUsecase:       storageusecase.NewGenPresignedURLUsecase(infras.StorageService),
 		ConfirmUploadUsecase:         fileusecase.NewConfirmUploadUsecase(repo.File),
 		GetFileUsecase:               fileusecase.NewGetFileUsecase(repo.File, storageusecase.NewGetPresignedDownloadURLUsecase(infras.StorageService)),
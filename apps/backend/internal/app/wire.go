@@ -14,6 +14,7 @@ func Build(ctx context.Context, cfg *config.Config) (*Container, error) {
 	wire.Build(
 		buildInfrastructures,
 		provideDBPool,
+		provideCipher,
 		buildRepositories,
 		buildUsecases,
 		buildHandlers,
@@ -19,7 +19,8 @@ func Build(ctx context.Context, cfg *config.Config) (*Container, error) {
 		return nil, err
 	}
 	pool := provideDBPool(infrastructures)
-	repositories := buildRepositories(pool)
+	cipher := provideCipher(infrastructures)
+	repositories := buildRepositories(pool, cipher)
 	usecases := buildUsecases(repositories, infrastructures)
 	handlers := buildHandlers(usecases, repositories)
 	mux := buildRouter(handlers, infrastructures)
@@ -16,6 +16,12 @@ type Config struct {
 	RabbitMQ RabbitMQ `validate:"required"`
 	JWT      JWT      `validate:"required"`
 	S3       S3Config `validate:"required"`
+	Payslip  Payslip
+	Secrets  Secrets `validate:"required"`
+
+	ContractReminder ContractReminder
+	Offboarding      Offboarding
+	Probation        Probation
 }
 
 type App struct {
@@ -48,6 +54,37 @@ type S3Config struct {
 	PublicURL string `envconfig:"PUBLIC_URL" validate:"required"`
 }
 
+// Secrets.EncryptionKey is a base64 encoded 32 byte AES key for
+// sensitive columns such as bank account numbers.
+type Secrets struct {
+	EncryptionKey string `envconfig:"ENCRYPTION_KEY" validate:"required"`
+}
+
+// Payslip.FontPath points at a TTF font with Vietnamese glyphs. Without it
+// payslips fall back to a core font and strip diacritics.
+type Payslip struct {
+	FontPath string `envconfig:"FONT_PATH"`
+}
+
+// ContractReminder controls the HR e-mails sent DaysBefore days ahead of
+// a contract or probation end, checked every IntervalHours.
+type ContractReminder struct {
+	DaysBefore    int `envconfig:"DAYS_BEFORE" validate:"gt=0" default:"30"`
+	IntervalHours int `envconfig:"INTERVAL_HOURS" validate:"gt=0" default:"24"`
+}
+
+// Offboarding controls how often the worker revokes the sessions of
+// employees who reached their last working day.
+type Offboarding struct {
+	IntervalHours int `envconfig:"INTERVAL_HOURS" validate:"gt=0" default:"1"`
+}
+
+// Probation controls how often the worker issues the evaluations of
+// probations ending within the week.
+type Probation struct {
+	IntervalHours int `envconfig:"INTERVAL_HOURS" validate:"gt=0" default:"24"`
+}
+
 type JWT struct {
 	AccessSecret     string `envconfig:"ACCESS_SECRET" validate:"required"`
 	RefreshSecret    string `envconfig:"REFRESH_SECRET" validate:"required"`
@@ -84,6 +121,22 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("load S3 config: %w", err)
 	}
 
+	if err := envconfig.Process("SECRETS", &cfg.Secrets); err != nil {
+		return nil, fmt.Errorf("load SECRETS config: %w", err)
+	}
+	if err := envconfig.Process("PAYSLIP", &cfg.Payslip); err != nil {
+		return nil, fmt.Errorf("load PAYSLIP config: %w", err)
+	}
+	if err := envconfig.Process("CONTRACT_REMINDER", &cfg.ContractReminder); err != nil {
+		return nil, fmt.Errorf("load CONTRACT_REMINDER config: %w", err)
+	}
+	if err := envconfig.Process("OFFBOARDING", &cfg.Offboarding); err != nil {
+		return nil, fmt.Errorf("load OFFBOARDING config: %w", err)
+	}
+	if err := envconfig.Process("PROBATION", &cfg.Probation); err != nil {
+		return nil, fmt.Errorf("load PROBATION config: %w", err)
+	}
+
 	if err := validate.Struct(cfg); err != nil {
 		return nil, fmt.Errorf("validate config: %w", err)
 	}
@@ -17,11 +17,17 @@ func NewPgxTxManager(pool *pgxpool.Pool) *PgxTxManager {
 	return &PgxTxManager{pool: pool}
 }
 
+// WithTx runs fn in a transaction. Called with a ctx that already carries
+// one, fn joins it and the outer call decides on commit or rollback.
 func (m *PgxTxManager) WithTx(
 	ctx context.Context,
 	fn func(txCtx context.Context) error,
 ) error {
 
+	if _, ok := txpkg.TxFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := m.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return err
@@ -0,0 +1,313 @@
+package fpdfrenderer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	fontFamily   = "payslip"
+	maxLogoBytes = 2 << 20
+	pageWidth    = 180.0
+	rowHeight    = 7.0
+)
+
+// PayslipRenderer draws payslips with fpdf. Without a TTF font only the
+// core Helvetica font is available, so text is folded to ASCII.
+type PayslipRenderer struct {
+	base
+}
+
+var _ domain.PayslipRenderer = (*PayslipRenderer)(nil)
+
+func NewPayslipRenderer(fontPath string) *PayslipRenderer {
+	return &PayslipRenderer{base: newBase(fontPath)}
+}
+
+func (r *PayslipRenderer) Render(ctx context.Context, doc *domain.PayslipDocument) ([]byte, error) {
+	pdf, w := r.newDocument("Payslip "+doc.Period, doc.CompanyName)
+	if doc.Password != "" {
+		pdf.SetProtection(fpdf.CnProtectPrint, doc.Password, "")
+	}
+	pdf.AddPage()
+
+	r.header(ctx, w, doc.CompanyName, doc.LogoURL, "Payslip for "+doc.Period)
+	w.details([][2]string{
+		{"Employee", doc.EmployeeName},
+		{"Code", doc.EmployeeCode},
+		{"Position", doc.Position},
+		{"Department", doc.Department},
+		{"Currency", string(doc.Currency)},
+	})
+
+	w.section("Earnings")
+	w.amountRow("Base salary", doc.BaseSalary)
+	for _, l := range doc.Earnings {
+		w.amountRow(l.Name, l.Amount)
+	}
+	w.totalRow("Gross pay", doc.GrossPay)
+
+	w.section("Deductions")
+	for _, l := range doc.Deductions {
+		w.amountRow(l.Name, l.Amount)
+	}
+	w.totalRow("Total deductions", doc.TotalDeductions)
+
+	pdf.Ln(2)
+	w.totalRow("Net pay", doc.NetPay)
+
+	if len(doc.Employer) > 0 {
+		w.section("Employer contributions")
+		for _, l := range doc.Employer {
+			w.amountRow(l.Name, l.Amount)
+		}
+	}
+
+	w.section("Year to date")
+	w.amountRow("Gross pay", doc.YTD.GrossPay)
+	w.amountRow("Deductions", doc.YTD.Deductions)
+	w.amountRow("Net pay", doc.YTD.NetPay)
+
+	if len(doc.LeaveBalances) > 0 {
+		w.section("Leave balance (days)")
+		w.leaveTable(doc.LeaveBalances)
+	}
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// base holds what every document renderer shares: the optional TTF font
+// and the client that fetches tenant logos.
+type base struct {
+	fontPath   string
+	httpClient *http.Client
+}
+
+func newBase(fontPath string) base {
+	return base{
+		fontPath:   fontPath,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// newDocument starts an A4 document and its writer. Pages are added by
+// the caller, after any protection is set.
+func (r *base) newDocument(title, author string) (*fpdf.Fpdf, *writer) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+
+	family, text := "Helvetica", asciiFold
+	if r.fontPath != "" {
+		pdf.AddUTF8Font(fontFamily, "", r.fontPath)
+		pdf.AddUTF8Font(fontFamily, "B", r.fontPath)
+		family, text = fontFamily, func(s string) string { return s }
+	}
+
+	pdf.SetTitle(text(title), true)
+	pdf.SetAuthor(text(author), true)
+
+	return pdf, &writer{pdf: pdf, family: family, text: text}
+}
+
+func (r *base) header(ctx context.Context, w *writer, company string, logoURL *string, title string) {
+	pdf := w.pdf
+	top := pdf.GetY()
+	textX := 15.0
+
+	if logoURL != nil && *logoURL != "" {
+		// A missing logo must not fail the document.
+		if name, ok := r.registerLogo(ctx, pdf, *logoURL); ok {
+			pdf.ImageOptions(name, 15, top, 0, 18, false, fpdf.ImageOptions{}, 0, "")
+			textX = 50
+		}
+	}
+
+	pdf.SetXY(textX, top)
+	pdf.SetFont(w.family, "B", 14)
+	pdf.CellFormat(0, 8, w.text(company), "", 1, "L", false, 0, "")
+
+	pdf.SetX(textX)
+	pdf.SetFont(w.family, "", 11)
+	pdf.CellFormat(0, 6, w.text(title), "", 1, "L", false, 0, "")
+
+	pdf.SetY(top + 22)
+	pdf.SetDrawColor(180, 180, 180)
+	pdf.Line(15, pdf.GetY(), 15+pageWidth, pdf.GetY())
+	pdf.Ln(4)
+}
+
+func (r *base) registerLogo(ctx context.Context, pdf *fpdf.Fpdf, url string) (string, bool) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", false
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return "", false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", false
+	}
+
+	imageType := logoType(resp.Header.Get("Content-Type"), url)
+	if imageType == "" {
+		return "", false
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLogoBytes))
+	if err != nil {
+		return "", false
+	}
+
+	opts := fpdf.ImageOptions{ImageType: imageType, ReadDpi: true}
+	pdf.RegisterImageOptionsReader("logo", opts, bytes.NewReader(data))
+	if !pdf.Ok() {
+		pdf.ClearError()
+		return "", false
+	}
+
+	return "logo", true
+}
+
+func logoType(contentType, url string) string {
+	contentType = strings.ToLower(contentType)
+	url = strings.ToLower(url)
+
+	switch {
+	case strings.Contains(contentType, "png"), strings.HasSuffix(url, ".png"):
+		return "PNG"
+	case strings.Contains(contentType, "jpeg"), strings.Contains(contentType, "jpg"),
+		strings.HasSuffix(url, ".jpg"), strings.HasSuffix(url, ".jpeg"):
+		return "JPG"
+	case strings.Contains(contentType, "gif"), strings.HasSuffix(url, ".gif"):
+		return "GIF"
+	default:
+		return ""
+	}
+}
+
+type writer struct {
+	pdf    *fpdf.Fpdf
+	family string
+	text   func(string) string
+}
+
+// details prints label and value pairs, skipping empty values.
+func (w *writer) details(rows [][2]string) {
+	for _, row := range rows {
+		if row[1] == "" {
+			continue
+		}
+		w.pdf.SetFont(w.family, "B", 10)
+		w.pdf.CellFormat(35, 6, w.text(row[0]), "", 0, "L", false, 0, "")
+		w.pdf.SetFont(w.family, "", 10)
+		w.pdf.CellFormat(0, 6, w.text(row[1]), "", 1, "L", false, 0, "")
+	}
+}
+
+func (w *writer) section(title string) {
+	w.pdf.Ln(4)
+	w.pdf.SetFont(w.family, "B", 11)
+	w.pdf.SetFillColor(235, 238, 243)
+	w.pdf.CellFormat(pageWidth, rowHeight, w.text(title), "", 1, "L", true, 0, "")
+}
+
+func (w *writer) amountRow(label string, amount money.Money) {
+	w.pdf.SetFont(w.family, "", 10)
+	w.pdf.CellFormat(pageWidth-50, rowHeight, w.text(label), "B", 0, "L", false, 0, "")
+	w.pdf.CellFormat(50, rowHeight, formatAmount(amount), "B", 1, "R", false, 0, "")
+}
+
+func (w *writer) totalRow(label string, amount money.Money) {
+	w.pdf.SetFont(w.family, "B", 10)
+	w.pdf.CellFormat(pageWidth-50, rowHeight, w.text(label), "", 0, "L", false, 0, "")
+	w.pdf.CellFormat(50, rowHeight, formatAmount(amount), "", 1, "R", false, 0, "")
+}
+
+func (w *writer) leaveTable(balances []domain.LeaveBalance) {
+	cols := []float64{pageWidth - 90, 30, 30, 30}
+
+	w.pdf.SetFont(w.family, "B", 10)
+	for i, h := range []string{"Leave type", "Entitled", "Taken", "Remaining"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		w.pdf.CellFormat(cols[i], rowHeight, h, "B", 0, align, false, 0, "")
+	}
+	w.pdf.Ln(-1)
+
+	w.pdf.SetFont(w.family, "", 10)
+	for _, b := range balances {
+		w.pdf.CellFormat(cols[0], rowHeight, w.text(b.LeaveType), "B", 0, "L", false, 0, "")
+		w.pdf.CellFormat(cols[1], rowHeight, fmt.Sprint(b.Entitled), "B", 0, "R", false, 0, "")
+		w.pdf.CellFormat(cols[2], rowHeight, fmt.Sprint(b.Taken), "B", 0, "R", false, 0, "")
+		w.pdf.CellFormat(cols[3], rowHeight, fmt.Sprint(b.Remaining), "B", 1, "R", false, 0, "")
+	}
+}
+
+// formatAmount rounds to the currency scale and groups thousands.
+func formatAmount(m money.Money) string {
+	s := m.Round().String()
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	intPart, frac, hasFrac := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+
+	if hasFrac {
+		return sign + b.String() + "." + frac
+	}
+	return sign + b.String()
+}
+
+// asciiFold strips diacritics for the core fonts, which only cover
+// Latin-1. Vietnamese đ has no decomposition and is mapped explicitly.
+func asciiFold(s string) string {
+	var b strings.Builder
+	for _, c := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, c):
+			continue
+		case c == 'đ':
+			b.WriteRune('d')
+		case c == 'Đ':
+			b.WriteRune('D')
+		case c > unicode.MaxASCII:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
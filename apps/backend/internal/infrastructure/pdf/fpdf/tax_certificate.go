@@ -0,0 +1,78 @@
+package fpdfrenderer
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+)
+
+// TaxCertificateRenderer draws the employer's annual income tax
+// withholding certificate for one employee.
+type TaxCertificateRenderer struct {
+	base
+}
+
+var _ domain.TaxCertificateRenderer = (*TaxCertificateRenderer)(nil)
+
+func NewTaxCertificateRenderer(fontPath string) *TaxCertificateRenderer {
+	return &TaxCertificateRenderer{base: newBase(fontPath)}
+}
+
+func (r *TaxCertificateRenderer) Render(ctx context.Context, doc *domain.TaxCertificateDocument) ([]byte, error) {
+	year := strconv.Itoa(doc.Year)
+	e := doc.Employee
+
+	pdf, w := r.newDocument("Tax withholding certificate "+year, doc.CompanyName)
+	pdf.AddPage()
+
+	r.header(ctx, w, doc.CompanyName, doc.LogoURL, "Income tax withholding certificate "+year)
+
+	w.section("Employer")
+	w.details([][2]string{
+		{"Name", doc.CompanyName},
+		{"Tax code", doc.CompanyTaxCode},
+	})
+
+	taxCode := ""
+	if e.TaxCode != nil {
+		taxCode = *e.TaxCode
+	}
+
+	w.section("Employee")
+	w.details([][2]string{
+		{"Name", e.EmployeeName},
+		{"Code", e.EmployeeCode},
+		{"Tax code", taxCode},
+		{"Dependents", strconv.Itoa(e.Dependents)},
+		{"Paid from", e.FromPeriod + " to " + e.ToPeriod},
+		{"Months", strconv.Itoa(e.MonthsEmployed)},
+		{"Currency", string(doc.Currency)},
+	})
+
+	w.section("Income")
+	w.amountRow("Gross income", e.GrossIncome)
+	w.amountRow("Taxable income", e.TaxableIncome)
+	w.amountRow("Compulsory insurance", e.Contributions)
+
+	w.section("Income tax")
+	w.amountRow("Tax withheld", e.TaxWithheld)
+	w.amountRow("Tax due for the year", e.TaxDue)
+	w.totalRow("Balance", e.TaxBalance)
+
+	pdf.Ln(4)
+	pdf.SetFont(w.family, "", 9)
+	note := "The employee files their own annual return with this certificate."
+	if e.EmployerSettles {
+		note = "The employer finalizes the tax for the year on the employee's behalf."
+	}
+	pdf.MultiCell(pageWidth, 5, w.text(note+" A positive balance is tax still due; a negative balance is refundable."), "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/secret"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+// BankAccountPostgresRepository stores account numbers encrypted; only
+// the last four characters are kept in clear text.
+type BankAccountPostgresRepository struct {
+	db     *pgxpool.Pool
+	cipher *secret.Cipher
+}
+
+var _ bankaccountrepository.BankAccountRepository = (*BankAccountPostgresRepository)(nil)
+
+func NewBankAccountPostgresRepository(db *pgxpool.Pool, cipher *secret.Cipher) *BankAccountPostgresRepository {
+	return &BankAccountPostgresRepository{db: db, cipher: cipher}
+}
+
+func (r *BankAccountPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *BankAccountPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *BankAccountPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *BankAccountPostgresRepository) Create(ctx context.Context, a *domain.BankAccount) error {
+	number, err := r.cipher.Encrypt([]byte(a.AccountNumber))
+	if err != nil {
+		return err
+	}
+
+	return r.queryRow(ctx,
+		`INSERT INTO employee_bank_accounts (
+			employee_id, bank_name, bank_code, branch_code, account_holder,
+			account_number_enc, account_last4, currency, is_primary,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		a.EmployeeID, a.BankName, a.BankCode, a.BranchCode, a.AccountHolder,
+		number, a.AccountLast4, a.Currency, a.IsPrimary,
+		a.CreatedAt, a.UpdatedAt,
+	).Scan(&a.ID)
+}
+
+func (r *BankAccountPostgresRepository) Update(ctx context.Context, a *domain.BankAccount) error {
+	number, err := r.cipher.Encrypt([]byte(a.AccountNumber))
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.exec(ctx,
+		`UPDATE employee_bank_accounts
+		 SET bank_name = $1, bank_code = $2, branch_code = $3, account_holder = $4,
+		     account_number_enc = $5, account_last4 = $6, currency = $7,
+		     is_primary = $8, updated_at = $9
+		 WHERE id = $10`,
+		a.BankName, a.BankCode, a.BranchCode, a.AccountHolder,
+		number, a.AccountLast4, a.Currency,
+		a.IsPrimary, a.UpdatedAt, a.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return bankaccountrepository.ErrBankAccountNotFound
+	}
+	return nil
+}
+
+func (r *BankAccountPostgresRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.exec(ctx, `DELETE FROM employee_bank_accounts WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return bankaccountrepository.ErrBankAccountNotFound
+	}
+	return nil
+}
+
+func (r *BankAccountPostgresRepository) ClearPrimary(ctx context.Context, employeeID string) error {
+	_, err := r.exec(ctx,
+		`UPDATE employee_bank_accounts
+		 SET is_primary = FALSE, updated_at = NOW()
+		 WHERE employee_id = $1 AND is_primary`,
+		employeeID,
+	)
+	return err
+}
+
+const bankAccountColumns = `id, employee_id, bank_name, bank_code, branch_code, account_holder,
+	account_number_enc, account_last4, currency, is_primary, created_at, updated_at`
+
+func (r *BankAccountPostgresRepository) scan(row pgx.Row) (*domain.BankAccount, error) {
+	var a domain.BankAccount
+	var number []byte
+
+	err := row.Scan(
+		&a.ID, &a.EmployeeID, &a.BankName, &a.BankCode, &a.BranchCode, &a.AccountHolder,
+		&number, &a.AccountLast4, &a.Currency, &a.IsPrimary, &a.CreatedAt, &a.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, bankaccountrepository.ErrBankAccountNotFound
+		}
+		return nil, err
+	}
+
+	plain, err := r.cipher.Decrypt(number)
+	if err != nil {
+		return nil, err
+	}
+	a.AccountNumber = string(plain)
+
+	return &a, nil
+}
+
+func (r *BankAccountPostgresRepository) GetByID(ctx context.Context, id string) (*domain.BankAccount, error) {
+	return r.scan(r.queryRow(ctx,
+		`SELECT `+bankAccountColumns+` FROM employee_bank_accounts WHERE id = $1`, id))
+}
+
+func (r *BankAccountPostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.BankAccount, error) {
+	return r.list(ctx,
+		`SELECT `+bankAccountColumns+`
+		 FROM employee_bank_accounts
+		 WHERE employee_id = $1
+		 ORDER BY is_primary DESC, created_at ASC`,
+		employeeID,
+	)
+}
+
+func (r *BankAccountPostgresRepository) ListPrimaryByEmployeeIDs(
+	ctx context.Context,
+	employeeIDs []string,
+) (map[string]*domain.BankAccount, error) {
+
+	accounts, err := r.list(ctx,
+		`SELECT `+bankAccountColumns+`
+		 FROM employee_bank_accounts
+		 WHERE employee_id = ANY($1) AND is_primary`,
+		employeeIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*domain.BankAccount, len(accounts))
+	for _, a := range accounts {
+		result[a.EmployeeID] = a
+	}
+	return result, nil
+}
+
+func (r *BankAccountPostgresRepository) list(ctx context.Context, query string, args ...any) ([]*domain.BankAccount, error) {
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.BankAccount
+	for rows.Next() {
+		a, err := r.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, a)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type ContractPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ contractrepository.ContractRepository = (*ContractPostgresRepository)(nil)
+
+func NewContractPostgresRepository(db *pgxpool.Pool) *ContractPostgresRepository {
+	return &ContractPostgresRepository{db: db}
+}
+
+func (r *ContractPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *ContractPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *ContractPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *ContractPostgresRepository) Create(ctx context.Context, c *domain.Contract) error {
+	err := r.queryRow(ctx,
+		`INSERT INTO employee_contracts (
+			tenant_id, employee_id, contract_type, contract_number, start_date,
+			end_date, probation_end_date, signed_file_id, renewal_count,
+			previous_contract_id, note, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id`,
+		c.TenantID, c.EmployeeID, c.Type, c.Number, c.StartDate,
+		c.EndDate, c.ProbationEndDate, c.SignedFileID, c.RenewalCount,
+		c.PreviousContractID, c.Note, c.CreatedAt, c.UpdatedAt,
+	).Scan(&c.ID)
+
+	return contractWriteError(err)
+}
+
+func (r *ContractPostgresRepository) Update(ctx context.Context, c *domain.Contract) error {
+	tag, err := r.exec(ctx,
+		`UPDATE employee_contracts
+		 SET contract_type = $1, contract_number = $2, start_date = $3,
+		     end_date = $4, probation_end_date = $5, signed_file_id = $6,
+		     note = $7, end_reminder_sent_at = $8,
+		     probation_reminder_sent_at = $9, updated_at = $10
+		 WHERE id = $11`,
+		c.Type, c.Number, c.StartDate,
+		c.EndDate, c.ProbationEndDate, c.SignedFileID,
+		c.Note, c.EndReminderSentAt,
+		c.ProbationReminderSentAt, c.UpdatedAt,
+		c.ID,
+	)
+	if err != nil {
+		return contractWriteError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return contractrepository.ErrContractNotFound
+	}
+
+	return nil
+}
+
+func contractWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		switch pgErr.ConstraintName {
+		case "uq_employee_contracts_previous":
+			return contractrepository.ErrContractAlreadyRenewed
+		default:
+			// unique constraint on (tenant_id, contract_number)
+			return contractrepository.ErrContractNumberExists
+		}
+	}
+	return err
+}
+
+func (r *ContractPostgresRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.exec(ctx, `DELETE FROM employee_contracts WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return contractrepository.ErrContractNotFound
+	}
+
+	return nil
+}
+
+const contractColumns = `c.id, c.tenant_id, c.employee_id, c.contract_type, c.contract_number,
+	c.start_date, c.end_date, c.probation_end_date, c.signed_file_id,
+	c.renewal_count, c.previous_contract_id, c.note,
+	c.end_reminder_sent_at, c.probation_reminder_sent_at, c.created_at, c.updated_at,
+	e.code, e.first_name || ' ' || e.last_name`
+
+func scanContract(row pgx.Row, extra ...any) (*domain.Contract, error) {
+	var c domain.Contract
+
+	dest := []any{
+		&c.ID, &c.TenantID, &c.EmployeeID, &c.Type, &c.Number,
+		&c.StartDate, &c.EndDate, &c.ProbationEndDate, &c.SignedFileID,
+		&c.RenewalCount, &c.PreviousContractID, &c.Note,
+		&c.EndReminderSentAt, &c.ProbationReminderSentAt, &c.CreatedAt, &c.UpdatedAt,
+		&c.EmployeeCode, &c.EmployeeName,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *ContractPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Contract, error) {
+	c, err := scanContract(r.queryRow(ctx,
+		`SELECT `+contractColumns+`
+		 FROM employee_contracts c
+		 JOIN employees e ON e.id = c.employee_id
+		 WHERE c.id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, contractrepository.ErrContractNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *ContractPostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Contract, error) {
+	rows, err := r.query(ctx,
+		`SELECT `+contractColumns+`
+		 FROM employee_contracts c
+		 JOIN employees e ON e.id = c.employee_id
+		 WHERE c.employee_id = $1
+		 ORDER BY c.start_date DESC, c.created_at DESC`,
+		employeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Contract
+	for rows.Next() {
+		c, err := scanContract(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ContractPostgresRepository) ListExpiring(
+	ctx context.Context,
+	tenantID string,
+	from, to time.Time,
+) ([]*domain.Contract, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+contractColumns+`
+		 FROM employee_contracts c
+		 JOIN employees e ON e.id = c.employee_id
+		 WHERE c.tenant_id = $1
+		   AND (c.end_date BETWEEN $2 AND $3 OR c.probation_end_date BETWEEN $2 AND $3)
+		   AND NOT EXISTS (
+		       SELECT 1 FROM employee_contracts n WHERE n.previous_contract_id = c.id
+		   )
+		 ORDER BY LEAST(
+		     CASE WHEN c.end_date BETWEEN $2 AND $3 THEN c.end_date END,
+		     CASE WHEN c.probation_end_date BETWEEN $2 AND $3 THEN c.probation_end_date END
+		 ), e.code`,
+		tenantID, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Contract
+	for rows.Next() {
+		c, err := scanContract(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	return result, rows.Err()
+}
+
+// reminderColumns maps a reminder kind to the date it is about and the
+// column recording that it was sent.
+var reminderColumns = map[domain.ReminderKind][2]string{
+	domain.ReminderContractEnd:  {"end_date", "end_reminder_sent_at"},
+	domain.ReminderProbationEnd: {"probation_end_date", "probation_reminder_sent_at"},
+}
+
+func (r *ContractPostgresRepository) ClaimReminders(
+	ctx context.Context,
+	kind domain.ReminderKind,
+	from, to time.Time,
+) ([]*domain.Reminder, error) {
+
+	columns, ok := reminderColumns[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown reminder kind %q", kind)
+	}
+	dateColumn, sentColumn := columns[0], columns[1]
+
+	// Contracts that were already renewed need no reminder, nor do
+	// employees who have left.
+	rows, err := r.query(ctx,
+		`UPDATE employee_contracts c
+		 SET `+sentColumn+` = NOW()
+		 FROM employees e
+		 JOIN employee_current_jobs cj ON cj.employee_id = e.id
+		 WHERE e.id = c.employee_id
+		   AND cj.employment_status <> 'RESIGNED'
+		   AND c.`+dateColumn+` BETWEEN $1 AND $2
+		   AND c.`+sentColumn+` IS NULL
+		   AND NOT EXISTS (
+		       SELECT 1 FROM employee_contracts n WHERE n.previous_contract_id = c.id
+		   )
+		 RETURNING `+contractColumns+`, c.`+dateColumn,
+		from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Reminder
+	for rows.Next() {
+		var due time.Time
+
+		c, err := scanContract(rows, &due)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &domain.Reminder{Kind: kind, Contract: c, DueDate: due})
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ContractPostgresRepository) ListReminderRecipients(ctx context.Context, tenantID string) ([]string, error) {
+	rows, err := r.query(ctx,
+		`SELECT DISTINCT u.email
+		 FROM tenant_members tm
+		 JOIN users u ON u.id = tm.user_id
+		 WHERE tm.tenant_id = $1
+		   AND (u.role = 'HR' OR tm.role = 'OWNER')
+		 ORDER BY u.email`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		result = append(result, email)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+)
+
+type CustomFieldPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ customfieldrepository.DefinitionRepository = (*CustomFieldPostgresRepository)(nil)
+
+func NewCustomFieldPostgresRepository(db *pgxpool.Pool) *CustomFieldPostgresRepository {
+	return &CustomFieldPostgresRepository{db: db}
+}
+
+func customFieldWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return customfieldrepository.ErrKeyExists
+	}
+	return err
+}
+
+func (r *CustomFieldPostgresRepository) Create(ctx context.Context, d *domain.Definition) error {
+	optionsJSON, err := json.Marshal(d.Options)
+	if err != nil {
+		return err
+	}
+	rolesJSON, err := json.Marshal(d.VisibleTo)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.QueryRow(ctx,
+		`INSERT INTO custom_field_definitions (
+			tenant_id, key, label, type, required, options, pattern, max_length,
+			min_value, max_value, visible_to, position, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id`,
+		d.TenantID, d.Key, d.Label, d.Type, d.Required, optionsJSON, d.Pattern, d.MaxLength,
+		d.Min, d.Max, rolesJSON, d.Position, d.CreatedAt, d.UpdatedAt,
+	).Scan(&d.ID)
+	return customFieldWriteError(err)
+}
+
+func (r *CustomFieldPostgresRepository) Update(ctx context.Context, d *domain.Definition) error {
+	optionsJSON, err := json.Marshal(d.Options)
+	if err != nil {
+		return err
+	}
+	rolesJSON, err := json.Marshal(d.VisibleTo)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE custom_field_definitions
+		 SET label = $1, required = $2, options = $3, pattern = $4, max_length = $5,
+		     min_value = $6, max_value = $7, visible_to = $8, position = $9, updated_at = $10
+		 WHERE id = $11`,
+		d.Label, d.Required, optionsJSON, d.Pattern, d.MaxLength,
+		d.Min, d.Max, rolesJSON, d.Position, d.UpdatedAt,
+		d.ID,
+	)
+	if err != nil {
+		return customFieldWriteError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return customfieldrepository.ErrDefinitionNotFound
+	}
+
+	return nil
+}
+
+func (r *CustomFieldPostgresRepository) Delete(ctx context.Context, id string) error {
+	var deleted int
+	err := r.db.QueryRow(ctx,
+		`WITH deleted AS (
+			DELETE FROM custom_field_definitions WHERE id = $1
+			RETURNING tenant_id, key
+		), stripped AS (
+			UPDATE employees e
+			SET custom_fields = e.custom_fields - d.key
+			FROM deleted d
+			WHERE e.tenant_id = d.tenant_id AND e.custom_fields ? d.key
+		)
+		SELECT COUNT(*) FROM deleted`,
+		id,
+	).Scan(&deleted)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return customfieldrepository.ErrDefinitionNotFound
+	}
+
+	return nil
+}
+
+const customFieldColumns = `id, tenant_id, key, label, type, required, options, pattern,
+	max_length, min_value, max_value, visible_to, position, created_at, updated_at`
+
+func scanCustomField(row pgx.Row) (*domain.Definition, error) {
+	var d domain.Definition
+	var optionsJSON, rolesJSON []byte
+
+	err := row.Scan(
+		&d.ID, &d.TenantID, &d.Key, &d.Label, &d.Type, &d.Required, &optionsJSON, &d.Pattern,
+		&d.MaxLength, &d.Min, &d.Max, &rolesJSON, &d.Position, &d.CreatedAt, &d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(optionsJSON, &d.Options); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(rolesJSON, &d.VisibleTo); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (r *CustomFieldPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Definition, error) {
+	d, err := scanCustomField(r.db.QueryRow(ctx,
+		`SELECT `+customFieldColumns+` FROM custom_field_definitions WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, customfieldrepository.ErrDefinitionNotFound
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (r *CustomFieldPostgresRepository) ListByTenantID(ctx context.Context, tenantID string) (domain.Schema, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+customFieldColumns+`
+		 FROM custom_field_definitions
+		 WHERE tenant_id = $1
+		 ORDER BY position ASC, key ASC`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schema := domain.Schema{}
+	for rows.Next() {
+		d, err := scanCustomField(rows)
+		if err != nil {
+			return nil, err
+		}
+		schema = append(schema, d)
+	}
+
+	return schema, rows.Err()
+}
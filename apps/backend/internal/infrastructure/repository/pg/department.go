@@ -2,13 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"slices"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
 	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
 	empDomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 )
 
 type DepartmentPostgresRepository struct {
@@ -19,12 +23,50 @@ func NewDepartmentPostgresRepository(db *pgxpool.Pool) departmentrepository.Depa
 	return &DepartmentPostgresRepository{db: db}
 }
 
+func (r *DepartmentPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *DepartmentPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *DepartmentPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
 func (r *DepartmentPostgresRepository) Create(d *domain.Department) error {
-	_, err := r.db.Exec(context.Background(),
-		`INSERT INTO departments (name, manager_id)
-		 VALUES ($1, $2)`, d.Name, d.ManagerID,
-	)
-	return err
+	err := r.db.QueryRow(context.Background(),
+		`INSERT INTO departments (tenant_id, name, parent_id, cost_center, manager_id)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING id`,
+		d.TenantID, d.Name, d.ParentID, d.CostCenter, d.ManagerID,
+	).Scan(&d.ID)
+	return mapDepartmentError(err)
 }
 
 func (r *DepartmentPostgresRepository) Update(d *domain.Department) error {
@@ -32,36 +74,49 @@ func (r *DepartmentPostgresRepository) Update(d *domain.Department) error {
 		`UPDATE departments 
 		 SET name = $1,
 		     manager_id = $2,
+		     cost_center = $3,
 		     updated_at = NOW()
-		 WHERE id = $3`,
-		d.Name, d.ManagerID, d.ID,
+		 WHERE id = $4`,
+		d.Name, d.ManagerID, d.CostCenter, d.ID,
 	)
+	return mapDepartmentError(err)
+}
+
+func mapDepartmentError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		// unique index on (tenant_id, cost_center)
+		return departmentrepository.ErrCostCenterExists
+	}
 	return err
 }
 
-func scanDepartment(row pgx.Row) (*domain.Department, error) {
+const departmentColumns = `id, tenant_id, name, parent_id, cost_center, manager_id, created_at, updated_at`
+
+func scanDepartment(row pgx.Row, extra ...any) (*domain.Department, error) {
 	var d domain.Department
-	var mgr *string
 
-	err := row.Scan(
+	dest := []any{
 		&d.ID,
+		&d.TenantID,
 		&d.Name,
-		&mgr,
+		&d.ParentID,
+		&d.CostCenter,
+		&d.ManagerID,
 		&d.CreatedAt,
 		&d.UpdatedAt,
-	)
-	if err != nil {
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
 		return nil, err
 	}
 
-	d.ManagerID = mgr
 	return &d, nil
 }
 
 func (r *DepartmentPostgresRepository) FindByID(id string) (*domain.Department, error) {
 	return scanDepartment(
 		r.db.QueryRow(context.Background(),
-			`SELECT id, name, manager_id, created_at, updated_at
+			`SELECT `+departmentColumns+`
 			 FROM departments WHERE id = $1`,
 			id,
 		),
@@ -71,7 +126,7 @@ func (r *DepartmentPostgresRepository) FindByID(id string) (*domain.Department,
 func (r *DepartmentPostgresRepository) FindByName(name string) (*domain.Department, error) {
 	return scanDepartment(
 		r.db.QueryRow(context.Background(),
-			`SELECT id, name, manager_id, created_at, updated_at
+			`SELECT `+departmentColumns+`
 			 FROM departments WHERE name = $1`,
 			name,
 		),
@@ -80,7 +135,7 @@ func (r *DepartmentPostgresRepository) FindByName(name string) (*domain.Departme
 
 func (r *DepartmentPostgresRepository) ListAll() ([]*domain.Department, error) {
 	rows, err := r.db.Query(context.Background(),
-		`SELECT id, name, manager_id, created_at, updated_at
+		`SELECT `+departmentColumns+`
 		 FROM departments
 		 ORDER BY name ASC`)
 	if err != nil {
@@ -167,3 +222,155 @@ func (r *DepartmentPostgresRepository) ListAll() ([]*domain.Department, error) {
 
 	return results, nil
 }
+
+func (r *DepartmentPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Department, error) {
+	d, err := scanDepartment(r.queryRow(ctx,
+		`SELECT `+departmentColumns+`
+		 FROM departments WHERE id = $1`,
+		id,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, departmentrepository.ErrDepartmentNotFound
+	}
+	return d, err
+}
+
+func (r *DepartmentPostgresRepository) LockTree(ctx context.Context, tenantID *string) error {
+	_, err := r.exec(ctx,
+		`SELECT 1 FROM departments
+		 WHERE tenant_id IS NOT DISTINCT FROM $1
+		 FOR UPDATE`,
+		tenantID,
+	)
+	return err
+}
+
+func (r *DepartmentPostgresRepository) ListDescendants(ctx context.Context, id string) ([]*domain.Descendant, error) {
+	rows, err := r.query(ctx,
+		`WITH RECURSIVE tree AS (
+			SELECT id, 1 AS depth, ARRAY[parent_id, id] AS path
+			FROM departments
+			WHERE parent_id = $1
+			UNION ALL
+			SELECT d.id, t.depth + 1, t.path || d.id
+			FROM departments d
+			JOIN tree t ON d.parent_id = t.id
+			WHERE NOT d.id = ANY(t.path)
+		 )
+		 SELECT `+departmentColumns+`, t.depth
+		 FROM tree t
+		 JOIN departments USING (id)
+		 ORDER BY t.depth, name`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Descendant
+	for rows.Next() {
+		var depth int
+		d, err := scanDepartment(rows, &depth)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &domain.Descendant{Department: d, Depth: depth})
+	}
+
+	return result, rows.Err()
+}
+
+// ListOn takes a department's parent on day from the first move after
+// day; without one the parent of today applies.
+func (r *DepartmentPostgresRepository) ListOn(
+	ctx context.Context,
+	tenantID string,
+	day time.Time,
+) ([]*domain.Department, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT d.id, d.tenant_id, d.name,
+		        CASE WHEN m.id IS NULL THEN d.parent_id ELSE m.from_parent_id END,
+		        d.cost_center, d.manager_id, d.created_at, d.updated_at
+		 FROM departments d
+		 LEFT JOIN LATERAL (
+			SELECT id, from_parent_id
+			FROM department_moves
+			WHERE department_id = d.id AND effective_from > $2
+			ORDER BY effective_from, created_at
+			LIMIT 1
+		 ) m ON TRUE
+		 WHERE d.tenant_id = $1
+		 ORDER BY d.name`,
+		tenantID, day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Department
+	for rows.Next() {
+		d, err := scanDepartment(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *DepartmentPostgresRepository) Move(ctx context.Context, m *domain.Move) error {
+	tag, err := r.exec(ctx,
+		`UPDATE departments
+		 SET parent_id = $1, updated_at = NOW()
+		 WHERE id = $2`,
+		m.ToParentID, m.DepartmentID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return departmentrepository.ErrDepartmentNotFound
+	}
+
+	return r.queryRow(ctx,
+		`INSERT INTO department_moves (
+			department_id, from_parent_id, to_parent_id, effective_from, changed_by, created_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id`,
+		m.DepartmentID, m.FromParentID, m.ToParentID, m.EffectiveFrom, m.ChangedBy, m.CreatedAt,
+	).Scan(&m.ID)
+}
+
+func (r *DepartmentPostgresRepository) ListMoves(ctx context.Context, departmentID string) ([]*domain.Move, error) {
+	rows, err := r.query(ctx,
+		`SELECT id, department_id, from_parent_id, to_parent_id, effective_from,
+		        COALESCE(changed_by::text, ''), created_at
+		 FROM department_moves
+		 WHERE department_id = $1
+		 ORDER BY effective_from DESC, created_at DESC`,
+		departmentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Move
+	for rows.Next() {
+		var m domain.Move
+		if err := rows.Scan(
+			&m.ID, &m.DepartmentID, &m.FromParentID, &m.ToParentID, &m.EffectiveFrom,
+			&m.ChangedBy, &m.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		result = append(result, &m)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/dependent/domain"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type DependentPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ dependentrepository.DependentRepository = (*DependentPostgresRepository)(nil)
+
+func NewDependentPostgresRepository(db *pgxpool.Pool) *DependentPostgresRepository {
+	return &DependentPostgresRepository{db: db}
+}
+
+func (r *DependentPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *DependentPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *DependentPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *DependentPostgresRepository) Create(ctx context.Context, d *domain.Dependent) error {
+	return r.queryRow(ctx,
+		`INSERT INTO employee_dependents (
+			employee_id, full_name, relationship, date_of_birth, tax_code,
+			registered_from, registered_until, created_at, updated_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 RETURNING id`,
+		d.EmployeeID, d.FullName, d.Relationship, d.DateOfBirth, d.TaxCode,
+		d.RegisteredFrom, d.RegisteredUntil, d.CreatedAt, d.UpdatedAt,
+	).Scan(&d.ID)
+}
+
+func (r *DependentPostgresRepository) Update(ctx context.Context, d *domain.Dependent) error {
+	tag, err := r.exec(ctx,
+		`UPDATE employee_dependents
+		 SET full_name = $1, relationship = $2, date_of_birth = $3, tax_code = $4,
+		     registered_from = $5, registered_until = $6, updated_at = $7
+		 WHERE id = $8`,
+		d.FullName, d.Relationship, d.DateOfBirth, d.TaxCode,
+		d.RegisteredFrom, d.RegisteredUntil, d.UpdatedAt,
+		d.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return dependentrepository.ErrDependentNotFound
+	}
+	return nil
+}
+
+func (r *DependentPostgresRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.exec(ctx, `DELETE FROM employee_dependents WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return dependentrepository.ErrDependentNotFound
+	}
+	return nil
+}
+
+const dependentColumns = `id, employee_id, full_name, relationship, date_of_birth, tax_code,
+	registered_from, registered_until, created_at, updated_at`
+
+func scanDependent(row pgx.Row) (*domain.Dependent, error) {
+	var d domain.Dependent
+
+	err := row.Scan(
+		&d.ID, &d.EmployeeID, &d.FullName, &d.Relationship, &d.DateOfBirth, &d.TaxCode,
+		&d.RegisteredFrom, &d.RegisteredUntil, &d.CreatedAt, &d.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (r *DependentPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Dependent, error) {
+	d, err := scanDependent(r.queryRow(ctx,
+		`SELECT `+dependentColumns+` FROM employee_dependents WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, dependentrepository.ErrDependentNotFound
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (r *DependentPostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Dependent, error) {
+	rows, err := r.query(ctx,
+		`SELECT `+dependentColumns+`
+		 FROM employee_dependents
+		 WHERE employee_id = $1
+		 ORDER BY registered_from, full_name`,
+		employeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Dependent
+	for rows.Next() {
+		d, err := scanDependent(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/domain"
+	emergencycontactrepository "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type EmergencyContactPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ emergencycontactrepository.EmergencyContactRepository = (*EmergencyContactPostgresRepository)(nil)
+
+func NewEmergencyContactPostgresRepository(db *pgxpool.Pool) *EmergencyContactPostgresRepository {
+	return &EmergencyContactPostgresRepository{db: db}
+}
+
+func (r *EmergencyContactPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *EmergencyContactPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *EmergencyContactPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *EmergencyContactPostgresRepository) Create(ctx context.Context, c *domain.EmergencyContact) error {
+	return r.queryRow(ctx,
+		`INSERT INTO employee_emergency_contacts (
+			employee_id, full_name, relationship, phone, email, address,
+			priority, created_at, updated_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 RETURNING id`,
+		c.EmployeeID, c.FullName, c.Relationship, c.Phone, c.Email, c.Address,
+		c.Priority, c.CreatedAt, c.UpdatedAt,
+	).Scan(&c.ID)
+}
+
+func (r *EmergencyContactPostgresRepository) Update(ctx context.Context, c *domain.EmergencyContact) error {
+	tag, err := r.exec(ctx,
+		`UPDATE employee_emergency_contacts
+		 SET full_name = $1, relationship = $2, phone = $3, email = $4,
+		     address = $5, priority = $6, updated_at = $7
+		 WHERE id = $8`,
+		c.FullName, c.Relationship, c.Phone, c.Email,
+		c.Address, c.Priority, c.UpdatedAt,
+		c.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return emergencycontactrepository.ErrEmergencyContactNotFound
+	}
+	return nil
+}
+
+func (r *EmergencyContactPostgresRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.exec(ctx, `DELETE FROM employee_emergency_contacts WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return emergencycontactrepository.ErrEmergencyContactNotFound
+	}
+	return nil
+}
+
+const emergencyContactColumns = `id, employee_id, full_name, relationship, phone, email, address,
+	priority, created_at, updated_at`
+
+func scanEmergencyContact(row pgx.Row) (*domain.EmergencyContact, error) {
+	var c domain.EmergencyContact
+
+	err := row.Scan(
+		&c.ID, &c.EmployeeID, &c.FullName, &c.Relationship, &c.Phone, &c.Email, &c.Address,
+		&c.Priority, &c.CreatedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *EmergencyContactPostgresRepository) GetByID(ctx context.Context, id string) (*domain.EmergencyContact, error) {
+	c, err := scanEmergencyContact(r.queryRow(ctx,
+		`SELECT `+emergencyContactColumns+` FROM employee_emergency_contacts WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, emergencycontactrepository.ErrEmergencyContactNotFound
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *EmergencyContactPostgresRepository) ListByEmployeeID(
+	ctx context.Context,
+	employeeID string,
+) ([]*domain.EmergencyContact, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+emergencyContactColumns+`
+		 FROM employee_emergency_contacts
+		 WHERE employee_id = $1
+		 ORDER BY priority, created_at`,
+		employeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.EmergencyContact
+	for rows.Next() {
+		c, err := scanEmergencyContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	return result, rows.Err()
+}
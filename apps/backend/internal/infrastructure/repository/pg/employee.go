@@ -4,12 +4,16 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
 	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 )
 
 type EmployeePostgresRepository struct {
@@ -22,20 +26,32 @@ func NewEmployeePostgresRepository(db *pgxpool.Pool) *EmployeePostgresRepository
 	return &EmployeePostgresRepository{db: db}
 }
 
-func (r *EmployeePostgresRepository) Create(e *domain.Employee) (string, error) {
-	var id string
-	err := r.db.QueryRow(context.Background(),
-		`INSERT INTO employees
-	 (code, first_name, last_name, email, phone, date_of_birth, 
-	  position, employment_type, employment_status, join_date, base_salary)
-	 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
-	 RETURNING id`,
-		e.Code, e.FirstName, e.LastName, e.Email, e.Phone, e.DateOfBirth,
-		e.Position, domain.FullTime, domain.Active,
-		e.JoinDate, e.BaseSalary,
-	).Scan(&id)
+func (r *EmployeePostgresRepository) Create(ctx context.Context, e *domain.Employee) (string, error) {
+	query := `INSERT INTO employees
+	 (tenant_id, code, first_name, last_name, email, phone, date_of_birth,
+	  department_id, manager_id,
+	  position, employment_type, employment_status, join_date, base_salary, salary_currency,
+	  custom_fields, probation_end_date, address)
+	 VALUES (NULLIF($1, '')::uuid,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,
+	  COALESCE($16::jsonb, '{}'::jsonb), $17, $18)
+	 RETURNING id`
+	args := []any{
+		e.TenantID, e.Code, e.FirstName, e.LastName, e.Email, e.Phone, e.DateOfBirth,
+		e.DepartmentID, e.ManagerID,
+		e.Position, e.EmploymentType, e.EmploymentStatus,
+		e.JoinDate, e.BaseSalary, e.BaseSalary.Currency(),
+		customFieldsArg(e.CustomFields), e.ProbationEndDate, e.Address,
+	}
 
-	if err != nil {
+	var row pgx.Row
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		row = tx.QueryRow(ctx, query, args...)
+	} else {
+		row = r.db.QueryRow(ctx, query, args...)
+	}
+
+	var id string
+	if err := row.Scan(&id); err != nil {
 		return "", err
 	}
 
@@ -48,45 +64,86 @@ func (r *EmployeePostgresRepository) Delete(id string) error {
 	return err
 }
 
+// Update leaves the salary and the job alone; they change through the
+// compensation and employment histories. Nil custom fields and a nil
+// address are left as they are.
 func (r *EmployeePostgresRepository) Update(e *domain.Employee) error {
 	_, err := r.db.Exec(context.Background(),
 		`UPDATE employees SET
 		 code=$1, first_name=$2, last_name=$3, email=$4, phone=$5,
-		 date_of_birth=$6, department_id=$7, manager_id=$8, position=$9,
-		 employment_type=$10, employment_status=$11,
-		 join_date=$12, base_salary=$13
-		 WHERE id=$14`,
+		 date_of_birth=$6, join_date=$7, custom_fields=COALESCE($8::jsonb, custom_fields),
+		 address=COALESCE($9, address)
+		 WHERE id=$10`,
 		e.Code, e.FirstName, e.LastName, e.Email, e.Phone,
-		e.DateOfBirth, e.DepartmentID, e.ManagerID, e.Position,
-		e.EmploymentType, e.EmploymentStatus, e.JoinDate, e.BaseSalary,
-		e.ID)
+		e.DateOfBirth, e.JoinDate, customFieldsArg(e.CustomFields), e.Address, e.ID)
 	return err
 }
 
+// UpdateContact sets the phone and address, clearing the address when
+// nil; it joins the transaction in ctx.
+func (r *EmployeePostgresRepository) UpdateContact(ctx context.Context, e *domain.Employee) error {
+	query := `UPDATE employees SET phone = $1, address = $2, updated_at = $3 WHERE id = $4`
+	args := []any{e.Phone, e.Address, e.UpdatedAt, e.ID}
+
+	var (
+		tag pgconn.CommandTag
+		err error
+	)
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		tag, err = tx.Exec(ctx, query, args...)
+	} else {
+		tag, err = r.db.Exec(ctx, query, args...)
+	}
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// customFieldsArg passes nil custom fields as SQL NULL rather than the
+// JSON null a nil map would encode to.
+func customFieldsArg(values map[string]any) any {
+	if values == nil {
+		return nil
+	}
+	return values
+}
+
+// currentSalaryJoin exposes today's salary from the compensation history
+// as cs; employees without history fall back to their hire salary.
+const currentSalaryJoin = `LEFT JOIN employee_current_salaries cs ON cs.employee_id = e.id`
+
+// currentJobJoin exposes today's job from the employment history as cj.
+const currentJobJoin = `JOIN employee_current_jobs cj ON cj.employee_id = e.id`
+
+const currentJobColumns = `cj.department_id, cj.manager_id, cj.position, cj.employment_type, cj.employment_status`
+
+const currentSalaryColumns = `COALESCE(cs.base_salary, e.base_salary), COALESCE(cs.currency, e.salary_currency)`
+
 func ScanEmployee(row pgx.Row) (*domain.Employee, error) {
 	var e domain.Employee
+	var currency money.Currency
 	err := row.Scan(
 		&e.ID, &e.Code, &e.FirstName, &e.LastName, &e.Email, &e.Phone,
 		&e.DateOfBirth, &e.DepartmentID, &e.ManagerID, &e.Position,
 		&e.EmploymentType, &e.EmploymentStatus, &e.JoinDate, &e.BaseSalary,
-		&e.CreatedAt, &e.UpdatedAt, &e.DepartmentName,
+		&currency, &e.CreatedAt, &e.UpdatedAt, &e.DepartmentName,
+		&e.TenantID, &e.CustomFields, &e.ProbationEndDate, &e.Address,
 	)
 	if err != nil {
 		return nil, err
 	}
+	e.BaseSalary = e.BaseSalary.WithCurrency(currency)
 	return &e, nil
 }
 
-func (r *EmployeePostgresRepository) Find(
-	tenantId string,
-	name string,
-	email string,
-	code string,
-	departmentIds []string,
-	page int,
-	limit int,
-) ([]*domain.Employee, int, int, error) {
-
+// employeeFilterWhere builds the WHERE clause of Find and Each; the
+// placeholders start at $1.
+func employeeFilterWhere(f domain.Filter) (string, []any) {
 	var (
 		orClauses  []string
 		andClauses []string
@@ -94,7 +151,7 @@ func (r *EmployeePostgresRepository) Find(
 		idx        = 1
 	)
 
-	if name != "" {
+	if f.Name != "" {
 		orClauses = append(orClauses,
 			fmt.Sprintf(`
 					(
@@ -105,24 +162,24 @@ func (r *EmployeePostgresRepository) Find(
 				`, idx, idx+1, idx+2),
 		)
 
-		pattern := "%" + name + "%"
+		pattern := "%" + f.Name + "%"
 		args = append(args, pattern, pattern, pattern)
 		idx += 3
 	}
 
-	if email != "" {
+	if f.Email != "" {
 		orClauses = append(orClauses,
 			fmt.Sprintf("e.email ILIKE $%d", idx),
 		)
-		args = append(args, "%"+email+"%")
+		args = append(args, "%"+f.Email+"%")
 		idx++
 	}
 
-	if code != "" {
+	if f.Code != "" {
 		orClauses = append(orClauses,
 			fmt.Sprintf("e.code ILIKE $%d", idx),
 		)
-		args = append(args, "%"+code+"%")
+		args = append(args, "%"+f.Code+"%")
 		idx++
 	}
 
@@ -130,21 +187,41 @@ func (r *EmployeePostgresRepository) Find(
 		andClauses = append(andClauses, "("+strings.Join(orClauses, " OR ")+")")
 	}
 
-	if len(departmentIds) > 0 {
+	if len(f.DepartmentIDs) > 0 {
 		andClauses = append(andClauses,
-			fmt.Sprintf("e.department_id = ANY($%d)", idx),
+			fmt.Sprintf("cj.department_id = ANY($%d)", idx),
 		)
-		args = append(args, departmentIds)
+		args = append(args, f.DepartmentIDs)
 		idx++
 	}
 
-	countQuery := `
-			SELECT COUNT(*)
+	keys := make([]string, 0, len(f.CustomFields))
+	for key := range f.CustomFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		andClauses = append(andClauses,
+			fmt.Sprintf("lower(e.custom_fields ->> $%d) = lower($%d)", idx, idx+1),
+		)
+		args = append(args, key, f.CustomFields[key])
+		idx += 2
+	}
+
+	andClauses = append(andClauses, fmt.Sprintf("e.tenant_id = $%d", idx))
+	args = append(args, f.TenantID)
+
+	return " WHERE " + strings.Join(andClauses, " AND "), args
+}
+
+const employeeFilterFrom = `
 			FROM employees e
-			LEFT JOIN departments d ON e.department_id = d.id
+			` + currentJobJoin + `
+			LEFT JOIN departments d ON cj.department_id = d.id
 		`
 
-	query := `
+const employeeFilterSelect = `
 			SELECT
 				e.id,
 				e.code,
@@ -153,36 +230,29 @@ func (r *EmployeePostgresRepository) Find(
 				e.email,
 				e.phone,
 				e.date_of_birth,
-				e.department_id,
-				e.manager_id,
-				e.position,
-				e.employment_type,
-				e.employment_status,
+				` + currentJobColumns + `,
 				e.join_date,
-				e.base_salary,
+				` + currentSalaryColumns + `,
 				e.created_at,
 				e.updated_at,
-				d.name
-			FROM employees e
-			LEFT JOIN departments d ON e.department_id = d.id
-		`
+				d.name,
+				COALESCE(e.tenant_id::text, ''),
+				e.custom_fields,
+				e.probation_end_date,
+				e.address
+		` + employeeFilterFrom + currentSalaryJoin
 
-	if len(andClauses) > 0 {
-		where := strings.Join(andClauses, " AND ")
-		countQuery += " WHERE " + where
-		query += " WHERE " + where
-	}
+func (r *EmployeePostgresRepository) Find(
+	f domain.Filter,
+	page int,
+	limit int,
+) ([]*domain.Employee, int, int, error) {
 
-	if len(andClauses) > 0 {
-		countQuery += fmt.Sprintf(" AND e.tenant_id = $%d", idx)
-		query += fmt.Sprintf(" AND e.tenant_id = $%d", idx)
-	} else {
-		countQuery += fmt.Sprintf(" WHERE e.tenant_id = $%d", idx)
-		query += fmt.Sprintf(" WHERE e.tenant_id = $%d", idx)
-	}
+	where, args := employeeFilterWhere(f)
+	idx := len(args) + 1
 
-	args = append(args, tenantId)
-	idx++
+	countQuery := `SELECT COUNT(*)` + employeeFilterFrom + where
+	query := employeeFilterSelect + where
 
 	var totalItems int
 	err := r.db.QueryRow(context.Background(), countQuery, args...).Scan(&totalItems)
@@ -218,15 +288,49 @@ func (r *EmployeePostgresRepository) Find(
 	return result, totalPages, totalItems, nil
 }
 
+func (r *EmployeePostgresRepository) Count(ctx context.Context, f domain.Filter) (int, error) {
+	where, args := employeeFilterWhere(f)
+
+	var n int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*)`+employeeFilterFrom+where, args...).Scan(&n)
+	return n, err
+}
+
+// Each scans the rows as they arrive instead of collecting them, so the
+// whole tenant can be exported without holding it in memory.
+func (r *EmployeePostgresRepository) Each(ctx context.Context, f domain.Filter, fn func(*domain.Employee) error) error {
+	where, args := employeeFilterWhere(f)
+
+	rows, err := r.db.Query(ctx, employeeFilterSelect+where+` ORDER BY e.code ASC`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e, err := ScanEmployee(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 func (r *EmployeePostgresRepository) FindByID(id string) (*domain.Employee, error) {
 	return ScanEmployee(
 		r.db.QueryRow(context.Background(),
 			`SELECT e.id, e.code, e.first_name, e.last_name, e.email, e.phone, e.date_of_birth,
-		       e.department_id, e.manager_id, e.position, e.employment_type,
-		       e.employment_status, e.join_date, e.base_salary,
-		       e.created_at, e.updated_at, d.name
+		       `+currentJobColumns+`,
+		       e.join_date, `+currentSalaryColumns+`,
+		       e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
 			FROM employees e
-			LEFT JOIN departments d ON e.department_id = d.id 
+			`+currentJobJoin+`
+			LEFT JOIN departments d ON cj.department_id = d.id
+			`+currentSalaryJoin+`
 			WHERE e.id = $1`, id),
 	)
 }
@@ -234,34 +338,43 @@ func (r *EmployeePostgresRepository) FindByID(id string) (*domain.Employee, erro
 func (r *EmployeePostgresRepository) FindByEmail(email string) (*domain.Employee, error) {
 	return ScanEmployee(
 		r.db.QueryRow(context.Background(),
-			`SELECT id, code, first_name, last_name, email, phone, date_of_birth,
-			        department_id, manager_id, position, employment_type,
-			        employment_status, join_date, base_salary,
-			        created_at, updated_at
-			       FROM employees WHERE email=$1`, email),
+			`SELECT e.id, e.code, e.first_name, e.last_name, e.email, e.phone, e.date_of_birth,
+			        `+currentJobColumns+`,
+			        e.join_date, `+currentSalaryColumns+`,
+			        e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
+			   FROM employees e
+			   `+currentJobJoin+`
+			   LEFT JOIN departments d ON cj.department_id = d.id
+			   `+currentSalaryJoin+`
+			   WHERE e.email=$1`, email),
 	)
 }
 
 func (r *EmployeePostgresRepository) FindByCode(code string) (*domain.Employee, error) {
 	return ScanEmployee(
 		r.db.QueryRow(context.Background(),
-			`SELECT id, code, first_name, last_name, email, phone, date_of_birth,
-			        department_id, manager_id, position, employment_type,
-			        employment_status, join_date, base_salary,
-			        created_at, updated_at
-			       FROM employees WHERE code=$1`, code),
+			`SELECT e.id, e.code, e.first_name, e.last_name, e.email, e.phone, e.date_of_birth,
+			        `+currentJobColumns+`,
+			        e.join_date, `+currentSalaryColumns+`,
+			        e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
+			   FROM employees e
+			   `+currentJobJoin+`
+			   LEFT JOIN departments d ON cj.department_id = d.id
+			   `+currentSalaryJoin+`
+			   WHERE e.code=$1`, code),
 	)
 }
 
 func (r *EmployeePostgresRepository) ListAll() ([]*domain.Employee, error) {
 	rows, err := r.db.Query(context.Background(),
 		`SELECT e.id, code, first_name, last_name, email, phone, date_of_birth,
-		        department_id, e.manager_id, position, employment_type,
-		        employment_status, join_date, base_salary,
-		        e.created_at, e.updated_at, d.name
-		   FROM employees e 
-		   LEFT JOIN departments d 
-		   ON e.department_id = d.id`)
+		        `+currentJobColumns+`,
+		        join_date, `+currentSalaryColumns+`,
+		        e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
+		   FROM employees e
+		   `+currentJobJoin+`
+		   LEFT JOIN departments d ON cj.department_id = d.id
+		   `+currentSalaryJoin)
 	if err != nil {
 		return nil, err
 	}
@@ -281,12 +394,14 @@ func (r *EmployeePostgresRepository) ListAll() ([]*domain.Employee, error) {
 func (r *EmployeePostgresRepository) ListByDepartment(deptID string) ([]*domain.Employee, error) {
 	rows, err := r.db.Query(context.Background(),
 		`SELECT e.id, code, first_name, last_name, email, phone, date_of_birth,
-		        department_id, e.manager_id, position, employment_type,
-		        employment_status, join_date, base_salary,
-		        e.created_at, e.updated_at, d.name
-		   FROM employees e 
-		   LEFT JOIN departments d 
-		   ON e.department_id = d.id WHERE department_id=$1`, deptID)
+		        `+currentJobColumns+`,
+		        join_date, `+currentSalaryColumns+`,
+		        e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
+		   FROM employees e
+		   `+currentJobJoin+`
+		   LEFT JOIN departments d ON cj.department_id = d.id
+		   `+currentSalaryJoin+`
+		   WHERE cj.department_id=$1`, deptID)
 	if err != nil {
 		return nil, err
 	}
@@ -302,3 +417,86 @@ func (r *EmployeePostgresRepository) ListByDepartment(deptID string) ([]*domain.
 	}
 	return result, nil
 }
+
+func (r *EmployeePostgresRepository) ListActiveByTenant(ctx context.Context, tenantID string) ([]*domain.Employee, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT e.id, code, first_name, last_name, email, phone, date_of_birth,
+		        `+currentJobColumns+`,
+		        join_date, `+currentSalaryColumns+`,
+		        e.created_at, e.updated_at, d.name, COALESCE(e.tenant_id::text, ''), e.custom_fields, e.probation_end_date, e.address
+		   FROM employees e
+		   `+currentJobJoin+`
+		   LEFT JOIN departments d ON cj.department_id = d.id
+		   `+currentSalaryJoin+`
+		   WHERE e.tenant_id = $1 AND cj.employment_status IN ($2, $3)
+		   ORDER BY e.code ASC`, tenantID, domain.Active, domain.Probation)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Employee
+	for rows.Next() {
+		e, err := ScanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+	return result, rows.Err()
+}
+
+// likeEscaper escapes the LIKE wildcards of a search query.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search matches the folded query against search_text as a substring or,
+// for misspellings, by trigram word similarity; the trigram index serves
+// both. Exact codes and e-mails rank first, then names starting with the
+// query, then substring matches, each ordered by similarity.
+func (r *EmployeePostgresRepository) Search(
+	ctx context.Context,
+	q domain.SearchQuery,
+) ([]*domain.SearchHit, int, error) {
+
+	rows, err := r.db.Query(ctx,
+		`SELECT e.id, e.code, e.first_name || ' ' || e.last_name, e.email,
+		        COALESCE(e.phone, ''), cj.position, d.name, cj.employment_status,
+		        (word_similarity(lower(immutable_unaccent($2)), e.search_text)
+		         + CASE WHEN lower(e.code) = lower($2) OR lower(e.email) = lower($2) THEN 3 ELSE 0 END
+		         + CASE WHEN e.search_name LIKE lower(immutable_unaccent($3)) || '%' THEN 2 ELSE 0 END
+		         + CASE WHEN e.search_text LIKE '%' || lower(immutable_unaccent($3)) || '%' THEN 1 ELSE 0 END
+		        )::float8 AS score,
+		        COUNT(*) OVER ()
+		 FROM employees e
+		 `+currentJobJoin+`
+		 LEFT JOIN departments d ON cj.department_id = d.id
+		 WHERE e.tenant_id = $1
+		   AND (e.search_text LIKE '%' || lower(immutable_unaccent($3)) || '%'
+		        OR lower(immutable_unaccent($2)) <% e.search_text)
+		 ORDER BY score DESC, e.code
+		 OFFSET $4 LIMIT $5`,
+		q.TenantID, q.Text, likeEscaper.Replace(q.Text), q.Offset, q.Limit,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		hits  []*domain.SearchHit
+		total int
+	)
+	for rows.Next() {
+		var h domain.SearchHit
+		if err := rows.Scan(
+			&h.ID, &h.Code, &h.Name, &h.Email,
+			&h.Phone, &h.Position, &h.DepartmentName, &h.EmploymentStatus,
+			&h.Score, &total,
+		); err != nil {
+			return nil, 0, err
+		}
+		hits = append(hits, &h)
+	}
+
+	return hits, total, rows.Err()
+}
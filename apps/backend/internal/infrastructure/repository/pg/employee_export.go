@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+)
+
+type EmployeeExportPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ employeeexportrepository.ExportRepository = (*EmployeeExportPostgresRepository)(nil)
+
+func NewEmployeeExportPostgresRepository(db *pgxpool.Pool) *EmployeeExportPostgresRepository {
+	return &EmployeeExportPostgresRepository{db: db}
+}
+
+func (r *EmployeeExportPostgresRepository) Create(ctx context.Context, e *domain.Export) error {
+	columnsJSON, err := json.Marshal(e.Columns)
+	if err != nil {
+		return err
+	}
+	filterJSON, err := json.Marshal(e.Filter)
+	if err != nil {
+		return err
+	}
+
+	return r.db.QueryRow(ctx,
+		`INSERT INTO employee_exports (
+			tenant_id, format, columns, filter, status, requested_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7)
+		RETURNING id`,
+		e.TenantID, e.Format, columnsJSON, filterJSON, e.Status, e.RequestedBy, e.CreatedAt,
+	).Scan(&e.ID)
+}
+
+func (r *EmployeeExportPostgresRepository) Save(ctx context.Context, e *domain.Export) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE employee_exports
+		 SET status = $1, row_count = $2, file_path = $3, error = $4,
+		     started_at = $5, finished_at = $6
+		 WHERE id = $7`,
+		e.Status, e.RowCount, e.FilePath, e.Error,
+		e.StartedAt, e.FinishedAt,
+		e.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return employeeexportrepository.ErrExportNotFound
+	}
+
+	return nil
+}
+
+const employeeExportColumns = `id, tenant_id, format, columns, filter, status, row_count,
+	file_path, error, COALESCE(requested_by::text, ''), created_at, started_at, finished_at`
+
+func scanEmployeeExport(row pgx.Row) (*domain.Export, error) {
+	var e domain.Export
+	var columnsJSON, filterJSON []byte
+
+	err := row.Scan(
+		&e.ID, &e.TenantID, &e.Format, &columnsJSON, &filterJSON, &e.Status, &e.RowCount,
+		&e.FilePath, &e.Error, &e.RequestedBy, &e.CreatedAt, &e.StartedAt, &e.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(columnsJSON, &e.Columns); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(filterJSON, &e.Filter); err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+func (r *EmployeeExportPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Export, error) {
+	e, err := scanEmployeeExport(r.db.QueryRow(ctx,
+		`SELECT `+employeeExportColumns+` FROM employee_exports WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employeeexportrepository.ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (r *EmployeeExportPostgresRepository) ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Export, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+employeeExportColumns+`
+		 FROM employee_exports
+		 WHERE tenant_id = $1
+		 ORDER BY created_at DESC`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Export
+	for rows.Next() {
+		e, err := scanEmployeeExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *EmployeeExportPostgresRepository) Claim(ctx context.Context, id string) (*domain.Export, error) {
+	e, err := scanEmployeeExport(r.db.QueryRow(ctx,
+		`UPDATE employee_exports
+		 SET status = 'RUNNING', started_at = NOW()
+		 WHERE id = $1 AND status = 'PENDING'
+		 RETURNING `+employeeExportColumns,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employeeexportrepository.ErrExportNotPending
+		}
+		return nil, err
+	}
+
+	return e, nil
+}
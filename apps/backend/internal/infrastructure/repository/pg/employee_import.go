@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+)
+
+type EmployeeImportPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ employeeimportrepository.ImportRepository = (*EmployeeImportPostgresRepository)(nil)
+
+func NewEmployeeImportPostgresRepository(db *pgxpool.Pool) *EmployeeImportPostgresRepository {
+	return &EmployeeImportPostgresRepository{db: db}
+}
+
+func (r *EmployeeImportPostgresRepository) Create(ctx context.Context, i *domain.Import) error {
+	optionsJSON, err := json.Marshal(i.Options)
+	if err != nil {
+		return err
+	}
+	errorsJSON, err := json.Marshal(i.Errors)
+	if err != nil {
+		return err
+	}
+
+	return r.db.QueryRow(ctx,
+		`INSERT INTO employee_imports (
+			tenant_id, file_path, options, status, errors, requested_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7)
+		RETURNING id`,
+		i.TenantID, i.FilePath, optionsJSON, i.Status, errorsJSON, i.RequestedBy, i.CreatedAt,
+	).Scan(&i.ID)
+}
+
+func (r *EmployeeImportPostgresRepository) Save(ctx context.Context, i *domain.Import) error {
+	errorsJSON, err := json.Marshal(i.Errors)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE employee_imports
+		 SET status = $1, total_rows = $2, processed_rows = $3, imported_rows = $4,
+		     failed_rows = $5, errors = $6, error = $7, started_at = $8, finished_at = $9
+		 WHERE id = $10`,
+		i.Status, i.TotalRows, i.ProcessedRows, i.ImportedRows,
+		i.FailedRows, errorsJSON, i.Error, i.StartedAt, i.FinishedAt,
+		i.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return employeeimportrepository.ErrImportNotFound
+	}
+
+	return nil
+}
+
+const employeeImportColumns = `id, tenant_id, file_path, options, status, total_rows,
+	processed_rows, imported_rows, failed_rows, errors, error,
+	COALESCE(requested_by::text, ''), created_at, started_at, finished_at`
+
+func scanEmployeeImport(row pgx.Row) (*domain.Import, error) {
+	var i domain.Import
+	var optionsJSON, errorsJSON []byte
+
+	err := row.Scan(
+		&i.ID, &i.TenantID, &i.FilePath, &optionsJSON, &i.Status, &i.TotalRows,
+		&i.ProcessedRows, &i.ImportedRows, &i.FailedRows, &errorsJSON, &i.Error,
+		&i.RequestedBy, &i.CreatedAt, &i.StartedAt, &i.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(optionsJSON, &i.Options); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(errorsJSON, &i.Errors); err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+func (r *EmployeeImportPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Import, error) {
+	i, err := scanEmployeeImport(r.db.QueryRow(ctx,
+		`SELECT `+employeeImportColumns+` FROM employee_imports WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employeeimportrepository.ErrImportNotFound
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func (r *EmployeeImportPostgresRepository) ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Import, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+employeeImportColumns+`
+		 FROM employee_imports
+		 WHERE tenant_id = $1
+		 ORDER BY created_at DESC`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Import
+	for rows.Next() {
+		i, err := scanEmployeeImport(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *EmployeeImportPostgresRepository) Claim(ctx context.Context, id string) (*domain.Import, error) {
+	i, err := scanEmployeeImport(r.db.QueryRow(ctx,
+		`UPDATE employee_imports
+		 SET status = 'RUNNING', started_at = NOW()
+		 WHERE id = $1 AND status = 'PENDING'
+		 RETURNING `+employeeImportColumns,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employeeimportrepository.ErrImportNotPending
+		}
+		return nil, err
+	}
+
+	return i, nil
+}
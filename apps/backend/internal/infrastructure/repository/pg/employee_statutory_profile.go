@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type EmployeeStatutoryProfilePostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ statutoryrepository.EmployeeProfileRepository = (*EmployeeStatutoryProfilePostgresRepository)(nil)
+
+func NewEmployeeStatutoryProfilePostgresRepository(db *pgxpool.Pool) *EmployeeStatutoryProfilePostgresRepository {
+	return &EmployeeStatutoryProfilePostgresRepository{db: db}
+}
+
+func (r *EmployeeStatutoryProfilePostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *EmployeeStatutoryProfilePostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *EmployeeStatutoryProfilePostgresRepository) Upsert(
+	ctx context.Context,
+	p *domain.EmployeeProfile,
+) error {
+
+	_, err := r.exec(ctx,
+		`INSERT INTO employee_statutory_profiles (
+			employee_id,
+			wage_region,
+			dependents,
+			insurance_salary,
+			tax_code,
+			created_at,
+			updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (employee_id) DO UPDATE
+		SET wage_region = EXCLUDED.wage_region,
+		    dependents = EXCLUDED.dependents,
+		    insurance_salary = EXCLUDED.insurance_salary,
+		    tax_code = EXCLUDED.tax_code,
+		    updated_at = EXCLUDED.updated_at`,
+		p.EmployeeID,
+		p.WageRegion,
+		p.Dependents,
+		p.InsuranceSalary,
+		p.TaxCode,
+		p.CreatedAt,
+		p.UpdatedAt,
+	)
+	return err
+}
+
+func (r *EmployeeStatutoryProfilePostgresRepository) GetByEmployeeID(
+	ctx context.Context,
+	employeeID string,
+) (*domain.EmployeeProfile, error) {
+
+	var p domain.EmployeeProfile
+
+	err := r.queryRow(ctx,
+		`SELECT employee_id, wage_region, dependents, insurance_salary,
+		        tax_code, created_at, updated_at
+		 FROM employee_statutory_profiles
+		 WHERE employee_id = $1`,
+		employeeID,
+	).Scan(
+		&p.EmployeeID,
+		&p.WageRegion,
+		&p.Dependents,
+		&p.InsuranceSalary,
+		&p.TaxCode,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, statutoryrepository.ErrEmployeeProfileNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
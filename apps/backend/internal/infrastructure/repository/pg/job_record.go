@@ -0,0 +1,309 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type JobRecordPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ employmentrepository.JobRecordRepository = (*JobRecordPostgresRepository)(nil)
+
+func NewJobRecordPostgresRepository(db *pgxpool.Pool) *JobRecordPostgresRepository {
+	return &JobRecordPostgresRepository{db: db}
+}
+
+func (r *JobRecordPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *JobRecordPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *JobRecordPostgresRepository) LockReportingLines(ctx context.Context, tenantID string) error {
+	tx, ok := txpkg.TxFromContext(ctx)
+	if !ok {
+		return errors.New("locking reporting lines needs a transaction")
+	}
+
+	_, err := tx.Exec(ctx,
+		`SELECT pg_advisory_xact_lock(hashtext('employee_job_records:' || $1))`,
+		tenantID,
+	)
+	return err
+}
+
+func (r *JobRecordPostgresRepository) EffectiveDatesAfter(
+	ctx context.Context,
+	tenantID string,
+	day time.Time,
+) ([]time.Time, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT DISTINCT j.effective_from
+		 FROM employee_job_records j
+		 JOIN employees e ON e.id = j.employee_id
+		 WHERE e.tenant_id = $1 AND j.effective_from > $2
+		 ORDER BY j.effective_from`,
+		tenantID, day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var d time.Time
+		if err := rows.Scan(&d); err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+
+	return days, rows.Err()
+}
+
+func (r *JobRecordPostgresRepository) Create(ctx context.Context, j *domain.JobRecord) error {
+	return r.queryRow(ctx,
+		`INSERT INTO employee_job_records (
+			employee_id, effective_from, position, department_id, manager_id,
+			employment_type, employment_status, reason, note, changed_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, '')::uuid, $11)
+		RETURNING id`,
+		j.EmployeeID, j.EffectiveFrom, j.Position, j.DepartmentID, j.ManagerID,
+		j.EmploymentType, j.EmploymentStatus, j.Reason, j.Note, j.ChangedBy, j.CreatedAt,
+	).Scan(&j.ID)
+}
+
+const jobRecordColumns = `j.id, j.employee_id, j.effective_from, j.position, j.department_id,
+	j.manager_id, j.employment_type, j.employment_status, j.reason, j.note,
+	COALESCE(j.changed_by::text, ''), j.created_at`
+
+func scanJobRecord(row pgx.Row, extra ...any) (*domain.JobRecord, error) {
+	var j domain.JobRecord
+
+	dest := []any{
+		&j.ID, &j.EmployeeID, &j.EffectiveFrom, &j.Position, &j.DepartmentID,
+		&j.ManagerID, &j.EmploymentType, &j.EmploymentStatus, &j.Reason, &j.Note,
+		&j.ChangedBy, &j.CreatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &j, nil
+}
+
+func (r *JobRecordPostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) (domain.History, error) {
+	rows, err := r.query(ctx,
+		`SELECT `+jobRecordColumns+`
+		 FROM employee_job_records j
+		 WHERE j.employee_id = $1
+		 ORDER BY j.effective_from DESC, j.created_at DESC`,
+		employeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result domain.History
+	for rows.Next() {
+		j, err := scanJobRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, j)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *JobRecordPostgresRepository) ListInDepartmentOn(
+	ctx context.Context,
+	tenantID string,
+	departmentID string,
+	day time.Time,
+) ([]*domain.JobRecord, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+jobRecordColumns+`, e.code, e.first_name || ' ' || e.last_name, d.name
+		 FROM (
+			SELECT DISTINCT ON (employee_id) *
+			FROM employee_job_records
+			WHERE effective_from <= $3
+			ORDER BY employee_id, effective_from DESC, created_at DESC
+		 ) j
+		 JOIN employees e ON e.id = j.employee_id
+		 LEFT JOIN departments d ON d.id = j.department_id
+		 WHERE e.tenant_id = $1 AND j.department_id = $2
+		 ORDER BY e.code`,
+		tenantID, departmentID, day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.JobRecord
+	for rows.Next() {
+		var code, name string
+		var department *string
+
+		j, err := scanJobRecord(rows, &code, &name, &department)
+		if err != nil {
+			return nil, err
+		}
+
+		j.EmployeeCode, j.EmployeeName, j.DepartmentName = code, name, department
+		result = append(result, j)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *JobRecordPostgresRepository) ListByReason(
+	ctx context.Context,
+	tenantID string,
+	reason domain.ChangeReason,
+	from, to time.Time,
+) ([]*domain.JobRecord, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+jobRecordColumns+`, e.code, e.first_name || ' ' || e.last_name, d.name, j.previous_position
+		 FROM (
+			SELECT *,
+			       LAG(position) OVER (
+			           PARTITION BY employee_id
+			           ORDER BY effective_from, created_at
+			       ) AS previous_position
+			FROM employee_job_records
+		 ) j
+		 JOIN employees e ON e.id = j.employee_id
+		 LEFT JOIN departments d ON d.id = j.department_id
+		 WHERE e.tenant_id = $1
+		   AND j.reason = $2
+		   AND j.effective_from BETWEEN $3 AND $4
+		 ORDER BY j.effective_from, e.code`,
+		tenantID, reason, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.JobRecord
+	for rows.Next() {
+		var code, name string
+		var department, previous *string
+
+		j, err := scanJobRecord(rows, &code, &name, &department, &previous)
+		if err != nil {
+			return nil, err
+		}
+
+		j.EmployeeCode, j.EmployeeName, j.DepartmentName = code, name, department
+		j.PreviousPosition = previous
+		result = append(result, j)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *JobRecordPostgresRepository) ListOn(
+	ctx context.Context,
+	tenantID string,
+	day time.Time,
+) ([]*domain.JobRecord, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+jobRecordColumns+`
+		 FROM (
+			SELECT DISTINCT ON (employee_id) *
+			FROM employee_job_records
+			WHERE effective_from <= $2
+			ORDER BY employee_id, effective_from DESC, created_at DESC
+		 ) j
+		 JOIN employees e ON e.id = j.employee_id
+		 WHERE e.tenant_id = $1`,
+		tenantID, day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.JobRecord
+	for rows.Next() {
+		j, err := scanJobRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, j)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *JobRecordPostgresRepository) ReportingLinesOn(
+	ctx context.Context,
+	tenantID string,
+	day time.Time,
+) (domain.ReportingLines, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT j.employee_id, j.manager_id
+		 FROM (
+			SELECT DISTINCT ON (employee_id) employee_id, manager_id
+			FROM employee_job_records
+			WHERE effective_from <= $2
+			ORDER BY employee_id, effective_from DESC, created_at DESC
+		 ) j
+		 JOIN employees e ON e.id = j.employee_id
+		 WHERE e.tenant_id = $1 AND j.manager_id IS NOT NULL`,
+		tenantID, day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	lines := domain.ReportingLines{}
+	for rows.Next() {
+		var employeeID, managerID string
+		if err := rows.Scan(&employeeID, &managerID); err != nil {
+			return nil, err
+		}
+		lines[employeeID] = managerID
+	}
+
+	return lines, rows.Err()
+}
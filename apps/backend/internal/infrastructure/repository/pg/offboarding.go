@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type OffboardingPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ offboardingrepository.OffboardingRepository = (*OffboardingPostgresRepository)(nil)
+
+func NewOffboardingPostgresRepository(db *pgxpool.Pool) *OffboardingPostgresRepository {
+	return &OffboardingPostgresRepository{db: db}
+}
+
+func (r *OffboardingPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *OffboardingPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *OffboardingPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *OffboardingPostgresRepository) Create(ctx context.Context, o *domain.Offboarding) error {
+	err := r.queryRow(ctx,
+		`INSERT INTO employee_offboardings (
+			tenant_id, employee_id, last_working_day, reason, note, status,
+			leave_balances, leave_payout, currency, initiated_by, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id`,
+		o.TenantID, o.EmployeeID, o.LastWorkingDay, o.Reason, o.Note, o.Status,
+		o.LeaveBalances, o.LeavePayout, o.LeavePayout.Currency(), o.InitiatedBy, o.CreatedAt, o.UpdatedAt,
+	).Scan(&o.ID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return offboardingrepository.ErrOffboardingExists
+		}
+		return err
+	}
+
+	for _, t := range o.Tasks {
+		t.OffboardingID = o.ID
+		if err := r.queryRow(ctx,
+			`INSERT INTO employee_offboarding_tasks (
+				offboarding_id, kind, title, position, done_at, done_by, note
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id`,
+			t.OffboardingID, t.Kind, t.Title, t.Position, t.DoneAt, t.DoneBy, t.Note,
+		).Scan(&t.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *OffboardingPostgresRepository) Update(ctx context.Context, o *domain.Offboarding) error {
+	tag, err := r.exec(ctx,
+		`UPDATE employee_offboardings
+		 SET status = $1, sessions_revoked_at = $2, completed_at = $3, updated_at = $4
+		 WHERE id = $5`,
+		o.Status, o.SessionsRevokedAt, o.CompletedAt, o.UpdatedAt,
+		o.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return offboardingrepository.ErrOffboardingNotFound
+	}
+
+	for _, t := range o.Tasks {
+		if _, err := r.exec(ctx,
+			`UPDATE employee_offboarding_tasks
+			 SET done_at = $1, done_by = $2, note = $3
+			 WHERE id = $4`,
+			t.DoneAt, t.DoneBy, t.Note,
+			t.ID,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const offboardingColumns = `o.id, o.tenant_id, o.employee_id, o.last_working_day, o.reason,
+	o.note, o.status, o.leave_balances, o.leave_payout, o.currency,
+	o.sessions_revoked_at, o.initiated_by, o.completed_at, o.created_at, o.updated_at,
+	e.code, e.first_name || ' ' || e.last_name`
+
+func scanOffboarding(row pgx.Row, extra ...any) (*domain.Offboarding, error) {
+	var o domain.Offboarding
+	var currency money.Currency
+
+	dest := []any{
+		&o.ID, &o.TenantID, &o.EmployeeID, &o.LastWorkingDay, &o.Reason,
+		&o.Note, &o.Status, &o.LeaveBalances, &o.LeavePayout, &currency,
+		&o.SessionsRevokedAt, &o.InitiatedBy, &o.CompletedAt, &o.CreatedAt, &o.UpdatedAt,
+		&o.EmployeeCode, &o.EmployeeName,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	o.LeavePayout = o.LeavePayout.WithCurrency(currency)
+	return &o, nil
+}
+
+// loadTasks fills the checklists of offboardings.
+func (r *OffboardingPostgresRepository) loadTasks(ctx context.Context, offboardings []*domain.Offboarding) error {
+	if len(offboardings) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*domain.Offboarding, len(offboardings))
+	ids := make([]string, 0, len(offboardings))
+	for _, o := range offboardings {
+		o.Tasks = []*domain.Task{}
+		byID[o.ID] = o
+		ids = append(ids, o.ID)
+	}
+
+	rows, err := r.query(ctx,
+		`SELECT id, offboarding_id, kind, title, position, done_at, done_by, note
+		 FROM employee_offboarding_tasks
+		 WHERE offboarding_id = ANY($1::uuid[])
+		 ORDER BY position, id`,
+		ids,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t domain.Task
+		if err := rows.Scan(
+			&t.ID, &t.OffboardingID, &t.Kind, &t.Title, &t.Position, &t.DoneAt, &t.DoneBy, &t.Note,
+		); err != nil {
+			return err
+		}
+		o := byID[t.OffboardingID]
+		o.Tasks = append(o.Tasks, &t)
+	}
+
+	return rows.Err()
+}
+
+func (r *OffboardingPostgresRepository) getOne(ctx context.Context, where string, arg any) (*domain.Offboarding, error) {
+	o, err := scanOffboarding(r.queryRow(ctx,
+		`SELECT `+offboardingColumns+`
+		 FROM employee_offboardings o
+		 JOIN employees e ON e.id = o.employee_id
+		 WHERE `+where+`
+		 ORDER BY o.created_at DESC
+		 LIMIT 1`,
+		arg,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, offboardingrepository.ErrOffboardingNotFound
+		}
+		return nil, err
+	}
+
+	if err := r.loadTasks(ctx, []*domain.Offboarding{o}); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+func (r *OffboardingPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Offboarding, error) {
+	return r.getOne(ctx, `o.id = $1`, id)
+}
+
+func (r *OffboardingPostgresRepository) GetByEmployeeID(ctx context.Context, employeeID string) (*domain.Offboarding, error) {
+	return r.getOne(ctx, `o.employee_id = $1`, employeeID)
+}
+
+func (r *OffboardingPostgresRepository) ListByTenantID(
+	ctx context.Context,
+	tenantID string,
+	status domain.Status,
+) ([]*domain.Offboarding, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT `+offboardingColumns+`
+		 FROM employee_offboardings o
+		 JOIN employees e ON e.id = o.employee_id
+		 WHERE o.tenant_id = $1
+		   AND ($2 = '' OR o.status = $2)
+		 ORDER BY o.last_working_day DESC, e.code`,
+		tenantID, string(status),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Offboarding
+	for rows.Next() {
+		o, err := scanOffboarding(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTasks(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *OffboardingPostgresRepository) ClaimDueRevocations(ctx context.Context, day time.Time) ([]*domain.Offboarding, error) {
+	rows, err := r.query(ctx,
+		`SELECT `+offboardingColumns+`, u.id
+		 FROM employee_offboardings o
+		 JOIN employees e ON e.id = o.employee_id
+		 LEFT JOIN users u ON u.employee_id = o.employee_id
+		 WHERE o.last_working_day <= $1
+		   AND o.sessions_revoked_at IS NULL
+		 ORDER BY o.last_working_day
+		 FOR UPDATE OF o SKIP LOCKED`,
+		day,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Offboarding
+	for rows.Next() {
+		var userID *string
+
+		o, err := scanOffboarding(rows, &userID)
+		if err != nil {
+			return nil, err
+		}
+		o.UserID = userID
+		result = append(result, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadTasks(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
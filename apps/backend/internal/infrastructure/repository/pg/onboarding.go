@@ -0,0 +1,321 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type OnboardingTemplatePostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ onboardingrepository.TemplateRepository = (*OnboardingTemplatePostgresRepository)(nil)
+
+func NewOnboardingTemplatePostgresRepository(db *pgxpool.Pool) *OnboardingTemplatePostgresRepository {
+	return &OnboardingTemplatePostgresRepository{db: db}
+}
+
+func onboardingTemplateWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return onboardingrepository.ErrTemplateExists
+	}
+	return err
+}
+
+func (r *OnboardingTemplatePostgresRepository) Create(ctx context.Context, t *domain.Template) error {
+	tasksJSON, err := json.Marshal(t.Tasks)
+	if err != nil {
+		return err
+	}
+
+	err = r.db.QueryRow(ctx,
+		`INSERT INTO onboarding_templates (tenant_id, name, department_id, tasks, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id`,
+		t.TenantID, t.Name, t.DepartmentID, tasksJSON, t.CreatedAt, t.UpdatedAt,
+	).Scan(&t.ID)
+	return onboardingTemplateWriteError(err)
+}
+
+func (r *OnboardingTemplatePostgresRepository) Update(ctx context.Context, t *domain.Template) error {
+	tasksJSON, err := json.Marshal(t.Tasks)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE onboarding_templates
+		 SET name = $1, department_id = $2, tasks = $3, updated_at = $4
+		 WHERE id = $5`,
+		t.Name, t.DepartmentID, tasksJSON, t.UpdatedAt,
+		t.ID,
+	)
+	if err != nil {
+		return onboardingTemplateWriteError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return onboardingrepository.ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+func (r *OnboardingTemplatePostgresRepository) Delete(ctx context.Context, id string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM onboarding_templates WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return onboardingrepository.ErrTemplateNotFound
+	}
+
+	return nil
+}
+
+const onboardingTemplateColumns = `id, tenant_id, name, department_id, tasks, created_at, updated_at`
+
+func scanOnboardingTemplate(row pgx.Row) (*domain.Template, error) {
+	var t domain.Template
+	var tasksJSON []byte
+
+	if err := row.Scan(
+		&t.ID, &t.TenantID, &t.Name, &t.DepartmentID, &tasksJSON, &t.CreatedAt, &t.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(tasksJSON, &t.Tasks); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *OnboardingTemplatePostgresRepository) GetByID(ctx context.Context, id string) (*domain.Template, error) {
+	t, err := scanOnboardingTemplate(r.db.QueryRow(ctx,
+		`SELECT `+onboardingTemplateColumns+` FROM onboarding_templates WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, onboardingrepository.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *OnboardingTemplatePostgresRepository) ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Template, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+onboardingTemplateColumns+`
+		 FROM onboarding_templates
+		 WHERE tenant_id = $1
+		 ORDER BY department_id NULLS FIRST, name`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Template
+	for rows.Next() {
+		t, err := scanOnboardingTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *OnboardingTemplatePostgresRepository) FindForDepartment(
+	ctx context.Context,
+	tenantID string,
+	departmentID *string,
+) (*domain.Template, error) {
+
+	t, err := scanOnboardingTemplate(r.db.QueryRow(ctx,
+		`SELECT `+onboardingTemplateColumns+`
+		 FROM onboarding_templates
+		 WHERE tenant_id = $1
+		   AND (department_id IS NULL OR department_id = $2)
+		 ORDER BY department_id NULLS LAST
+		 LIMIT 1`,
+		tenantID, departmentID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, onboardingrepository.ErrTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type OnboardingTaskPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ onboardingrepository.TaskRepository = (*OnboardingTaskPostgresRepository)(nil)
+
+func NewOnboardingTaskPostgresRepository(db *pgxpool.Pool) *OnboardingTaskPostgresRepository {
+	return &OnboardingTaskPostgresRepository{db: db}
+}
+
+func (r *OnboardingTaskPostgresRepository) CreateMany(ctx context.Context, tasks []*domain.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, t := range tasks {
+		batch.Queue(
+			`INSERT INTO onboarding_tasks (
+				tenant_id, employee_id, template_id, title, description, assignee,
+				assignee_employee_id, due_date, status, position, created_at, updated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			RETURNING id`,
+			t.TenantID, t.EmployeeID, t.TemplateID, t.Title, t.Description, t.Assignee,
+			t.AssigneeEmployeeID, t.DueDate, t.Status, t.Position, t.CreatedAt, t.UpdatedAt,
+		)
+	}
+
+	var results pgx.BatchResults
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		results = tx.SendBatch(ctx, batch)
+	} else {
+		results = r.db.SendBatch(ctx, batch)
+	}
+	defer results.Close()
+
+	for _, t := range tasks {
+		if err := results.QueryRow().Scan(&t.ID); err != nil {
+			return err
+		}
+	}
+
+	return results.Close()
+}
+
+func (r *OnboardingTaskPostgresRepository) Update(ctx context.Context, t *domain.Task) error {
+	tag, err := r.db.Exec(ctx,
+		`UPDATE onboarding_tasks
+		 SET status = $1, completed_at = $2, completed_by = $3, note = $4, updated_at = $5
+		 WHERE id = $6`,
+		t.Status, t.CompletedAt, t.CompletedBy, t.Note, t.UpdatedAt,
+		t.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return onboardingrepository.ErrTaskNotFound
+	}
+
+	return nil
+}
+
+const onboardingTaskColumns = `t.id, t.tenant_id, t.employee_id, t.template_id, t.title, t.description,
+	t.assignee, t.assignee_employee_id, t.due_date, t.status, t.completed_at,
+	t.completed_by, t.note, t.position, t.created_at, t.updated_at,
+	e.code, e.first_name || ' ' || e.last_name`
+
+func scanOnboardingTask(row pgx.Row) (*domain.Task, error) {
+	var t domain.Task
+
+	if err := row.Scan(
+		&t.ID, &t.TenantID, &t.EmployeeID, &t.TemplateID, &t.Title, &t.Description,
+		&t.Assignee, &t.AssigneeEmployeeID, &t.DueDate, &t.Status, &t.CompletedAt,
+		&t.CompletedBy, &t.Note, &t.Position, &t.CreatedAt, &t.UpdatedAt,
+		&t.EmployeeCode, &t.EmployeeName,
+	); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *OnboardingTaskPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Task, error) {
+	t, err := scanOnboardingTask(r.db.QueryRow(ctx,
+		`SELECT `+onboardingTaskColumns+`
+		 FROM onboarding_tasks t
+		 JOIN employees e ON e.id = t.employee_id
+		 WHERE t.id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, onboardingrepository.ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (r *OnboardingTaskPostgresRepository) List(
+	ctx context.Context,
+	tenantID string,
+	f onboardingrepository.TaskFilter,
+) ([]*domain.Task, error) {
+
+	clauses := []string{"t.tenant_id = $1"}
+	args := []any{tenantID}
+
+	add := func(column string, value any) {
+		args = append(args, value)
+		clauses = append(clauses, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if f.EmployeeID != "" {
+		add("t.employee_id", f.EmployeeID)
+	}
+	if f.Assignee != "" {
+		add("t.assignee", f.Assignee)
+	}
+	if f.AssigneeEmployeeID != "" {
+		add("t.assignee_employee_id", f.AssigneeEmployeeID)
+	}
+	if f.Status != "" {
+		add("t.status", f.Status)
+	}
+
+	rows, err := r.db.Query(ctx,
+		`SELECT `+onboardingTaskColumns+`
+		 FROM onboarding_tasks t
+		 JOIN employees e ON e.id = t.employee_id
+		 WHERE `+strings.Join(clauses, " AND ")+`
+		 ORDER BY t.due_date, e.code, t.position`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Task
+	for rows.Next() {
+		t, err := scanOnboardingTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	return result, rows.Err()
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
 	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 )
 
 type PayrollPostgresRepository struct {
@@ -18,47 +20,144 @@ func NewPayrollPostgresRepository(db *pgxpool.Pool) payrollrepository.PayrollRep
 	return &PayrollPostgresRepository{db: db}
 }
 
+func (r *PayrollPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PayrollPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
 func (r *PayrollPostgresRepository) Create(p *domain.PayrollRecord) error {
 	allowancesJSON, _ := json.Marshal(p.Allowances)
 	deductionsJSON, _ := json.Marshal(p.Deductions)
+	linesJSON, _ := json.Marshal(p.Lines)
 
 	_, err := r.db.Exec(context.Background(),
-		`INSERT INTO payroll_records 
-		 (id, employee_id, period, base_salary, allowances, deductions, net_salary, generated_at)
-		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
+		`INSERT INTO payroll_records
+		 (id, employee_id, run_id, period, currency, base_salary, allowances, deductions, lines, net_salary, generated_at)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`,
 		p.ID,
 		p.EmployeeID,
+		p.RunID,
 		p.Period,
+		p.Currency,
 		p.BaseSalary,
 		allowancesJSON,
 		deductionsJSON,
+		linesJSON,
 		p.NetSalary,
 		p.GeneratedAt,
 	)
 	return err
 }
 
+func (r *PayrollPostgresRepository) CreateBatch(ctx context.Context, records []*domain.PayrollRecord) error {
+	batch := &pgx.Batch{}
+
+	for _, p := range records {
+		allowancesJSON, _ := json.Marshal(p.Allowances)
+		deductionsJSON, _ := json.Marshal(p.Deductions)
+		linesJSON, _ := json.Marshal(p.Lines)
+
+		batch.Queue(
+			`INSERT INTO payroll_records
+			 (id, employee_id, run_id, period, currency, base_salary, allowances, deductions, lines, net_salary, generated_at)
+			 VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)`,
+			p.ID,
+			p.EmployeeID,
+			p.RunID,
+			p.Period,
+			p.Currency,
+			p.BaseSalary,
+			allowancesJSON,
+			deductionsJSON,
+			linesJSON,
+			p.NetSalary,
+			p.GeneratedAt,
+		)
+	}
+
+	var results pgx.BatchResults
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		results = tx.SendBatch(ctx, batch)
+	} else {
+		results = r.db.SendBatch(ctx, batch)
+	}
+	defer results.Close()
+
+	for range records {
+		if _, err := results.Exec(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *PayrollPostgresRepository) Update(p *domain.PayrollRecord) error {
 	allowancesJSON, _ := json.Marshal(p.Allowances)
 	deductionsJSON, _ := json.Marshal(p.Deductions)
+	linesJSON, _ := json.Marshal(p.Lines)
 
-	_, err := r.db.Exec(context.Background(),
+	cmd, err := r.db.Exec(context.Background(),
 		`UPDATE payroll_records
 		 SET employee_id=$1,
 		     period=$2,
-		     base_salary=$3,
-		     allowances=$4,
-		     deductions=$5,
-		     net_salary=$6
-		 WHERE id=$7`,
+		     currency=$3,
+		     base_salary=$4,
+		     allowances=$5,
+		     deductions=$6,
+		     lines=$7,
+		     net_salary=$8
+		 WHERE id=$9
+		   AND NOT EXISTS (
+		     SELECT 1 FROM payroll_runs pr
+		     WHERE pr.id = payroll_records.run_id
+		       AND pr.status IN ('APPROVED', 'PAID')
+		   )`,
 		p.EmployeeID,
 		p.Period,
+		p.Currency,
 		p.BaseSalary,
 		allowancesJSON,
 		deductionsJSON,
+		linesJSON,
 		p.NetSalary,
 		p.ID,
 	)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 && p.RunID != nil {
+		return domain.ErrPayrollRecordIsLocked
+	}
+
+	return nil
+}
+
+func (r *PayrollPostgresRepository) DeleteByRunID(ctx context.Context, runID string) error {
+	_, err := r.exec(ctx,
+		`DELETE FROM payroll_records WHERE run_id = $1`,
+		runID,
+	)
 	return err
 }
 
@@ -66,14 +165,18 @@ func scanPayroll(row pgx.Row) (*domain.PayrollRecord, error) {
 	var p domain.PayrollRecord
 	var allowancesJSON []byte
 	var deductionsJSON []byte
+	var linesJSON []byte
 
 	err := row.Scan(
 		&p.ID,
 		&p.EmployeeID,
+		&p.RunID,
 		&p.Period,
+		&p.Currency,
 		&p.BaseSalary,
 		&allowancesJSON,
 		&deductionsJSON,
+		&linesJSON,
 		&p.NetSalary,
 		&p.GeneratedAt,
 	)
@@ -84,6 +187,10 @@ func scanPayroll(row pgx.Row) (*domain.PayrollRecord, error) {
 	// Parse JSONB
 	json.Unmarshal(allowancesJSON, &p.Allowances)
 	json.Unmarshal(deductionsJSON, &p.Deductions)
+	json.Unmarshal(linesJSON, &p.Lines)
+
+	// NUMERIC columns and older JSONB amounts carry no currency.
+	p.SetCurrency(p.Currency)
 
 	return &p, nil
 }
@@ -91,8 +198,8 @@ func scanPayroll(row pgx.Row) (*domain.PayrollRecord, error) {
 func (r *PayrollPostgresRepository) FindByID(id string) (*domain.PayrollRecord, error) {
 	return scanPayroll(
 		r.db.QueryRow(context.Background(),
-			`SELECT id, employee_id, period, base_salary, allowances, 
-			        deductions, net_salary, generated_at
+			`SELECT id, employee_id, run_id, period, currency, base_salary, allowances,
+			        deductions, lines, net_salary, generated_at
 			 FROM payroll_records
 			 WHERE id=$1`,
 			id,
@@ -102,8 +209,8 @@ func (r *PayrollPostgresRepository) FindByID(id string) (*domain.PayrollRecord,
 
 func (r *PayrollPostgresRepository) ListByEmployee(employeeID string) ([]*domain.PayrollRecord, error) {
 	rows, err := r.db.Query(context.Background(),
-		`SELECT id, employee_id, period, base_salary, allowances, 
-		        deductions, net_salary, generated_at
+		`SELECT id, employee_id, run_id, period, currency, base_salary, allowances,
+		        deductions, lines, net_salary, generated_at
 		 FROM payroll_records
 		 WHERE employee_id=$1
 		 ORDER BY period DESC`,
@@ -127,14 +234,42 @@ func (r *PayrollPostgresRepository) ListByEmployee(employeeID string) ([]*domain
 	return results, nil
 }
 
-func (r *PayrollPostgresRepository) ListByPeriod(period string) ([]*domain.PayrollRecord, error) {
-	rows, err := r.db.Query(context.Background(),
-		`SELECT id, employee_id, period, base_salary, allowances, 
-		        deductions, net_salary, generated_at
+func (r *PayrollPostgresRepository) ListByTenantAndPeriod(ctx context.Context, tenantID, period string) ([]*domain.PayrollRecord, error) {
+	rows, err := r.query(ctx,
+		`SELECT p.id, p.employee_id, p.run_id, p.period, p.currency, p.base_salary, p.allowances,
+		        p.deductions, p.lines, p.net_salary, p.generated_at
+		 FROM payroll_records p
+		 JOIN employees e ON e.id = p.employee_id
+		 WHERE e.tenant_id=$1 AND p.period=$2
+		 ORDER BY p.employee_id ASC`,
+		tenantID, period,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*domain.PayrollRecord
+
+	for rows.Next() {
+		item, err := scanPayroll(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, item)
+	}
+
+	return results, nil
+}
+
+func (r *PayrollPostgresRepository) ListByRunID(ctx context.Context, runID string) ([]*domain.PayrollRecord, error) {
+	rows, err := r.query(ctx,
+		`SELECT id, employee_id, run_id, period, currency, base_salary, allowances,
+		        deductions, lines, net_salary, generated_at
 		 FROM payroll_records
-		 WHERE period=$1
+		 WHERE run_id=$1
 		 ORDER BY employee_id ASC`,
-		period,
+		runID,
 	)
 	if err != nil {
 		return nil, err
@@ -151,5 +286,5 @@ func (r *PayrollPostgresRepository) ListByPeriod(period string) ([]*domain.Payro
 		results = append(results, item)
 	}
 
-	return results, nil
+	return results, rows.Err()
 }
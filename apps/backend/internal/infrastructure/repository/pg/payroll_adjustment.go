@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type PayrollAdjustmentPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.AdjustmentRepository = (*PayrollAdjustmentPostgresRepository)(nil)
+
+func NewPayrollAdjustmentPostgresRepository(db *pgxpool.Pool) *PayrollAdjustmentPostgresRepository {
+	return &PayrollAdjustmentPostgresRepository{db: db}
+}
+
+func (r *PayrollAdjustmentPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) Create(ctx context.Context, a *domain.PayrollAdjustment) error {
+	linesJSON, err := json.Marshal(a.Lines)
+	if err != nil {
+		return err
+	}
+
+	return r.queryRow(ctx,
+		`INSERT INTO payroll_adjustments (
+			tenant_id, employee_id, source_record_id, source_run_id, source_period,
+			target_run_id, reason, currency, lines, net_delta, created_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, '')::uuid, $12)
+		RETURNING id`,
+		a.TenantID, a.EmployeeID, a.SourceRecordID, a.SourceRunID, a.SourcePeriod,
+		a.TargetRunID, a.Reason, a.Currency, linesJSON, a.NetDelta, a.CreatedBy, a.CreatedAt,
+	).Scan(&a.ID)
+}
+
+const payrollAdjustmentColumns = `a.id, a.tenant_id, a.employee_id, a.source_record_id, a.source_run_id,
+	a.source_period, a.target_run_id, a.reason, a.currency, a.lines, a.net_delta,
+	COALESCE(a.created_by::text, ''), a.created_at`
+
+func (r *PayrollAdjustmentPostgresRepository) list(
+	ctx context.Context,
+	query string,
+	args ...any,
+) ([]*domain.PayrollAdjustment, error) {
+
+	rows, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.PayrollAdjustment
+	for rows.Next() {
+		var a domain.PayrollAdjustment
+		var linesJSON []byte
+
+		if err := rows.Scan(
+			&a.ID, &a.TenantID, &a.EmployeeID, &a.SourceRecordID, &a.SourceRunID,
+			&a.SourcePeriod, &a.TargetRunID, &a.Reason, &a.Currency, &linesJSON, &a.NetDelta,
+			&a.CreatedBy, &a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(linesJSON, &a.Lines); err != nil {
+			return nil, err
+		}
+		a.SetCurrency(a.Currency)
+
+		result = append(result, &a)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *PayrollAdjustmentPostgresRepository) ListBySourceRunID(
+	ctx context.Context,
+	runID string,
+) ([]*domain.PayrollAdjustment, error) {
+
+	return r.list(ctx,
+		`SELECT `+payrollAdjustmentColumns+`
+		 FROM payroll_adjustments a
+		 WHERE a.source_run_id = $1
+		 ORDER BY a.created_at DESC`,
+		runID,
+	)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) ListByTargetRunID(
+	ctx context.Context,
+	runID string,
+) ([]*domain.PayrollAdjustment, error) {
+
+	return r.list(ctx,
+		`SELECT `+payrollAdjustmentColumns+`
+		 FROM payroll_adjustments a
+		 WHERE a.target_run_id = $1
+		 ORDER BY a.source_period ASC, a.employee_id ASC`,
+		runID,
+	)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) ListSettledBySourceRecordID(
+	ctx context.Context,
+	recordID string,
+) ([]*domain.PayrollAdjustment, error) {
+
+	return r.list(ctx,
+		`SELECT `+payrollAdjustmentColumns+`
+		 FROM payroll_adjustments a
+		 JOIN payroll_runs t ON t.id = a.target_run_id
+		 WHERE a.source_record_id = $1
+		   AND t.status IN ('APPROVED', 'PAID')
+		 ORDER BY a.created_at ASC`,
+		recordID,
+	)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) ListPayable(
+	ctx context.Context,
+	tenantID,
+	runID,
+	period string,
+) ([]*domain.PayrollAdjustment, error) {
+
+	return r.list(ctx,
+		`SELECT `+payrollAdjustmentColumns+`
+		 FROM payroll_adjustments a
+		 WHERE a.tenant_id = $1
+		   AND a.source_period < $3
+		   AND (a.target_run_id IS NULL OR a.target_run_id = $2)
+		 ORDER BY a.source_period ASC, a.created_at ASC`,
+		tenantID, runID, period,
+	)
+}
+
+func (r *PayrollAdjustmentPostgresRepository) DeleteUnsettledBySourceRecordID(
+	ctx context.Context,
+	recordID string,
+) error {
+
+	_, err := r.exec(ctx,
+		`DELETE FROM payroll_adjustments a
+		 WHERE a.source_record_id = $1
+		   AND NOT EXISTS (
+		     SELECT 1 FROM payroll_runs t
+		     WHERE t.id = a.target_run_id
+		       AND t.status IN ('APPROVED', 'PAID')
+		   )`,
+		recordID,
+	)
+	return err
+}
+
+func (r *PayrollAdjustmentPostgresRepository) AssignTargetRun(
+	ctx context.Context,
+	runID string,
+	ids []string,
+) error {
+
+	if _, err := r.exec(ctx,
+		`UPDATE payroll_adjustments SET target_run_id = NULL WHERE target_run_id = $1`,
+		runID,
+	); err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.exec(ctx,
+		`UPDATE payroll_adjustments SET target_run_id = $1 WHERE id = ANY($2)`,
+		runID, ids,
+	)
+	return err
+}
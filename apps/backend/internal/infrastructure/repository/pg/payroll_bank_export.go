@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type PayrollBankExportPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.BankExportRepository = (*PayrollBankExportPostgresRepository)(nil)
+
+func NewPayrollBankExportPostgresRepository(db *pgxpool.Pool) *PayrollBankExportPostgresRepository {
+	return &PayrollBankExportPostgresRepository{db: db}
+}
+
+func (r *PayrollBankExportPostgresRepository) Create(ctx context.Context, e *domain.BankExport) error {
+	return r.db.QueryRow(ctx,
+		`INSERT INTO payroll_bank_exports (
+			run_id, format, file_name, checksum, transfer_count,
+			total_amount, currency, created_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id`,
+		e.RunID, e.Format, e.FileName, e.Checksum, e.TransferCount,
+		e.TotalAmount, e.Currency, e.CreatedBy, e.CreatedAt,
+	).Scan(&e.ID)
+}
+
+func (r *PayrollBankExportPostgresRepository) ListByRunID(ctx context.Context, runID string) ([]*domain.BankExport, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, run_id, format, file_name, checksum, transfer_count,
+		        total_amount, currency, COALESCE(created_by::text, ''), created_at
+		 FROM payroll_bank_exports
+		 WHERE run_id = $1
+		 ORDER BY created_at DESC`,
+		runID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.BankExport
+	for rows.Next() {
+		var e domain.BankExport
+		if err := rows.Scan(
+			&e.ID, &e.RunID, &e.Format, &e.FileName, &e.Checksum, &e.TransferCount,
+			&e.TotalAmount, &e.Currency, &e.CreatedBy, &e.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		e.TotalAmount = e.TotalAmount.WithCurrency(e.Currency)
+		result = append(result, &e)
+	}
+
+	return result, rows.Err()
+}
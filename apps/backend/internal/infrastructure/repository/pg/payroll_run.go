@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type PayrollRunPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.PayrollRunRepository = (*PayrollRunPostgresRepository)(nil)
+
+func NewPayrollRunPostgresRepository(db *pgxpool.Pool) *PayrollRunPostgresRepository {
+	return &PayrollRunPostgresRepository{db: db}
+}
+
+func (r *PayrollRunPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PayrollRunPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *PayrollRunPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *PayrollRunPostgresRepository) Create(
+	ctx context.Context,
+	run *domain.PayrollRun,
+) error {
+
+	err := r.queryRow(ctx,
+		`INSERT INTO payroll_runs (
+			tenant_id,
+			run_type,
+			period,
+			status,
+			salary_multiplier,
+			prorate_year,
+			employee_ids,
+			total_employees,
+			created_at,
+			updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id`,
+		run.TenantID,
+		run.Type,
+		run.Period,
+		run.Status,
+		run.SalaryMultiplier,
+		run.ProrateYear,
+		employeeIDs(run.EmployeeIDs),
+		run.TotalEmployees,
+		run.CreatedAt,
+		run.UpdatedAt,
+	).Scan(&run.ID)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			// unique constraint on (tenant_id, period) of regular runs
+			return payrollrepository.ErrPayrollRunAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayrollRunPostgresRepository) Update(
+	ctx context.Context,
+	run *domain.PayrollRun,
+) error {
+
+	cmd, err := r.exec(ctx,
+		`UPDATE payroll_runs
+		 SET status = $1,
+		     total_employees = $2,
+		     calculated_at = $3,
+		     approved_by = $4,
+		     approved_at = $5,
+		     paid_at = $6,
+		     updated_at = $7
+		 WHERE id = $8`,
+		run.Status,
+		run.TotalEmployees,
+		run.CalculatedAt,
+		run.ApprovedBy,
+		run.ApprovedAt,
+		run.PaidAt,
+		run.UpdatedAt,
+		run.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return payrollrepository.ErrPayrollRunNotFound
+	}
+
+	return nil
+}
+
+func scanPayrollRun(row pgx.Row) (*domain.PayrollRun, error) {
+	var run domain.PayrollRun
+
+	err := row.Scan(
+		&run.ID,
+		&run.TenantID,
+		&run.Type,
+		&run.Period,
+		&run.Status,
+		&run.SalaryMultiplier,
+		&run.ProrateYear,
+		&run.EmployeeIDs,
+		&run.TotalEmployees,
+		&run.CalculatedAt,
+		&run.ApprovedBy,
+		&run.ApprovedAt,
+		&run.PaidAt,
+		&run.CreatedAt,
+		&run.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, payrollrepository.ErrPayrollRunNotFound
+		}
+		return nil, err
+	}
+
+	return &run, nil
+}
+
+func (r *PayrollRunPostgresRepository) GetByID(
+	ctx context.Context,
+	id string,
+) (*domain.PayrollRun, error) {
+
+	return scanPayrollRun(
+		r.queryRow(ctx,
+			`SELECT id, tenant_id, run_type, period, status,
+			        salary_multiplier::float8, prorate_year, employee_ids::text[], total_employees,
+			        calculated_at, approved_by, approved_at, paid_at,
+			        created_at, updated_at
+			 FROM payroll_runs
+			 WHERE id = $1`,
+			id,
+		),
+	)
+}
+
+func (r *PayrollRunPostgresRepository) LockByID(
+	ctx context.Context,
+	id string,
+) (*domain.PayrollRun, error) {
+
+	return scanPayrollRun(
+		r.queryRow(ctx,
+			`SELECT id, tenant_id, run_type, period, status,
+			        salary_multiplier::float8, prorate_year, employee_ids::text[], total_employees,
+			        calculated_at, approved_by, approved_at, paid_at,
+			        created_at, updated_at
+			 FROM payroll_runs
+			 WHERE id = $1
+			 FOR UPDATE`,
+			id,
+		),
+	)
+}
+
+func (r *PayrollRunPostgresRepository) GetByTenantAndPeriod(
+	ctx context.Context,
+	tenantID,
+	period string,
+) (*domain.PayrollRun, error) {
+
+	return scanPayrollRun(
+		r.queryRow(ctx,
+			`SELECT id, tenant_id, run_type, period, status,
+			        salary_multiplier::float8, prorate_year, employee_ids::text[], total_employees,
+			        calculated_at, approved_by, approved_at, paid_at,
+			        created_at, updated_at
+			 FROM payroll_runs
+			 WHERE tenant_id = $1 AND period = $2 AND run_type = 'REGULAR'`,
+			tenantID,
+			period,
+		),
+	)
+}
+
+func (r *PayrollRunPostgresRepository) ListByTenant(
+	ctx context.Context,
+	tenantID string,
+) ([]*domain.PayrollRun, error) {
+
+	rows, err := r.query(ctx,
+		`SELECT id, tenant_id, run_type, period, status,
+		        salary_multiplier::float8, prorate_year, employee_ids::text[], total_employees,
+		        calculated_at, approved_by, approved_at, paid_at,
+		        created_at, updated_at
+		 FROM payroll_runs
+		 WHERE tenant_id = $1
+		 ORDER BY period DESC, created_at DESC`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []*domain.PayrollRun
+
+	for rows.Next() {
+		run, err := scanPayrollRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, rows.Err()
+}
+
+// employeeIDs stores a missing scope as an empty array.
+func employeeIDs(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type PayslipPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.PayslipRepository = (*PayslipPostgresRepository)(nil)
+
+func NewPayslipPostgresRepository(db *pgxpool.Pool) *PayslipPostgresRepository {
+	return &PayslipPostgresRepository{db: db}
+}
+
+func (r *PayslipPostgresRepository) Upsert(ctx context.Context, p *domain.Payslip) error {
+	return r.db.QueryRow(ctx,
+		`INSERT INTO payslips (
+			record_id,
+			run_id,
+			employee_id,
+			period,
+			status,
+			storage_path,
+			password_protected,
+			error,
+			generated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (record_id) DO UPDATE
+		SET status = EXCLUDED.status,
+		    storage_path = EXCLUDED.storage_path,
+		    password_protected = EXCLUDED.password_protected,
+		    error = EXCLUDED.error,
+		    generated_at = EXCLUDED.generated_at
+		RETURNING id`,
+		p.RecordID,
+		p.RunID,
+		p.EmployeeID,
+		p.Period,
+		p.Status,
+		p.StoragePath,
+		p.PasswordProtected,
+		p.Error,
+		p.GeneratedAt,
+	).Scan(&p.ID)
+}
+
+func scanPayslip(row pgx.Row) (*domain.Payslip, error) {
+	var p domain.Payslip
+
+	err := row.Scan(
+		&p.ID,
+		&p.RecordID,
+		&p.RunID,
+		&p.EmployeeID,
+		&p.Period,
+		&p.Status,
+		&p.StoragePath,
+		&p.PasswordProtected,
+		&p.Error,
+		&p.GeneratedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, payrollrepository.ErrPayslipNotFound
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+func (r *PayslipPostgresRepository) GetByRecordID(ctx context.Context, recordID string) (*domain.Payslip, error) {
+	return scanPayslip(
+		r.db.QueryRow(ctx,
+			`SELECT id, record_id, run_id, employee_id, period, status,
+			        storage_path, password_protected, error, generated_at
+			 FROM payslips
+			 WHERE record_id = $1`,
+			recordID,
+		),
+	)
+}
+
+func (r *PayslipPostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Payslip, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT id, record_id, run_id, employee_id, period, status,
+		        storage_path, password_protected, error, generated_at
+		 FROM payslips
+		 WHERE employee_id = $1 AND status = $2
+		 ORDER BY period DESC`,
+		employeeID, domain.PayslipGenerated,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*domain.Payslip
+	for rows.Next() {
+		p, err := scanPayslip(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, p)
+	}
+
+	return results, rows.Err()
+}
+
+type PayslipPreferencePostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.PayslipPreferenceRepository = (*PayslipPreferencePostgresRepository)(nil)
+
+func NewPayslipPreferencePostgresRepository(db *pgxpool.Pool) *PayslipPreferencePostgresRepository {
+	return &PayslipPreferencePostgresRepository{db: db}
+}
+
+func (r *PayslipPreferencePostgresRepository) Upsert(ctx context.Context, p *domain.PayslipPreference) error {
+	_, err := r.db.Exec(ctx,
+		`INSERT INTO payslip_preferences (employee_id, password_protected, updated_at)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (employee_id) DO UPDATE
+		 SET password_protected = EXCLUDED.password_protected,
+		     updated_at = EXCLUDED.updated_at`,
+		p.EmployeeID,
+		p.PasswordProtected,
+		p.UpdatedAt,
+	)
+	return err
+}
+
+func (r *PayslipPreferencePostgresRepository) GetByEmployeeID(
+	ctx context.Context,
+	employeeID string,
+) (*domain.PayslipPreference, error) {
+
+	var p domain.PayslipPreference
+
+	err := r.db.QueryRow(ctx,
+		`SELECT employee_id, password_protected, updated_at
+		 FROM payslip_preferences
+		 WHERE employee_id = $1`,
+		employeeID,
+	).Scan(&p.EmployeeID, &p.PasswordProtected, &p.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &p, nil
+}
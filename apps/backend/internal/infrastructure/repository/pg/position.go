@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type PositionPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ positionrepository.PositionRepository = (*PositionPostgresRepository)(nil)
+
+func NewPositionPostgresRepository(db *pgxpool.Pool) *PositionPostgresRepository {
+	return &PositionPostgresRepository{db: db}
+}
+
+func (r *PositionPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PositionPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *PositionPostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *PositionPostgresRepository) Create(ctx context.Context, p *domain.Position) error {
+	err := r.queryRow(ctx,
+		`INSERT INTO positions (
+			tenant_id, department_id, title, grade, salary_min, salary_max,
+			currency, fte, status, employee_id, filled_at, created_by,
+			created_at, updated_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NULLIF($12, '')::uuid, $13, $14)
+		 RETURNING id`,
+		p.TenantID, p.DepartmentID, p.Title, p.Grade, p.SalaryMin, p.SalaryMax,
+		p.SalaryMin.Currency(), p.FTE, p.Status, p.EmployeeID, p.FilledAt, p.CreatedBy,
+		p.CreatedAt, p.UpdatedAt,
+	).Scan(&p.ID)
+
+	return positionWriteError(err)
+}
+
+func (r *PositionPostgresRepository) Update(ctx context.Context, p *domain.Position) error {
+	tag, err := r.exec(ctx,
+		`UPDATE positions
+		 SET department_id = $1, title = $2, grade = $3, salary_min = $4,
+		     salary_max = $5, currency = $6, fte = $7, status = $8,
+		     employee_id = $9, filled_at = $10, updated_at = $11
+		 WHERE id = $12`,
+		p.DepartmentID, p.Title, p.Grade, p.SalaryMin,
+		p.SalaryMax, p.SalaryMin.Currency(), p.FTE, p.Status,
+		p.EmployeeID, p.FilledAt, p.UpdatedAt,
+		p.ID,
+	)
+	if err != nil {
+		return positionWriteError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return positionrepository.ErrPositionNotFound
+	}
+
+	return nil
+}
+
+func positionWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		// unique index on employee_id
+		return positionrepository.ErrEmployeeHasPosition
+	}
+	return err
+}
+
+const positionColumns = `p.id, p.tenant_id, p.department_id, p.title, p.grade,
+	p.salary_min, p.salary_max, p.currency, p.fte::float8, p.status,
+	p.employee_id, p.filled_at, COALESCE(p.created_by::text, ''),
+	p.created_at, p.updated_at,
+	d.name, e.code, e.first_name || ' ' || e.last_name`
+
+const positionFrom = `
+	FROM positions p
+	LEFT JOIN departments d ON d.id = p.department_id
+	LEFT JOIN employees e ON e.id = p.employee_id`
+
+func scanPosition(row pgx.Row) (*domain.Position, error) {
+	var p domain.Position
+	var currency string
+
+	if err := row.Scan(
+		&p.ID, &p.TenantID, &p.DepartmentID, &p.Title, &p.Grade,
+		&p.SalaryMin, &p.SalaryMax, &currency, &p.FTE, &p.Status,
+		&p.EmployeeID, &p.FilledAt, &p.CreatedBy,
+		&p.CreatedAt, &p.UpdatedAt,
+		&p.DepartmentName, &p.EmployeeCode, &p.EmployeeName,
+	); err != nil {
+		return nil, err
+	}
+
+	p.SalaryMin = p.SalaryMin.WithCurrency(money.Currency(currency))
+	p.SalaryMax = p.SalaryMax.WithCurrency(money.Currency(currency))
+
+	return &p, nil
+}
+
+func (r *PositionPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Position, error) {
+	p, err := scanPosition(r.queryRow(ctx,
+		`SELECT `+positionColumns+positionFrom+`
+		 WHERE p.id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, positionrepository.ErrPositionNotFound
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (r *PositionPostgresRepository) List(
+	ctx context.Context,
+	tenantID string,
+	f positionrepository.PositionFilter,
+) ([]*domain.Position, error) {
+
+	where := []string{"p.tenant_id = $1"}
+	args := []any{tenantID}
+
+	if f.DepartmentID != "" {
+		args = append(args, f.DepartmentID)
+		where = append(where, fmt.Sprintf("p.department_id = $%d", len(args)))
+	}
+	if f.EmployeeID != "" {
+		args = append(args, f.EmployeeID)
+		where = append(where, fmt.Sprintf("p.employee_id = $%d", len(args)))
+	}
+	if f.Status != "" {
+		args = append(args, f.Status)
+		where = append(where, fmt.Sprintf("p.status = $%d", len(args)))
+	}
+
+	rows, err := r.query(ctx,
+		`SELECT `+positionColumns+positionFrom+`
+		 WHERE `+strings.Join(where, " AND ")+`
+		 ORDER BY d.name, p.title, p.created_at`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Position
+	for rows.Next() {
+		p, err := scanPosition(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type ProbationEvaluationPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ probationrepository.EvaluationRepository = (*ProbationEvaluationPostgresRepository)(nil)
+
+func NewProbationEvaluationPostgresRepository(db *pgxpool.Pool) *ProbationEvaluationPostgresRepository {
+	return &ProbationEvaluationPostgresRepository{db: db}
+}
+
+func (r *ProbationEvaluationPostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *ProbationEvaluationPostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *ProbationEvaluationPostgresRepository) Update(ctx context.Context, e *domain.Evaluation) error {
+	tag, err := r.exec(ctx,
+		`UPDATE probation_evaluations
+		 SET status = $1, performance_rating = $2, conduct_rating = $3, comments = $4,
+		     decision = $5, extended_until = $6, decided_by = $7, decided_at = $8,
+		     updated_at = $9
+		 WHERE id = $10`,
+		e.Status, e.PerformanceRating, e.ConductRating, e.Comments,
+		e.Decision, e.ExtendedUntil, e.DecidedBy, e.DecidedAt,
+		e.UpdatedAt,
+		e.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return probationrepository.ErrEvaluationNotFound
+	}
+
+	return nil
+}
+
+const probationEvaluationColumns = `v.id, v.tenant_id, v.employee_id, v.evaluator_id, v.probation_end_date,
+	v.status, v.performance_rating, v.conduct_rating, v.comments, v.decision,
+	v.extended_until, v.decided_by, v.decided_at, v.created_at, v.updated_at,
+	e.code, e.first_name || ' ' || e.last_name, e.email, e.join_date,
+	m.first_name || ' ' || m.last_name, m.email`
+
+const probationEvaluationFrom = `
+	FROM probation_evaluations v
+	JOIN employees e ON e.id = v.employee_id
+	LEFT JOIN employees m ON m.id = v.evaluator_id`
+
+func scanProbationEvaluation(row pgx.Row) (*domain.Evaluation, error) {
+	var e domain.Evaluation
+
+	if err := row.Scan(
+		&e.ID, &e.TenantID, &e.EmployeeID, &e.EvaluatorID, &e.ProbationEndDate,
+		&e.Status, &e.PerformanceRating, &e.ConductRating, &e.Comments, &e.Decision,
+		&e.ExtendedUntil, &e.DecidedBy, &e.DecidedAt, &e.CreatedAt, &e.UpdatedAt,
+		&e.EmployeeCode, &e.EmployeeName, &e.EmployeeEmail, &e.JoinDate,
+		&e.EvaluatorName, &e.EvaluatorEmail,
+	); err != nil {
+		return nil, err
+	}
+
+	return &e, nil
+}
+
+func (r *ProbationEvaluationPostgresRepository) list(ctx context.Context, where string, args ...any) ([]*domain.Evaluation, error) {
+	rows, err := r.query(ctx,
+		`SELECT `+probationEvaluationColumns+probationEvaluationFrom+`
+		 WHERE `+where+`
+		 ORDER BY v.probation_end_date, e.code`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.Evaluation
+	for rows.Next() {
+		e, err := scanProbationEvaluation(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ProbationEvaluationPostgresRepository) GetByID(ctx context.Context, id string) (*domain.Evaluation, error) {
+	result, err := r.list(ctx, `v.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, probationrepository.ErrEvaluationNotFound
+	}
+
+	return result[0], nil
+}
+
+func (r *ProbationEvaluationPostgresRepository) List(
+	ctx context.Context,
+	tenantID string,
+	f probationrepository.EvaluationFilter,
+) ([]*domain.Evaluation, error) {
+
+	clauses := []string{"v.tenant_id = $1"}
+	args := []any{tenantID}
+
+	add := func(column string, value any) {
+		args = append(args, value)
+		clauses = append(clauses, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if f.EmployeeID != "" {
+		add("v.employee_id", f.EmployeeID)
+	}
+	if f.EvaluatorID != "" {
+		add("v.evaluator_id", f.EvaluatorID)
+	}
+	if f.Status != "" {
+		add("v.status", f.Status)
+	}
+
+	return r.list(ctx, strings.Join(clauses, " AND "), args...)
+}
+
+// IssueDue takes the evaluator from today's job of the employee. The
+// unique index on (employee_id, probation_end_date) keeps concurrent runs
+// from issuing an evaluation twice.
+func (r *ProbationEvaluationPostgresRepository) IssueDue(ctx context.Context, until time.Time) ([]*domain.Evaluation, error) {
+	rows, err := r.query(ctx,
+		`INSERT INTO probation_evaluations (tenant_id, employee_id, evaluator_id, probation_end_date)
+		 SELECT e.tenant_id, e.id, cj.manager_id, e.probation_end_date
+		 FROM employees e
+		 JOIN employee_current_jobs cj ON cj.employee_id = e.id
+		 WHERE e.tenant_id IS NOT NULL
+		   AND e.probation_end_date <= $1
+		   AND cj.employment_status = $2
+		 ON CONFLICT (employee_id, probation_end_date) DO NOTHING
+		 RETURNING id`,
+		until, employeedomain.Probation,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.list(ctx, `v.id = ANY($1)`, ids)
+}
+
+func (r *ProbationEvaluationPostgresRepository) SetProbationEndDate(ctx context.Context, employeeID string, end time.Time) error {
+	tag, err := r.exec(ctx,
+		`UPDATE employees SET probation_end_date = $1, updated_at = NOW() WHERE id = $2`,
+		end, employeeID,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("employee %s: %w", employeeID, pgx.ErrNoRows)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/secret"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+// ProfileChangePostgresRepository stores the pending values of a request,
+// which may hold an account number, encrypted.
+type ProfileChangePostgresRepository struct {
+	db     *pgxpool.Pool
+	cipher *secret.Cipher
+}
+
+var _ profilechangerepository.ChangeRequestRepository = (*ProfileChangePostgresRepository)(nil)
+
+func NewProfileChangePostgresRepository(db *pgxpool.Pool, cipher *secret.Cipher) *ProfileChangePostgresRepository {
+	return &ProfileChangePostgresRepository{db: db, cipher: cipher}
+}
+
+func (r *ProfileChangePostgresRepository) exec(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgconn.CommandTag, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Exec(ctx, query, args...)
+	}
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *ProfileChangePostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *ProfileChangePostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *ProfileChangePostgresRepository) encryptPending(p *domain.Pending) ([]byte, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return r.cipher.Encrypt(data)
+}
+
+func (r *ProfileChangePostgresRepository) Create(ctx context.Context, c *domain.ChangeRequest) error {
+	pending, err := r.encryptPending(c.Pending)
+	if err != nil {
+		return err
+	}
+
+	err = r.queryRow(ctx,
+		`INSERT INTO profile_change_requests (
+			tenant_id, employee_id, status, changes, pending_enc, reason,
+			requested_by, applied_at, created_at, updated_at
+		 )
+		 VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, $8, $9, $10)
+		 RETURNING id`,
+		c.TenantID, c.EmployeeID, c.Status, c.Changes, pending, c.Reason,
+		c.RequestedBy, c.AppliedAt, c.CreatedAt, c.UpdatedAt,
+	).Scan(&c.ID)
+
+	return changeRequestWriteError(err)
+}
+
+func (r *ProfileChangePostgresRepository) Update(ctx context.Context, c *domain.ChangeRequest) error {
+	pending, err := r.encryptPending(c.Pending)
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.exec(ctx,
+		`UPDATE profile_change_requests
+		 SET status = $1, changes = $2, pending_enc = $3, reviewed_by = $4,
+		     reviewed_at = $5, review_note = $6, applied_at = $7, updated_at = $8
+		 WHERE id = $9`,
+		c.Status, c.Changes, pending, c.ReviewedBy,
+		c.ReviewedAt, c.ReviewNote, c.AppliedAt, c.UpdatedAt,
+		c.ID,
+	)
+	if err != nil {
+		return changeRequestWriteError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return profilechangerepository.ErrChangeRequestNotFound
+	}
+
+	return nil
+}
+
+func changeRequestWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		// unique index on the employee's pending request
+		return profilechangerepository.ErrPendingRequestExists
+	}
+	return err
+}
+
+const changeRequestColumns = `c.id, c.tenant_id, c.employee_id, c.status, c.changes,
+	c.reason, COALESCE(c.requested_by::text, ''), c.reviewed_by, c.reviewed_at,
+	c.review_note, c.applied_at, c.created_at, c.updated_at,
+	e.code, e.first_name || ' ' || e.last_name, e.email`
+
+const changeRequestFrom = `
+	FROM profile_change_requests c
+	JOIN employees e ON e.id = c.employee_id`
+
+func scanChangeRequest(row pgx.Row, extra ...any) (*domain.ChangeRequest, error) {
+	var c domain.ChangeRequest
+
+	dest := []any{
+		&c.ID, &c.TenantID, &c.EmployeeID, &c.Status, &c.Changes,
+		&c.Reason, &c.RequestedBy, &c.ReviewedBy, &c.ReviewedAt,
+		&c.ReviewNote, &c.AppliedAt, &c.CreatedAt, &c.UpdatedAt,
+		&c.EmployeeCode, &c.EmployeeName, &c.EmployeeEmail,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *ProfileChangePostgresRepository) GetByID(ctx context.Context, id string) (*domain.ChangeRequest, error) {
+	return r.getByID(ctx, id, "")
+}
+
+func (r *ProfileChangePostgresRepository) LockByID(ctx context.Context, id string) (*domain.ChangeRequest, error) {
+	return r.getByID(ctx, id, " FOR UPDATE OF c")
+}
+
+func (r *ProfileChangePostgresRepository) getByID(
+	ctx context.Context,
+	id string,
+	lock string,
+) (*domain.ChangeRequest, error) {
+
+	var pending []byte
+
+	c, err := scanChangeRequest(r.queryRow(ctx,
+		`SELECT `+changeRequestColumns+`, c.pending_enc`+changeRequestFrom+`
+		 WHERE c.id = $1`+lock,
+		id,
+	), &pending)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, profilechangerepository.ErrChangeRequestNotFound
+		}
+		return nil, err
+	}
+
+	if pending != nil {
+		data, err := r.cipher.Decrypt(pending)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt change request %s: %w", id, err)
+		}
+		c.Pending = &domain.Pending{}
+		if err := json.Unmarshal(data, c.Pending); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (r *ProfileChangePostgresRepository) List(
+	ctx context.Context,
+	tenantID string,
+	f profilechangerepository.ChangeRequestFilter,
+) ([]*domain.ChangeRequest, error) {
+
+	where := []string{"c.tenant_id = $1"}
+	args := []any{tenantID}
+
+	if f.EmployeeID != "" {
+		args = append(args, f.EmployeeID)
+		where = append(where, fmt.Sprintf("c.employee_id = $%d", len(args)))
+	}
+	if f.Status != "" {
+		args = append(args, f.Status)
+		where = append(where, fmt.Sprintf("c.status = $%d", len(args)))
+	}
+
+	rows, err := r.query(ctx,
+		`SELECT `+changeRequestColumns+changeRequestFrom+`
+		 WHERE `+strings.Join(where, " AND ")+`
+		 ORDER BY c.created_at DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*domain.ChangeRequest
+	for rows.Next() {
+		c, err := scanChangeRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	return result, rows.Err()
+}
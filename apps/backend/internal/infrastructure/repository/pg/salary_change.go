@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type SalaryChangePostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ compensationrepository.SalaryChangeRepository = (*SalaryChangePostgresRepository)(nil)
+
+func NewSalaryChangePostgresRepository(db *pgxpool.Pool) *SalaryChangePostgresRepository {
+	return &SalaryChangePostgresRepository{db: db}
+}
+
+func (r *SalaryChangePostgresRepository) queryRow(
+	ctx context.Context,
+	query string,
+	args ...any,
+) pgx.Row {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.QueryRow(ctx, query, args...)
+	}
+	return r.db.QueryRow(ctx, query, args...)
+}
+
+func (r *SalaryChangePostgresRepository) query(
+	ctx context.Context,
+	query string,
+	args ...any,
+) (pgx.Rows, error) {
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		return tx.Query(ctx, query, args...)
+	}
+	return r.db.Query(ctx, query, args...)
+}
+
+func (r *SalaryChangePostgresRepository) Create(ctx context.Context, c *domain.SalaryChange) error {
+	return r.queryRow(ctx,
+		`INSERT INTO employee_salary_changes (
+			employee_id, effective_from, base_salary, currency,
+			reason, note, approved_by, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, $8)
+		RETURNING id`,
+		c.EmployeeID, c.EffectiveFrom, c.BaseSalary, c.BaseSalary.Currency(),
+		c.Reason, c.Note, c.ApprovedBy, c.CreatedAt,
+	).Scan(&c.ID)
+}
+
+func (r *SalaryChangePostgresRepository) ListByEmployeeID(ctx context.Context, employeeID string) (domain.History, error) {
+	rows, err := r.query(ctx,
+		`SELECT id, employee_id, effective_from, base_salary, currency,
+		        reason, note, COALESCE(approved_by::text, ''), created_at
+		 FROM employee_salary_changes
+		 WHERE employee_id = $1
+		 ORDER BY effective_from DESC, created_at DESC`,
+		employeeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result domain.History
+	for rows.Next() {
+		var c domain.SalaryChange
+		var currency money.Currency
+
+		if err := rows.Scan(
+			&c.ID, &c.EmployeeID, &c.EffectiveFrom, &c.BaseSalary, &currency,
+			&c.Reason, &c.Note, &c.ApprovedBy, &c.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		c.BaseSalary = c.BaseSalary.WithCurrency(currency)
+		result = append(result, &c)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type SalaryComponentPostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ salarycomponentrepository.SalaryComponentRepository = (*SalaryComponentPostgresRepository)(nil)
+
+func NewSalaryComponentPostgresRepository(db *pgxpool.Pool) *SalaryComponentPostgresRepository {
+	return &SalaryComponentPostgresRepository{db: db}
+}
+
+func (r *SalaryComponentPostgresRepository) Create(
+	ctx context.Context,
+	c *domain.SalaryComponent,
+) error {
+
+	err := r.db.QueryRow(ctx,
+		`INSERT INTO salary_components (
+			tenant_id,
+			code,
+			name,
+			kind,
+			taxable,
+			formula,
+			run_types,
+			sort_order,
+			is_active,
+			created_at,
+			updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		c.TenantID,
+		c.Code,
+		c.Name,
+		c.Kind,
+		c.Taxable,
+		c.Formula,
+		runTypeStrings(c.RunTypes),
+		c.SortOrder,
+		c.IsActive,
+		c.CreatedAt,
+		c.UpdatedAt,
+	).Scan(&c.ID)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			// unique constraint on (tenant_id, code)
+			return salarycomponentrepository.ErrSalaryComponentAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *SalaryComponentPostgresRepository) Update(
+	ctx context.Context,
+	c *domain.SalaryComponent,
+) error {
+
+	cmd, err := r.db.Exec(ctx,
+		`UPDATE salary_components
+		 SET name = $1,
+		     kind = $2,
+		     taxable = $3,
+		     formula = $4,
+		     run_types = $5,
+		     sort_order = $6,
+		     is_active = $7,
+		     updated_at = $8
+		 WHERE id = $9`,
+		c.Name,
+		c.Kind,
+		c.Taxable,
+		c.Formula,
+		runTypeStrings(c.RunTypes),
+		c.SortOrder,
+		c.IsActive,
+		c.UpdatedAt,
+		c.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return salarycomponentrepository.ErrSalaryComponentNotFound
+	}
+
+	return nil
+}
+
+func (r *SalaryComponentPostgresRepository) Delete(
+	ctx context.Context,
+	id string,
+) error {
+
+	cmd, err := r.db.Exec(ctx,
+		`DELETE FROM salary_components WHERE id = $1`,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	if cmd.RowsAffected() == 0 {
+		return salarycomponentrepository.ErrSalaryComponentNotFound
+	}
+
+	return nil
+}
+
+func scanSalaryComponent(row pgx.Row) (*domain.SalaryComponent, error) {
+	var c domain.SalaryComponent
+	var runTypes []string
+
+	err := row.Scan(
+		&c.ID,
+		&c.TenantID,
+		&c.Code,
+		&c.Name,
+		&c.Kind,
+		&c.Taxable,
+		&c.Formula,
+		&runTypes,
+		&c.SortOrder,
+		&c.IsActive,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, salarycomponentrepository.ErrSalaryComponentNotFound
+		}
+		return nil, err
+	}
+
+	for _, t := range runTypes {
+		c.RunTypes = append(c.RunTypes, payrolldomain.RunType(t))
+	}
+
+	return &c, nil
+}
+
+func (r *SalaryComponentPostgresRepository) GetByID(
+	ctx context.Context,
+	id string,
+) (*domain.SalaryComponent, error) {
+
+	return scanSalaryComponent(
+		r.db.QueryRow(ctx,
+			`SELECT id, tenant_id, code, name, kind, taxable, formula,
+			        run_types, sort_order, is_active, created_at, updated_at
+			 FROM salary_components
+			 WHERE id = $1`,
+			id,
+		),
+	)
+}
+
+func (r *SalaryComponentPostgresRepository) ListByTenant(
+	ctx context.Context,
+	tenantID string,
+	activeOnly bool,
+) ([]*domain.SalaryComponent, error) {
+
+	rows, err := r.db.Query(ctx,
+		`SELECT id, tenant_id, code, name, kind, taxable, formula,
+		        run_types, sort_order, is_active, created_at, updated_at
+		 FROM salary_components
+		 WHERE tenant_id = $1
+		   AND ($2 = FALSE OR is_active = TRUE)
+		 ORDER BY sort_order ASC, code ASC`,
+		tenantID,
+		activeOnly,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var components []*domain.SalaryComponent
+
+	for rows.Next() {
+		c, err := scanSalaryComponent(rows)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, c)
+	}
+
+	return components, rows.Err()
+}
+
+func runTypeStrings(types []payrolldomain.RunType) []string {
+	result := make([]string, len(types))
+	for i, t := range types {
+		result[i] = string(t)
+	}
+	return result
+}
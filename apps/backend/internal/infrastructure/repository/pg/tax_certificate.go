@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type TaxCertificatePostgresRepository struct {
+	db *pgxpool.Pool
+}
+
+var _ payrollrepository.TaxCertificateRepository = (*TaxCertificatePostgresRepository)(nil)
+
+func NewTaxCertificatePostgresRepository(db *pgxpool.Pool) *TaxCertificatePostgresRepository {
+	return &TaxCertificatePostgresRepository{db: db}
+}
+
+func (r *TaxCertificatePostgresRepository) Upsert(ctx context.Context, c *domain.TaxCertificate) error {
+	return r.db.QueryRow(ctx,
+		`INSERT INTO tax_certificates (
+			tenant_id,
+			employee_id,
+			year,
+			status,
+			storage_path,
+			error,
+			generated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (employee_id, year) DO UPDATE
+		SET tenant_id = EXCLUDED.tenant_id,
+		    status = EXCLUDED.status,
+		    storage_path = EXCLUDED.storage_path,
+		    error = EXCLUDED.error,
+		    generated_at = EXCLUDED.generated_at
+		RETURNING id`,
+		c.TenantID,
+		c.EmployeeID,
+		c.Year,
+		c.Status,
+		c.StoragePath,
+		c.Error,
+		c.GeneratedAt,
+	).Scan(&c.ID)
+}
+
+func scanTaxCertificate(row pgx.Row) (*domain.TaxCertificate, error) {
+	var c domain.TaxCertificate
+
+	err := row.Scan(
+		&c.ID,
+		&c.TenantID,
+		&c.EmployeeID,
+		&c.Year,
+		&c.Status,
+		&c.StoragePath,
+		&c.Error,
+		&c.GeneratedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, payrollrepository.ErrTaxCertificateNotFound
+		}
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (r *TaxCertificatePostgresRepository) GetByID(ctx context.Context, id string) (*domain.TaxCertificate, error) {
+	return scanTaxCertificate(
+		r.db.QueryRow(ctx,
+			`SELECT id, tenant_id, employee_id, year, status,
+			        storage_path, error, generated_at
+			 FROM tax_certificates
+			 WHERE id = $1`,
+			id,
+		),
+	)
+}
+
+func (r *TaxCertificatePostgresRepository) ListByTenantYear(
+	ctx context.Context,
+	tenantID string,
+	year int,
+) ([]*domain.TaxCertificate, error) {
+
+	rows, err := r.db.Query(ctx,
+		`SELECT id, tenant_id, employee_id, year, status,
+		        storage_path, error, generated_at
+		 FROM tax_certificates
+		 WHERE tenant_id = $1 AND year = $2
+		 ORDER BY generated_at`,
+		tenantID, year,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*domain.TaxCertificate
+	for rows.Next() {
+		c, err := scanTaxCertificate(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, c)
+	}
+
+	return results, rows.Err()
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
 	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 )
 
 type UserPostgresRepository struct {
@@ -17,13 +18,17 @@ func NewUserPostgresRepository(db *pgxpool.Pool) userrepository.UserRepository {
 	return &UserPostgresRepository{db: db}
 }
 
-func (r *UserPostgresRepository) Create(u *domain.User) error {
-	_, err := r.db.Exec(context.Background(),
-		`INSERT INTO users 
+func (r *UserPostgresRepository) Create(ctx context.Context, u *domain.User) error {
+	query := `INSERT INTO users 
 		 (email, password_hash, role, employee_id)
-		 VALUES ($1, $2, $3, $4)`,
-		u.Email, u.PasswordHash, u.Role, *u.EmployeeID,
-	)
+		 VALUES ($1, $2, $3, $4)`
+	args := []any{u.Email, u.PasswordHash, u.Role, *u.EmployeeID}
+
+	if tx, ok := txpkg.TxFromContext(ctx); ok {
+		_, err := tx.Exec(ctx, query, args...)
+		return err
+	}
+	_, err := r.db.Exec(ctx, query, args...)
 	return err
 }
 
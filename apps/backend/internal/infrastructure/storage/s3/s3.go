@@ -49,12 +49,17 @@ func NewS3Client(ctx context.Context, region string) (*s3.Client, error) {
 func (s *S3Storage) Upload(ctx context.Context, in storageports.UploadInput) (*storageports.FileMetadata, error) {
 	key := fmt.Sprintf("%s/%s", strings.TrimRight(in.Path, "/"), in.Filename)
 
+	acl := types.ObjectCannedACLPublicRead
+	if in.Private {
+		acl = types.ObjectCannedACLPrivate
+	}
+
 	out, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(s.bucket),
 		Key:         aws.String(key),
 		Body:        in.Reader,
 		ContentType: aws.String(in.ContentType),
-		ACL:         types.ObjectCannedACLPublicRead,
+		ACL:         acl,
 	})
 	if err != nil {
 		return nil, err
@@ -20,6 +20,8 @@ type UploadInput struct {
 	Reader      io.Reader
 	Size        int64
 	ContentType string
+	// Private objects are only reachable through a presigned URL.
+	Private bool
 }
 
 type PresignInput struct {
@@ -0,0 +1,11 @@
+package bankaccounthandlerdto
+
+type BankAccountRequest struct {
+	BankName      string  `json:"bankName" validate:"required,max=255"`
+	BankCode      string  `json:"bankCode" validate:"required,max=35"`
+	BranchCode    *string `json:"branchCode" validate:"omitempty,max=35"`
+	AccountHolder string  `json:"accountHolder" validate:"required,max=255"`
+	AccountNumber string  `json:"accountNumber" validate:"required,max=64"`
+	Currency      string  `json:"currency" validate:"omitempty,len=3"`
+	IsPrimary     bool    `json:"isPrimary"`
+}
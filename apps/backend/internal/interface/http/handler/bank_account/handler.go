@@ -0,0 +1,161 @@
+package bankaccounthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	bankaccounthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/bank_account/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	bankaccountusecase "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type BankAccountHandler struct {
+	CreateUC *bankaccountusecase.CreateBankAccountUsecase
+	UpdateUC *bankaccountusecase.UpdateBankAccountUsecase
+	DeleteUC *bankaccountusecase.DeleteBankAccountUsecase
+	ListUC   *bankaccountusecase.ListBankAccountsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewBankAccountHandler(
+	createUC *bankaccountusecase.CreateBankAccountUsecase,
+	updateUC *bankaccountusecase.UpdateBankAccountUsecase,
+	deleteUC *bankaccountusecase.DeleteBankAccountUsecase,
+	listUC *bankaccountusecase.ListBankAccountsUsecase,
+) *BankAccountHandler {
+	return &BankAccountHandler{
+		CreateUC: createUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *BankAccountHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	accounts, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), userID)
+	if errors.Is(err, domain.ErrAccountsForbidden) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, accounts, http.StatusOK)
+}
+
+func (h *BankAccountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	account, err := h.CreateUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), in, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, account, http.StatusCreated)
+}
+
+func (h *BankAccountHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	account, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, account, http.StatusOK)
+}
+
+func (h *BankAccountHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.DeleteUC.Execute(r.Context(), chi.URLParam(r, "id"), userID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.BankAccountInput, bool) {
+	var body bankaccounthandlerdto.BankAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.BankAccountInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.BankAccountInput{}, false
+	}
+
+	var currency money.Currency
+	if body.Currency != "" {
+		parsed, err := money.ParseCurrency(body.Currency)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return domain.BankAccountInput{}, false
+		}
+		currency = parsed
+	}
+
+	return domain.BankAccountInput{
+		BankName:      body.BankName,
+		BankCode:      body.BankCode,
+		BranchCode:    body.BranchCode,
+		AccountHolder: body.AccountHolder,
+		AccountNumber: body.AccountNumber,
+		Currency:      currency,
+		IsPrimary:     body.IsPrimary,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, bankaccountrepository.ErrBankAccountNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNotHR):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,10 @@
+package bankaccounthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *BankAccountHandler) Routes(r chi.Router) {
+	r.Get("/employee/{employeeId}", h.ListByEmployee)
+	r.Post("/employee/{employeeId}", h.Create)
+	r.Put("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+}
@@ -0,0 +1,14 @@
+package compensationhandlerdto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type SalaryChangeRequest struct {
+	BaseSalary    json.Number `json:"base_salary" validate:"required"`
+	Currency      string      `json:"currency" validate:"omitempty,len=3"`
+	EffectiveFrom time.Time   `json:"effective_from" validate:"required"`
+	Reason        string      `json:"reason" validate:"required,oneof=PROMOTION MERIT ADJUSTMENT"`
+	Note          *string     `json:"note" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,105 @@
+package compensationhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	compensationhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/compensation/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+	compensationusecase "github.com/smart-hmm/smart-hmm/internal/modules/compensation/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type CompensationHandler struct {
+	RecordUC *compensationusecase.RecordSalaryChangeUsecase
+	ListUC   *compensationusecase.ListSalaryHistoryUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewCompensationHandler(
+	recordUC *compensationusecase.RecordSalaryChangeUsecase,
+	listUC *compensationusecase.ListSalaryHistoryUsecase,
+) *CompensationHandler {
+	return &CompensationHandler{
+		RecordUC: recordUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *CompensationHandler) ListHistory(w http.ResponseWriter, r *http.Request) {
+	history, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, history, http.StatusOK)
+}
+
+func (h *CompensationHandler) RecordChange(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body compensationhandlerdto.SalaryChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	currency := money.DefaultCurrency
+	if body.Currency != "" {
+		parsed, err := money.ParseCurrency(body.Currency)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		currency = parsed
+	}
+
+	baseSalary, err := money.Parse(body.BaseSalary.String(), currency)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	change, err := h.RecordUC.Execute(r.Context(), compensationusecase.RecordSalaryChangeInput{
+		EmployeeID:    chi.URLParam(r, "employeeId"),
+		EffectiveFrom: body.EffectiveFrom,
+		BaseSalary:    baseSalary,
+		Reason:        domain.ChangeReason(body.Reason),
+		Note:          body.Note,
+		ApprovedBy:    userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, change, http.StatusCreated)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidReason),
+		errors.Is(err, domain.ErrNegativeSalary),
+		errors.Is(err, domain.ErrEffectiveDate),
+		errors.Is(err, domain.ErrApproverRequired):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,8 @@
+package compensationhandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *CompensationHandler) Routes(r chi.Router) {
+	r.Get("/employee/{employeeId}", h.ListHistory)
+	r.Post("/employee/{employeeId}", h.RecordChange)
+}
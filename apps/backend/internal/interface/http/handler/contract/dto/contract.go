@@ -0,0 +1,13 @@
+package contracthandlerdto
+
+import "time"
+
+type ContractRequest struct {
+	Type             string     `json:"type" validate:"required,oneof=INDEFINITE FIXED_TERM PROBATION"`
+	Number           string     `json:"number" validate:"required,max=100"`
+	StartDate        time.Time  `json:"start_date" validate:"required"`
+	EndDate          *time.Time `json:"end_date"`
+	ProbationEndDate *time.Time `json:"probation_end_date"`
+	SignedFileID     *string    `json:"signed_file_id" validate:"omitempty,uuid"`
+	Note             *string    `json:"note" validate:"omitempty,max=1000"`
+}
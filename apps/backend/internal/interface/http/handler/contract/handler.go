@@ -0,0 +1,190 @@
+package contracthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	contracthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/contract/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	contractusecase "github.com/smart-hmm/smart-hmm/internal/modules/contract/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type ContractHandler struct {
+	CreateUC       *contractusecase.CreateContractUsecase
+	UpdateUC       *contractusecase.UpdateContractUsecase
+	DeleteUC       *contractusecase.DeleteContractUsecase
+	RenewUC        *contractusecase.RenewContractUsecase
+	ListUC         *contractusecase.ListContractsUsecase
+	ListExpiringUC *contractusecase.ListExpiringContractsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewContractHandler(
+	createUC *contractusecase.CreateContractUsecase,
+	updateUC *contractusecase.UpdateContractUsecase,
+	deleteUC *contractusecase.DeleteContractUsecase,
+	renewUC *contractusecase.RenewContractUsecase,
+	listUC *contractusecase.ListContractsUsecase,
+	listExpiringUC *contractusecase.ListExpiringContractsUsecase,
+) *ContractHandler {
+	return &ContractHandler{
+		CreateUC:       createUC,
+		UpdateUC:       updateUC,
+		DeleteUC:       deleteUC,
+		RenewUC:        renewUC,
+		ListUC:         listUC,
+		ListExpiringUC: listExpiringUC,
+	}
+}
+
+func (h *ContractHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
+	contracts, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, contracts, http.StatusOK)
+}
+
+func (h *ContractHandler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	contract, err := h.CreateUC.Execute(r.Context(), tenantID, chi.URLParam(r, "employeeId"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, contract, http.StatusCreated)
+}
+
+func (h *ContractHandler) Update(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	contract, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, contract, http.StatusOK)
+}
+
+func (h *ContractHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.DeleteUC.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ContractHandler) Renew(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	contract, err := h.RenewUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, contract, http.StatusCreated)
+}
+
+// ListExpiring lists the contracts ending or leaving probation within
+// ?days= (default 30) from today.
+func (h *ContractHandler) ListExpiring(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	days := 30
+	if v := r.URL.Query().Get("days"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			http.Error(w, "invalid days", http.StatusBadRequest)
+			return
+		}
+		days = parsed
+	}
+
+	contracts, err := h.ListExpiringUC.Execute(r.Context(), tenantID, time.Now().UTC(), days)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, contracts, http.StatusOK)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.ContractInput, bool) {
+	var body contracthandlerdto.ContractRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.ContractInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.ContractInput{}, false
+	}
+
+	return domain.ContractInput{
+		Type:             domain.ContractType(body.Type),
+		Number:           body.Number,
+		StartDate:        body.StartDate,
+		EndDate:          body.EndDate,
+		ProbationEndDate: body.ProbationEndDate,
+		SignedFileID:     body.SignedFileID,
+		Note:             body.Note,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, contractrepository.ErrContractNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, contractrepository.ErrContractNumberExists),
+		errors.Is(err, contractrepository.ErrContractAlreadyRenewed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrInvalidType),
+		errors.Is(err, domain.ErrNumberRequired),
+		errors.Is(err, domain.ErrStartDate),
+		errors.Is(err, domain.ErrEndDateRequired),
+		errors.Is(err, domain.ErrEndDateNotAllowed),
+		errors.Is(err, domain.ErrEndBeforeStart),
+		errors.Is(err, domain.ErrFixedTermTooLong),
+		errors.Is(err, domain.ErrProbationPeriod),
+		errors.Is(err, domain.ErrRenewalLimit),
+		errors.Is(err, domain.ErrNotRenewable):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,12 @@
+package contracthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *ContractHandler) Routes(r chi.Router) {
+	r.Get("/employee/{employeeId}", h.ListByEmployee)
+	r.Post("/employee/{employeeId}", h.Create)
+	r.Get("/expiring", h.ListExpiring)
+	r.Put("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+	r.Post("/{id}/renew", h.Renew)
+}
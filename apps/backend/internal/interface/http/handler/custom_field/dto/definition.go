@@ -0,0 +1,17 @@
+package customfieldhandlerdto
+
+// DefinitionRequest creates or updates a custom field. Key and type are
+// ignored on update.
+type DefinitionRequest struct {
+	Key       string   `json:"key" validate:"required,max=50"`
+	Label     string   `json:"label" validate:"required,max=255"`
+	Type      string   `json:"type" validate:"required,oneof=TEXT NUMBER DATE BOOLEAN SELECT"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options" validate:"omitempty,dive,max=255"`
+	Pattern   *string  `json:"pattern" validate:"omitempty,max=500"`
+	MaxLength *int     `json:"max_length" validate:"omitempty,gt=0"`
+	Min       *float64 `json:"min"`
+	Max       *float64 `json:"max"`
+	VisibleTo []string `json:"visible_to" validate:"omitempty,dive,oneof=ADMIN HR MANAGER EMPLOYEE"`
+	Position  int      `json:"position"`
+}
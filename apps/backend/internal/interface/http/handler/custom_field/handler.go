@@ -0,0 +1,185 @@
+package customfieldhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	customfieldhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/custom_field/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	customfieldusecase "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/usecase"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type CustomFieldHandler struct {
+	CreateUC  *customfieldusecase.CreateDefinitionUsecase
+	UpdateUC  *customfieldusecase.UpdateDefinitionUsecase
+	DeleteUC  *customfieldusecase.DeleteDefinitionUsecase
+	ListUC    *customfieldusecase.ListDefinitionsUsecase
+	VisibleUC *customfieldusecase.VisibleFieldsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewCustomFieldHandler(
+	createUC *customfieldusecase.CreateDefinitionUsecase,
+	updateUC *customfieldusecase.UpdateDefinitionUsecase,
+	deleteUC *customfieldusecase.DeleteDefinitionUsecase,
+	listUC *customfieldusecase.ListDefinitionsUsecase,
+	visibleUC *customfieldusecase.VisibleFieldsUsecase,
+) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		CreateUC:  createUC,
+		UpdateUC:  updateUC,
+		DeleteUC:  deleteUC,
+		ListUC:    listUC,
+		VisibleUC: visibleUC,
+	}
+}
+
+// List returns the whole schema of the tenant.
+func (h *CustomFieldHandler) List(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	schema, err := h.ListUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, schema, http.StatusOK)
+}
+
+// ListVisible returns the fields the caller's role sees, for building
+// employee forms.
+func (h *CustomFieldHandler) ListVisible(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	schema, err := h.VisibleUC.Execute(r.Context(), tenantID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, schema, http.StatusOK)
+}
+
+func (h *CustomFieldHandler) Create(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.CreateUC.Execute(r.Context(), tenantID, in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, d, http.StatusCreated)
+}
+
+func (h *CustomFieldHandler) Update(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, d, http.StatusOK)
+}
+
+// Delete removes the field and its values from every employee.
+func (h *CustomFieldHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.DeleteUC.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.DefinitionInput, bool) {
+	var body customfieldhandlerdto.DefinitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.DefinitionInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.DefinitionInput{}, false
+	}
+
+	roles := make([]userdomain.UserRole, len(body.VisibleTo))
+	for i, role := range body.VisibleTo {
+		roles[i] = userdomain.UserRole(role)
+	}
+
+	return domain.DefinitionInput{
+		Key:       body.Key,
+		Label:     body.Label,
+		Type:      domain.FieldType(body.Type),
+		Required:  body.Required,
+		Options:   body.Options,
+		Pattern:   body.Pattern,
+		MaxLength: body.MaxLength,
+		Min:       body.Min,
+		Max:       body.Max,
+		VisibleTo: roles,
+		Position:  body.Position,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, customfieldrepository.ErrDefinitionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, customfieldrepository.ErrKeyExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrInvalidKey),
+		errors.Is(err, domain.ErrLabelRequired),
+		errors.Is(err, domain.ErrInvalidType),
+		errors.Is(err, domain.ErrOptionsRequired),
+		errors.Is(err, domain.ErrOptionsNotAllowed),
+		errors.Is(err, domain.ErrPatternNotAllowed),
+		errors.Is(err, domain.ErrInvalidPattern),
+		errors.Is(err, domain.ErrMaxLengthNotAllowed),
+		errors.Is(err, domain.ErrRangeNotAllowed),
+		errors.Is(err, domain.ErrInvalidRange),
+		errors.Is(err, domain.ErrInvalidRole):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
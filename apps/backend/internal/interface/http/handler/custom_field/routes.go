@@ -0,0 +1,11 @@
+package customfieldhandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *CustomFieldHandler) Routes(r chi.Router) {
+	r.Get("/", h.List)
+	r.Get("/visible", h.ListVisible)
+	r.Post("/", h.Create)
+	r.Put("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+}
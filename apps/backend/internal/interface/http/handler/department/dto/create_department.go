@@ -1,6 +1,8 @@
 package departmenthandlerdto
 
 type CreateDepartmentRequest struct {
-	Name      string  `json:"name" validate:"required"`
-	ManagerID *string `json:"managerId" validate:"required"`
+	Name       string  `json:"name" validate:"required"`
+	ManagerID  *string `json:"managerId" validate:"required"`
+	ParentID   *string `json:"parentId" validate:"omitempty,uuid"`
+	CostCenter *string `json:"costCenter" validate:"omitempty,max=20"`
 }
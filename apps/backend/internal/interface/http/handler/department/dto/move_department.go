@@ -0,0 +1,6 @@
+package departmenthandlerdto
+
+type MoveDepartmentRequest struct {
+	// ParentID is the new parent; null makes the department top-level.
+	ParentID *string `json:"parentId" validate:"omitempty,uuid"`
+}
@@ -1,6 +1,7 @@
 package departmenthandlerdto
 
 type UpdateDepartmentRequest struct {
-	Name      string  `json:"name" validate:"required"`
-	ManagerID *string `json:"managerId" validate:"required"`
+	Name       string  `json:"name" validate:"required"`
+	ManagerID  *string `json:"managerId" validate:"required"`
+	CostCenter *string `json:"costCenter" validate:"omitempty,max=20"`
 }
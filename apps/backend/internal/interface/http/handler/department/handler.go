@@ -2,7 +2,9 @@ package departmenthandler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
@@ -10,12 +12,16 @@ import (
 	"github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
 	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
 	departmentusecase "github.com/smart-hmm/smart-hmm/internal/modules/department/usecase"
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
 	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
 )
 
 type DepartmentHandler struct {
 	CreateUC *departmentusecase.CreateDepartmentUsecase
 	UpdateUC *departmentusecase.UpdateDepartmentUsecase
+	MoveUC   *departmentusecase.MoveDepartmentUsecase
+	TreeUC   *departmentusecase.GetDepartmentTreeUsecase
 	Repo     departmentrepository.DepartmentRepository
 }
 
@@ -24,11 +30,15 @@ var validate = validator.New(validator.WithRequiredStructEnabled())
 func NewDepartmentHandler(
 	createUC *departmentusecase.CreateDepartmentUsecase,
 	updateUC *departmentusecase.UpdateDepartmentUsecase,
+	moveUC *departmentusecase.MoveDepartmentUsecase,
+	treeUC *departmentusecase.GetDepartmentTreeUsecase,
 	repo departmentrepository.DepartmentRepository,
 ) *DepartmentHandler {
 	return &DepartmentHandler{
 		CreateUC: createUC,
 		UpdateUC: updateUC,
+		MoveUC:   moveUC,
+		TreeUC:   treeUC,
 		Repo:     repo,
 	}
 }
@@ -45,9 +55,19 @@ func (h *DepartmentHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	dept, err := h.CreateUC.Execute(r.Context(), body.Name, body.ManagerID)
+	in := departmentusecase.CreateDepartmentInput{
+		Name:       body.Name,
+		ManagerID:  body.ManagerID,
+		ParentID:   body.ParentID,
+		CostCenter: body.CostCenter,
+	}
+	if tenantID := r.URL.Query().Get("tenantId"); tenantID != "" {
+		in.TenantID = &tenantID
+	}
+
+	dept, err := h.CreateUC.Execute(r.Context(), in)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, err)
 		return
 	}
 
@@ -69,13 +89,14 @@ func (h *DepartmentHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	dept := &domain.Department{
-		ID:        id,
-		Name:      body.Name,
-		ManagerID: body.ManagerID,
+		ID:         id,
+		Name:       body.Name,
+		ManagerID:  body.ManagerID,
+		CostCenter: body.CostCenter,
 	}
 
 	if err := h.UpdateUC.Execute(r.Context(), dept); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeError(w, err)
 		return
 	}
 
@@ -107,3 +128,106 @@ func (h *DepartmentHandler) List(w http.ResponseWriter, r *http.Request) {
 
 	httpx.WriteJSON(w, depts, http.StatusOK)
 }
+
+// Tree shows the tenant's department tree with headcount and payroll cost
+// rolled up for ?period=YYYY-MM, the current month by default.
+func (h *DepartmentHandler) Tree(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now().UTC()
+	period := q.Get("period")
+	if period == "" {
+		period = now.Format("2006-01")
+	}
+
+	tree, err := h.TreeUC.Execute(r.Context(), tenantID, period, now)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, tree, http.StatusOK)
+}
+
+// Descendants lists every department below the department, at any depth.
+func (h *DepartmentHandler) Descendants(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if _, err := h.Repo.GetByID(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	descendants, err := h.Repo.ListDescendants(r.Context(), id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if descendants == nil {
+		descendants = []*domain.Descendant{}
+	}
+
+	httpx.WriteJSON(w, descendants, http.StatusOK)
+}
+
+func (h *DepartmentHandler) Move(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body departmenthandlerdto.MoveDepartmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	move, err := h.MoveUC.Execute(r.Context(), chi.URLParam(r, "id"), body.ParentID, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, move, http.StatusOK)
+}
+
+// ListMoves lists the department's moves, latest first.
+func (h *DepartmentHandler) ListMoves(w http.ResponseWriter, r *http.Request) {
+	moves, err := h.Repo.ListMoves(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if moves == nil {
+		moves = []*domain.Move{}
+	}
+
+	httpx.WriteJSON(w, moves, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, departmentrepository.ErrDepartmentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, departmentrepository.ErrCostCenterExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrCostCenter),
+		errors.Is(err, domain.ErrDepartmentCycle),
+		errors.Is(err, domain.ErrParentOtherTenant),
+		errors.Is(err, payrolldomain.ErrInvalidPeriod):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
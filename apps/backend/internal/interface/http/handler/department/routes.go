@@ -5,6 +5,10 @@ import "github.com/go-chi/chi/v5"
 func (h *DepartmentHandler) Routes(r chi.Router) {
 	r.Post("/", h.Create)
 	r.Get("/", h.List)
+	r.Get("/tree", h.Tree)
 	r.Get("/{id}", h.Get)
 	r.Put("/{id}", h.Update)
+	r.Get("/{id}/descendants", h.Descendants)
+	r.Post("/{id}/move", h.Move)
+	r.Get("/{id}/moves", h.ListMoves)
 }
@@ -0,0 +1,12 @@
+package dependenthandlerdto
+
+import "time"
+
+type DependentRequest struct {
+	FullName        string     `json:"full_name" validate:"required,max=255"`
+	Relationship    string     `json:"relationship" validate:"required,oneof=CHILD SPOUSE PARENT OTHER"`
+	DateOfBirth     time.Time  `json:"date_of_birth" validate:"required"`
+	TaxCode         *string    `json:"tax_code" validate:"omitempty,max=20"`
+	RegisteredFrom  time.Time  `json:"registered_from" validate:"required"`
+	RegisteredUntil *time.Time `json:"registered_until"`
+}
@@ -0,0 +1,126 @@
+package dependenthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	dependenthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/dependent/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/dependent/domain"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+	dependentusecase "github.com/smart-hmm/smart-hmm/internal/modules/dependent/usecase"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type DependentHandler struct {
+	CreateUC *dependentusecase.CreateDependentUsecase
+	UpdateUC *dependentusecase.UpdateDependentUsecase
+	DeleteUC *dependentusecase.DeleteDependentUsecase
+	ListUC   *dependentusecase.ListDependentsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewDependentHandler(
+	createUC *dependentusecase.CreateDependentUsecase,
+	updateUC *dependentusecase.UpdateDependentUsecase,
+	deleteUC *dependentusecase.DeleteDependentUsecase,
+	listUC *dependentusecase.ListDependentsUsecase,
+) *DependentHandler {
+	return &DependentHandler{
+		CreateUC: createUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *DependentHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
+	dependents, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, dependents, http.StatusOK)
+}
+
+func (h *DependentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.CreateUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, d, http.StatusCreated)
+}
+
+func (h *DependentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	d, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, d, http.StatusOK)
+}
+
+func (h *DependentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.DeleteUC.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.DependentInput, bool) {
+	var body dependenthandlerdto.DependentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.DependentInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.DependentInput{}, false
+	}
+
+	return domain.DependentInput{
+		FullName:        body.FullName,
+		Relationship:    domain.Relationship(body.Relationship),
+		DateOfBirth:     body.DateOfBirth,
+		TaxCode:         body.TaxCode,
+		RegisteredFrom:  body.RegisteredFrom,
+		RegisteredUntil: body.RegisteredUntil,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, dependentrepository.ErrDependentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNameRequired),
+		errors.Is(err, domain.ErrInvalidRelationship),
+		errors.Is(err, domain.ErrDateOfBirth),
+		errors.Is(err, domain.ErrRegisteredFrom),
+		errors.Is(err, domain.ErrRegisteredUntil),
+		errors.Is(err, statutorydomain.ErrTaxCode):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
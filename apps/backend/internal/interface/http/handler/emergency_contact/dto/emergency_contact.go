@@ -0,0 +1,11 @@
+package emergencycontacthandlerdto
+
+type EmergencyContactRequest struct {
+	FullName     string  `json:"full_name" validate:"required,max=255"`
+	Relationship string  `json:"relationship" validate:"required,max=50"`
+	Phone        string  `json:"phone" validate:"required,max=20"`
+	Email        *string `json:"email" validate:"omitempty,max=255"`
+	Address      *string `json:"address" validate:"omitempty,max=500"`
+	// Priority orders the contacts, 1 first; omitted means 1.
+	Priority int `json:"priority" validate:"omitempty,min=1,max=9"`
+}
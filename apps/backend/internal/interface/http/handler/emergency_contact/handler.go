@@ -0,0 +1,216 @@
+package emergencycontacthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	emergencycontacthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/emergency_contact/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/domain"
+	emergencycontactrepository "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/repository"
+	emergencycontactusecase "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/usecase"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type EmergencyContactHandler struct {
+	CreateUC     *emergencycontactusecase.CreateEmergencyContactUsecase
+	UpdateUC     *emergencycontactusecase.UpdateEmergencyContactUsecase
+	DeleteUC     *emergencycontactusecase.DeleteEmergencyContactUsecase
+	ListUC       *emergencycontactusecase.ListEmergencyContactsUsecase
+	ListMineUC   *emergencycontactusecase.ListMyEmergencyContactsUsecase
+	CreateMineUC *emergencycontactusecase.CreateMyEmergencyContactUsecase
+	UpdateMineUC *emergencycontactusecase.UpdateMyEmergencyContactUsecase
+	DeleteMineUC *emergencycontactusecase.DeleteMyEmergencyContactUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewEmergencyContactHandler(
+	createUC *emergencycontactusecase.CreateEmergencyContactUsecase,
+	updateUC *emergencycontactusecase.UpdateEmergencyContactUsecase,
+	deleteUC *emergencycontactusecase.DeleteEmergencyContactUsecase,
+	listUC *emergencycontactusecase.ListEmergencyContactsUsecase,
+	listMineUC *emergencycontactusecase.ListMyEmergencyContactsUsecase,
+	createMineUC *emergencycontactusecase.CreateMyEmergencyContactUsecase,
+	updateMineUC *emergencycontactusecase.UpdateMyEmergencyContactUsecase,
+	deleteMineUC *emergencycontactusecase.DeleteMyEmergencyContactUsecase,
+) *EmergencyContactHandler {
+	return &EmergencyContactHandler{
+		CreateUC:     createUC,
+		UpdateUC:     updateUC,
+		DeleteUC:     deleteUC,
+		ListUC:       listUC,
+		ListMineUC:   listMineUC,
+		CreateMineUC: createMineUC,
+		UpdateMineUC: updateMineUC,
+		DeleteMineUC: deleteMineUC,
+	}
+}
+
+func (h *EmergencyContactHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
+	contacts, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, contacts, http.StatusOK)
+}
+
+func (h *EmergencyContactHandler) Create(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.CreateUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusCreated)
+}
+
+func (h *EmergencyContactHandler) Update(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusOK)
+}
+
+func (h *EmergencyContactHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.DeleteUC.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListMine lists the caller's own contacts.
+func (h *EmergencyContactHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	contacts, err := h.ListMineUC.Execute(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, contacts, http.StatusOK)
+}
+
+func (h *EmergencyContactHandler) CreateMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.CreateMineUC.Execute(r.Context(), userID, in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusCreated)
+}
+
+func (h *EmergencyContactHandler) UpdateMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.UpdateMineUC.Execute(r.Context(), userID, chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusOK)
+}
+
+func (h *EmergencyContactHandler) DeleteMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.DeleteMineUC.Execute(r.Context(), userID, chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.EmergencyContactInput, bool) {
+	var body emergencycontacthandlerdto.EmergencyContactRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.EmergencyContactInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.EmergencyContactInput{}, false
+	}
+
+	return domain.EmergencyContactInput{
+		FullName:     body.FullName,
+		Relationship: body.Relationship,
+		Phone:        body.Phone,
+		Email:        body.Email,
+		Address:      body.Address,
+		Priority:     body.Priority,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, emergencycontactrepository.ErrEmergencyContactNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNoEmployee):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrTooManyContacts):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrNameRequired),
+		errors.Is(err, domain.ErrRelationshipRequired),
+		errors.Is(err, domain.ErrEmail),
+		errors.Is(err, domain.ErrPriority),
+		errors.Is(err, employeedomain.ErrPhone):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,15 @@
+package emergencycontacthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *EmergencyContactHandler) Routes(r chi.Router) {
+	r.Get("/me", h.ListMine)
+	r.Post("/me", h.CreateMine)
+	r.Put("/me/{id}", h.UpdateMine)
+	r.Delete("/me/{id}", h.DeleteMine)
+
+	r.Get("/employee/{employeeId}", h.ListByEmployee)
+	r.Post("/employee/{employeeId}", h.Create)
+	r.Put("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+}
@@ -1,9 +1,14 @@
 package employeehandlerdto
 
+import "encoding/json"
+
 type CreateEmployeeRequest struct {
-	Code       string  `json:"code" validate:"required"`
-	FirstName  string  `json:"first_name" validate:"required"`
-	LastName   string  `json:"last_name" validate:"required"`
-	Email      string  `json:"email" validate:"required"`
-	BaseSalary float64 `json:"base_salary" validate:"required"`
+	Code       string      `json:"code" validate:"required"`
+	FirstName  string      `json:"first_name" validate:"required"`
+	LastName   string      `json:"last_name" validate:"required"`
+	Email      string      `json:"email" validate:"required"`
+	BaseSalary json.Number `json:"base_salary" validate:"required"`
+	Currency   string      `json:"currency" validate:"omitempty,len=3"`
+	// CustomFields are checked against the tenant's custom field schema.
+	CustomFields map[string]any `json:"custom_fields"`
 }
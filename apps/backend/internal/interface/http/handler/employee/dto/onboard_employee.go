@@ -1,6 +1,11 @@
 package employeehandlerdto
 
-import userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+import (
+	"encoding/json"
+	"time"
+
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+)
 
 type OnboardEmployeeRequest struct {
 	Code       string              `json:"code" validate:"required"`
@@ -9,9 +14,23 @@ type OnboardEmployeeRequest struct {
 	Email      string              `json:"email" validate:"required,email"`
 	Position   string              `json:"position" validate:"required"`
 	Phone      string              `json:"phone" validate:"required"`
-	BaseSalary float64             `json:"base_salary" validate:"required"`
+	BaseSalary json.Number         `json:"base_salary" validate:"required"`
+	Currency   string              `json:"currency" validate:"omitempty,len=3"`
 	CreateUser bool                `json:"create_user"`
 	UserEmail  string              `json:"user_email" validate:"required_if=CreateUser true,email"`
 	Password   string              `json:"password" validate:"required_if=CreateUser true"`
 	Role       userdomain.UserRole `json:"role" validate:"required_if=CreateUser true,oneof=ADMIN HR MANAGER EMPLOYEE"`
+	// CustomFields are checked against the tenant's custom field schema.
+	CustomFields map[string]any `json:"custom_fields"`
+
+	DepartmentID   *string    `json:"department_id" validate:"omitempty,uuid"`
+	ManagerID      *string    `json:"manager_id" validate:"omitempty,uuid"`
+	JoinDate       *time.Time `json:"join_date"`
+	EmploymentType string     `json:"employment_type" validate:"omitempty,oneof=FULL_TIME PART_TIME INTERN CONTRACT"`
+	// ProbationEndDate is the last day of probation, at most 60 days
+	// after the join date.
+	ProbationEndDate *time.Time `json:"probation_end_date"`
+	// TemplateID picks the onboarding checklist; left empty the template
+	// of the department or the tenant's default applies.
+	TemplateID *string `json:"template_id" validate:"omitempty,uuid"`
 }
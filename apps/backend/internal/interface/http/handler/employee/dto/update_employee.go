@@ -1,23 +1,20 @@
 package employeehandlerdto
 
-import (
-	"time"
-
-	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
-)
+import "time"
 
+// UpdateEmployeeRequest changes profile fields only. Position, department,
+// manager, employment type and status change through a job change.
 type UpdateEmployeeRequest struct {
-	Code             string                  `json:"code" validate:"required"`
-	FirstName        string                  `json:"first_name" validate:"required"`
-	LastName         string                  `json:"last_name" validate:"required"`
-	Email            string                  `json:"email" validate:"required"`
-	Phone            string                  `json:"phone" validate:"required"`
-	DateOfBirth      *time.Time              `json:"date_of_birth" validate:"required"`
-	DepartmentID     string                  `json:"department_id" validate:"required"`
-	ManagerID        *string                 `json:"manager_id" validate:"required"`
-	Position         string                  `json:"position" validate:"required"`
-	EmploymentType   domain.EmploymentType   `json:"employment_type" validate:"required"`
-	EmploymentStatus domain.EmploymentStatus `json:"employment_status" validate:"required"`
-	JoinDate         time.Time               `json:"join_date" validate:"required"`
-	BaseSalary       float64                 `json:"base_salary" validate:"required"`
+	Code      string `json:"code" validate:"required"`
+	FirstName string `json:"first_name" validate:"required"`
+	LastName  string `json:"last_name" validate:"required"`
+	Email     string `json:"email" validate:"required"`
+	Phone     string `json:"phone" validate:"required"`
+	// Address is left as it is when omitted.
+	Address     *string    `json:"address" validate:"omitempty,max=500"`
+	DateOfBirth *time.Time `json:"date_of_birth" validate:"required"`
+	JoinDate    time.Time  `json:"join_date" validate:"required"`
+	// CustomFields lists the custom fields to change; null removes a
+	// value and fields not listed keep theirs.
+	CustomFields map[string]any `json:"custom_fields"`
 }
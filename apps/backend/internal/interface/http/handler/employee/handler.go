@@ -2,23 +2,32 @@ package employeehandler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	employeehandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee/dto"
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldusecase "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/usecase"
 	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
 	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
 	employeeusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
 	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
 )
 
 type EmployeeHandler struct {
 	CreateUC  *employeeusecase.CreateEmployeeUsecase
 	UpdateUC  *employeeusecase.UpdateEmployeeUsecase
 	OnboardUC *employeeusecase.OnboardEmployeeUsecase
+	FieldsUC  *customfieldusecase.VisibleFieldsUsecase
+	SearchUC  *employeeusecase.SearchEmployeesUsecase
+	SuggestUC *employeeusecase.SuggestEmployeesUsecase
 	Repo      employeerepository.EmployeeRepository
 }
 
@@ -28,12 +37,18 @@ func NewEmployeeHandler(
 	createUC *employeeusecase.CreateEmployeeUsecase,
 	updateUC *employeeusecase.UpdateEmployeeUsecase,
 	onboardUC *employeeusecase.OnboardEmployeeUsecase,
+	fieldsUC *customfieldusecase.VisibleFieldsUsecase,
+	searchUC *employeeusecase.SearchEmployeesUsecase,
+	suggestUC *employeeusecase.SuggestEmployeesUsecase,
 	repo employeerepository.EmployeeRepository,
 ) *EmployeeHandler {
 	return &EmployeeHandler{
 		CreateUC:  createUC,
 		UpdateUC:  updateUC,
 		OnboardUC: onboardUC,
+		FieldsUC:  fieldsUC,
+		SearchUC:  searchUC,
+		SuggestUC: suggestUC,
 		Repo:      repo,
 	}
 }
@@ -50,12 +65,20 @@ func (h *EmployeeHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	baseSalary, err := parseSalary(body.BaseSalary, body.Currency)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	e := &domain.Employee{
-		Code:       body.Code,
-		FirstName:  body.FirstName,
-		LastName:   body.LastName,
-		Email:      body.Email,
-		BaseSalary: body.BaseSalary,
+		TenantID:     r.URL.Query().Get("tenantId"),
+		Code:         body.Code,
+		FirstName:    body.FirstName,
+		LastName:     body.LastName,
+		Email:        body.Email,
+		BaseSalary:   baseSalary,
+		CustomFields: body.CustomFields,
 	}
 
 	created, err := h.CreateUC.Execute(r.Context(), e)
@@ -68,6 +91,12 @@ func (h *EmployeeHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *EmployeeHandler) Update(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 
 	var body employeehandlerdto.UpdateEmployeeRequest
@@ -82,23 +111,19 @@ func (h *EmployeeHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	e := &domain.Employee{
-		ID:               id,
-		Code:             body.Code,
-		FirstName:        body.FirstName,
-		LastName:         body.LastName,
-		Email:            body.Email,
-		Phone:            body.Phone,
-		DateOfBirth:      body.DateOfBirth,
-		DepartmentID:     &body.DepartmentID,
-		ManagerID:        body.ManagerID,
-		Position:         body.Position,
-		EmploymentType:   body.EmploymentType,
-		EmploymentStatus: body.EmploymentStatus,
-		JoinDate:         body.JoinDate,
-		BaseSalary:       body.BaseSalary,
+		ID:           id,
+		Code:         body.Code,
+		FirstName:    body.FirstName,
+		LastName:     body.LastName,
+		Email:        body.Email,
+		Phone:        body.Phone,
+		Address:      body.Address,
+		DateOfBirth:  body.DateOfBirth,
+		JoinDate:     body.JoinDate,
+		CustomFields: body.CustomFields,
 	}
 
-	if err := h.UpdateUC.Execute(r.Context(), e); err != nil {
+	if err := h.UpdateUC.Execute(r.Context(), e, userID); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -118,18 +143,38 @@ func (h *EmployeeHandler) Onboard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := h.OnboardUC.Execute(r.Context(), employeeusecase.OnboardEmployeeInput{
+	baseSalary, err := parseSalary(body.BaseSalary, body.Currency)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var joinDate time.Time
+	if body.JoinDate != nil {
+		joinDate = *body.JoinDate
+	}
+
+	_, err = h.OnboardUC.Execute(r.Context(), employeeusecase.OnboardEmployeeInput{
 		Code:       body.Code,
 		FirstName:  body.FirstName,
 		LastName:   body.LastName,
 		Email:      body.Email,
-		BaseSalary: body.BaseSalary,
+		BaseSalary: baseSalary,
 		CreateUser: body.CreateUser,
 		UserEmail:  body.UserEmail,
 		Password:   body.Password,
 		Role:       body.Role,
 		Phone:      body.Phone,
 		Position:   body.Position,
+
+		TenantID:         r.URL.Query().Get("tenantId"),
+		CustomFields:     body.CustomFields,
+		DepartmentID:     body.DepartmentID,
+		ManagerID:        body.ManagerID,
+		JoinDate:         joinDate,
+		EmploymentType:   domain.EmploymentType(body.EmploymentType),
+		ProbationEndDate: body.ProbationEndDate,
+		TemplateID:       body.TemplateID,
 	}, true)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -152,6 +197,11 @@ func (h *EmployeeHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.redactCustomFields(r, emp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	httpx.WriteJSON(w, emp, http.StatusOK)
 }
 
@@ -162,6 +212,11 @@ func (h *EmployeeHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.redactCustomFields(r, employees...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	httpx.WriteJSON(w, employees, http.StatusOK)
 }
 
@@ -174,6 +229,11 @@ func (h *EmployeeHandler) ListByDepartment(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if err := h.redactCustomFields(r, employees...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	httpx.WriteJSON(w, employees, http.StatusOK)
 }
 
@@ -215,12 +275,21 @@ func (h *EmployeeHandler) Find(w http.ResponseWriter, r *http.Request) {
 		limit = 20
 	}
 
+	customFields, err := h.customFieldFilter(r, tenantId)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	employees, totalPages, totalItems, err := h.Repo.Find(
-		tenantId,
-		name,
-		email,
-		code,
-		departmentIds,
+		domain.Filter{
+			TenantID:      tenantId,
+			Name:          name,
+			Email:         email,
+			Code:          code,
+			DepartmentIDs: departmentIds,
+			CustomFields:  customFields,
+		},
 		page,
 		limit,
 	)
@@ -229,6 +298,11 @@ func (h *EmployeeHandler) Find(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.redactCustomFields(r, employees...); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	data := map[string]any{
 		"pagination": map[string]any{
 			"totalPages":  totalPages,
@@ -241,3 +315,130 @@ func (h *EmployeeHandler) Find(w http.ResponseWriter, r *http.Request) {
 
 	httpx.WriteJSON(w, data, http.StatusOK)
 }
+
+// Search ranks the tenant's employees against ?q=, ignoring case and
+// diacritics and tolerating typos, across name, email, code and phone.
+func (h *EmployeeHandler) Search(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	page, err := strconv.Atoi(q.Get("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	hits, totalItems, err := h.SearchUC.Execute(r.Context(), tenantID, q.Get("q"), page, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrSearchQuery) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := map[string]any{
+		"pagination": map[string]any{
+			"totalPages":  (totalItems + limit - 1) / limit,
+			"totalItems":  totalItems,
+			"currentPage": page,
+			"limit":       limit,
+		},
+		"items": hits,
+	}
+
+	httpx.WriteJSON(w, data, http.StatusOK)
+}
+
+// Suggest returns up to ?limit= (default 8) matches for ?q= as the user
+// types.
+func (h *EmployeeHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 8
+	}
+
+	hits, err := h.SuggestUC.Execute(r.Context(), tenantID, q.Get("q"), limit)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, hits, http.StatusOK)
+}
+
+// customFieldFilter reads the custom field filters of the query. Only
+// fields the caller sees can be filtered on.
+func (h *EmployeeHandler) customFieldFilter(r *http.Request, tenantID string) (map[string]string, error) {
+	filters := map[string]string{}
+	for param, values := range r.URL.Query() {
+		if key, ok := strings.CutPrefix(param, customfielddomain.Prefix); ok && len(values) > 0 {
+			filters[key] = values[0]
+		}
+	}
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	userID, _ := authctx.UserID(r.Context())
+	schema, err := h.FieldsUC.Execute(r.Context(), tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.ParseFilter(filters)
+}
+
+// redactCustomFields drops the custom fields the caller's role does not
+// see. Employees may belong to different tenants.
+func (h *EmployeeHandler) redactCustomFields(r *http.Request, employees ...*domain.Employee) error {
+	userID, _ := authctx.UserID(r.Context())
+	schemas := map[string]customfielddomain.Schema{}
+
+	for _, e := range employees {
+		schema, ok := schemas[e.TenantID]
+		if !ok && e.TenantID != "" {
+			var err error
+			schema, err = h.FieldsUC.Execute(r.Context(), e.TenantID, userID)
+			if err != nil {
+				return err
+			}
+			schemas[e.TenantID] = schema
+		}
+		e.CustomFields = schema.Redact(e.CustomFields)
+	}
+
+	return nil
+}
+
+// parseSalary reads an exact decimal salary. The currency defaults to VND.
+func parseSalary(amount json.Number, currency string) (money.Money, error) {
+	cur := money.DefaultCurrency
+	if currency != "" {
+		parsed, err := money.ParseCurrency(currency)
+		if err != nil {
+			return money.Money{}, err
+		}
+		cur = parsed
+	}
+
+	return money.Parse(amount.String(), cur)
+}
@@ -7,6 +7,8 @@ func (h *EmployeeHandler) Routes(r chi.Router) {
 	r.Post("/", h.Create)
 	r.Get("/department/{departmentId}", h.ListByDepartment)
 	r.Get("/", h.Find)
+	r.Get("/search", h.Search)
+	r.Get("/search/suggest", h.Suggest)
 	r.Get("/{id}", h.Get)
 	r.Put("/{id}", h.Update)
 }
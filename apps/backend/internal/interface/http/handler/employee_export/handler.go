@@ -0,0 +1,174 @@
+package employeeexporthandler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+	employeeexportusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/spreadsheet"
+)
+
+type EmployeeExportHandler struct {
+	ExportUC *employeeexportusecase.ExportEmployeesUsecase
+	GetUC    *employeeexportusecase.GetExportUsecase
+	ListUC   *employeeexportusecase.ListExportsUsecase
+}
+
+func NewEmployeeExportHandler(
+	exportUC *employeeexportusecase.ExportEmployeesUsecase,
+	getUC *employeeexportusecase.GetExportUsecase,
+	listUC *employeeexportusecase.ListExportsUsecase,
+) *EmployeeExportHandler {
+	return &EmployeeExportHandler{
+		ExportUC: exportUC,
+		GetUC:    getUC,
+		ListUC:   listUC,
+	}
+}
+
+// Export takes the filters of GET /employees, custom.<key> ones
+// included, plus format (csv, xlsx or json, default csv) and a comma
+// separated columns list, custom.<key> for custom fields. Small
+// exports are the response body; larger ones answer 202 with an export
+// to poll on Get until its download_url is set.
+func (h *EmployeeExportHandler) Export(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+
+	filter := employeedomain.Filter{
+		TenantID:      q.Get("tenantId"),
+		Name:          q.Get("name"),
+		Email:         q.Get("email"),
+		Code:          q.Get("code"),
+		DepartmentIDs: departmentIDs(q["departmentId"], q.Get("departmentIds")),
+		CustomFields:  map[string]string{},
+	}
+	for param, values := range q {
+		if key, ok := strings.CutPrefix(param, customfielddomain.Prefix); ok && len(values) > 0 {
+			filter.CustomFields[key] = values[0]
+		}
+	}
+	if filter.TenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	format := spreadsheet.CSV
+	if v := q.Get("format"); v != "" {
+		parsed, err := spreadsheet.ParseFormat(v)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		format = parsed
+	}
+
+	var columns []domain.Column
+	for _, c := range splitList(q.Get("columns")) {
+		columns = append(columns, domain.Column(c))
+	}
+
+	streaming := false
+	open := func(exp *domain.Export) io.Writer {
+		streaming = true
+		w.Header().Set("Content-Type", format.ContentType())
+		w.Header().Set("Content-Disposition",
+			fmt.Sprintf(`attachment; filename="%s"`, exp.FileName(time.Now().UTC())))
+		w.WriteHeader(http.StatusOK)
+		return w
+	}
+
+	exp, err := h.ExportUC.Execute(r.Context(), format, columns, filter, userID, open)
+	if err != nil {
+		if streaming {
+			// The status is already sent; the client sees a truncated file.
+			log.Println("employee export interrupted:", err)
+			return
+		}
+		writeError(w, err)
+		return
+	}
+	if streaming {
+		return
+	}
+
+	httpx.WriteJSON(w, exp, http.StatusAccepted)
+}
+
+func (h *EmployeeExportHandler) List(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	exports, err := h.ListUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, exports, http.StatusOK)
+}
+
+func (h *EmployeeExportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	exp, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, exp, http.StatusOK)
+}
+
+// departmentIDs accepts repeated departmentId and a comma separated
+// departmentIds, like the employee search.
+func departmentIDs(repeated []string, list string) []string {
+	ids := append([]string{}, repeated...)
+	return append(ids, splitList(list)...)
+}
+
+func splitList(v string) []string {
+	var items []string
+	for item := range strings.SplitSeq(v, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var unknownColumn domain.UnknownColumnError
+	var valueErrs customfielddomain.ValueErrors
+
+	switch {
+	case errors.Is(err, employeeexportrepository.ErrExportNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrDuplicateColumn),
+		errors.Is(err, spreadsheet.ErrUnknownFormat),
+		errors.As(err, &unknownColumn),
+		errors.As(err, &valueErrs):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
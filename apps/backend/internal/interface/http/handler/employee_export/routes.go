@@ -0,0 +1,9 @@
+package employeeexporthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *EmployeeExportHandler) Routes(r chi.Router) {
+	r.Post("/", h.Export)
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Get)
+}
@@ -0,0 +1,13 @@
+package employeeimporthandlerdto
+
+type ImportRequest struct {
+	// Path is the storage path the sheet was uploaded to through
+	// /upload/presign.
+	Path string `json:"path" validate:"required"`
+	// Columns maps headers to fields, custom.<key> for custom fields.
+	Columns     map[string]string `json:"columns"`
+	Currency    string            `json:"currency" validate:"omitempty,len=3"`
+	CreateUsers bool              `json:"create_users"`
+	DefaultRole string            `json:"default_role" validate:"omitempty,oneof=ADMIN HR MANAGER EMPLOYEE"`
+	SendEmails  bool              `json:"send_emails"`
+}
@@ -0,0 +1,172 @@
+package employeeimporthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	employeeimporthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee_import/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+	employeeimportusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/usecase"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type EmployeeImportHandler struct {
+	DryRunUC  *employeeimportusecase.DryRunImportUsecase
+	RequestUC *employeeimportusecase.RequestImportUsecase
+	GetUC     *employeeimportusecase.GetImportUsecase
+	ListUC    *employeeimportusecase.ListImportsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewEmployeeImportHandler(
+	dryRunUC *employeeimportusecase.DryRunImportUsecase,
+	requestUC *employeeimportusecase.RequestImportUsecase,
+	getUC *employeeimportusecase.GetImportUsecase,
+	listUC *employeeimportusecase.ListImportsUsecase,
+) *EmployeeImportHandler {
+	return &EmployeeImportHandler{
+		DryRunUC:  dryRunUC,
+		RequestUC: requestUC,
+		GetUC:     getUC,
+		ListUC:    listUC,
+	}
+}
+
+// DryRun validates an uploaded sheet and returns the row errors and the
+// rows that would be imported.
+func (h *EmployeeImportHandler) DryRun(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	path, opts, ok := decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.DryRunUC.Execute(r.Context(), tenantID, path, opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, report, http.StatusOK)
+}
+
+// Request queues the import of an uploaded sheet; poll Get for progress.
+func (h *EmployeeImportHandler) Request(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	path, opts, ok := decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	imp, err := h.RequestUC.Execute(r.Context(), tenantID, path, opts, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, imp, http.StatusAccepted)
+}
+
+func (h *EmployeeImportHandler) List(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	imports, err := h.ListUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, imports, http.StatusOK)
+}
+
+func (h *EmployeeImportHandler) Get(w http.ResponseWriter, r *http.Request) {
+	imp, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, imp, http.StatusOK)
+}
+
+func decodeRequest(w http.ResponseWriter, r *http.Request) (string, domain.Options, bool) {
+	var body employeeimporthandlerdto.ImportRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return "", domain.Options{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return "", domain.Options{}, false
+	}
+
+	opts := domain.Options{
+		CreateUsers: body.CreateUsers,
+		DefaultRole: userdomain.UserRole(body.DefaultRole),
+		SendEmails:  body.SendEmails,
+	}
+
+	if body.Currency != "" {
+		currency, err := money.ParseCurrency(body.Currency)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return "", domain.Options{}, false
+		}
+		opts.Currency = currency
+	}
+
+	if len(body.Columns) > 0 {
+		opts.Columns = make(map[string]domain.Field, len(body.Columns))
+		for header, field := range body.Columns {
+			opts.Columns[header] = domain.Field(field)
+		}
+	}
+
+	return body.Path, opts, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var unknownField domain.UnknownFieldError
+
+	switch {
+	case errors.Is(err, employeeimportrepository.ErrImportNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidSheet),
+		errors.Is(err, domain.ErrPathRequired),
+		errors.Is(err, domain.ErrInvalidRole),
+		errors.Is(err, domain.ErrUsersWithoutEmails),
+		errors.As(err, &unknownField):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
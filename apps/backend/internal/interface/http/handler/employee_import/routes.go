@@ -0,0 +1,10 @@
+package employeeimporthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *EmployeeImportHandler) Routes(r chi.Router) {
+	r.Post("/dry-run", h.DryRun)
+	r.Post("/", h.Request)
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Get)
+}
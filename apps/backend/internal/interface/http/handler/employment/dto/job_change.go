@@ -0,0 +1,14 @@
+package employmenthandlerdto
+
+import "time"
+
+type JobChangeRequest struct {
+	EffectiveFrom    time.Time `json:"effective_from" validate:"required"`
+	Position         string    `json:"position" validate:"required,max=200"`
+	DepartmentID     *string   `json:"department_id" validate:"omitempty,uuid"`
+	ManagerID        *string   `json:"manager_id" validate:"omitempty,uuid"`
+	EmploymentType   string    `json:"employment_type" validate:"required,oneof=FULL_TIME PART_TIME INTERN CONTRACT"`
+	EmploymentStatus string    `json:"employment_status" validate:"required,oneof=ACTIVE PROBATION INACTIVE RESIGNED"`
+	Reason           string    `json:"reason" validate:"required,oneof=PROMOTION TRANSFER DEMOTION REORGANIZATION STATUS_CHANGE CORRECTION"`
+	Note             *string   `json:"note" validate:"omitempty,max=1000"`
+}
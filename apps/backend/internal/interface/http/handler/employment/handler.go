@@ -0,0 +1,182 @@
+package employmenthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	employmenthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employment/dto"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentusecase "github.com/smart-hmm/smart-hmm/internal/modules/employment/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type EmploymentHandler struct {
+	ChangeJobUC   *employmentusecase.ChangeJobUsecase
+	ListUC        *employmentusecase.ListEmploymentHistoryUsecase
+	MembersOnUC   *employmentusecase.ListDepartmentMembersOnUsecase
+	ListChangesUC *employmentusecase.ListJobChangesUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewEmploymentHandler(
+	changeJobUC *employmentusecase.ChangeJobUsecase,
+	listUC *employmentusecase.ListEmploymentHistoryUsecase,
+	membersOnUC *employmentusecase.ListDepartmentMembersOnUsecase,
+	listChangesUC *employmentusecase.ListJobChangesUsecase,
+) *EmploymentHandler {
+	return &EmploymentHandler{
+		ChangeJobUC:   changeJobUC,
+		ListUC:        listUC,
+		MembersOnUC:   membersOnUC,
+		ListChangesUC: listChangesUC,
+	}
+}
+
+func (h *EmploymentHandler) ListHistory(w http.ResponseWriter, r *http.Request) {
+	history, err := h.ListUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, history, http.StatusOK)
+}
+
+func (h *EmploymentHandler) ChangeJob(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body employmenthandlerdto.JobChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	record, err := h.ChangeJobUC.Execute(r.Context(), employmentusecase.ChangeJobInput{
+		EmployeeID:       chi.URLParam(r, "employeeId"),
+		EffectiveFrom:    body.EffectiveFrom,
+		Position:         body.Position,
+		DepartmentID:     body.DepartmentID,
+		ManagerID:        body.ManagerID,
+		EmploymentType:   employeedomain.EmploymentType(body.EmploymentType),
+		EmploymentStatus: employeedomain.EmploymentStatus(body.EmploymentStatus),
+		Reason:           domain.ChangeReason(body.Reason),
+		Note:             body.Note,
+		ChangedBy:        userID,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, record, http.StatusCreated)
+}
+
+// ListDepartmentMembers answers who was in the department on ?date=
+// (YYYY-MM-DD, default today).
+func (h *EmploymentHandler) ListDepartmentMembers(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	day, err := queryDate(r, "date", time.Now().UTC())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	records, err := h.MembersOnUC.Execute(r.Context(), tenantID, chi.URLParam(r, "departmentId"), day)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, records, http.StatusOK)
+}
+
+// ListChanges reports the job changes with ?reason= (default PROMOTION)
+// effective between ?from= and ?to=, by default the current year.
+func (h *EmploymentHandler) ListChanges(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	reason := domain.ReasonPromotion
+	if v := q.Get("reason"); v != "" {
+		reason = domain.ChangeReason(v)
+	}
+
+	now := time.Now().UTC()
+
+	from, err := queryDate(r, "from", time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	to, err := queryDate(r, "to", time.Date(now.Year(), time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	records, err := h.ListChangesUC.Execute(r.Context(), tenantID, reason, from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, records, http.StatusOK)
+}
+
+func queryDate(r *http.Request, key string, fallback time.Time) (time.Time, error) {
+	v := r.URL.Query().Get(key)
+	if v == "" {
+		return fallback, nil
+	}
+
+	day, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return time.Time{}, errors.New("invalid " + key + ": expected YYYY-MM-DD")
+	}
+	return day, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidReason),
+		errors.Is(err, domain.ErrEffectiveDate),
+		errors.Is(err, domain.ErrPositionRequired),
+		errors.Is(err, domain.ErrInvalidEmploymentType),
+		errors.Is(err, domain.ErrInvalidStatus),
+		errors.Is(err, domain.ErrSelfManager),
+		errors.Is(err, domain.ErrReportingCycle),
+		errors.Is(err, domain.ErrManagerOtherTenant),
+		errors.Is(err, domain.ErrChangedByRequired),
+		errors.Is(err, domain.ErrNoJobChange):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
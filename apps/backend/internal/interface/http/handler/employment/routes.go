@@ -0,0 +1,10 @@
+package employmenthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *EmploymentHandler) Routes(r chi.Router) {
+	r.Get("/employee/{employeeId}", h.ListHistory)
+	r.Post("/employee/{employeeId}", h.ChangeJob)
+	r.Get("/department/{departmentId}", h.ListDepartmentMembers)
+	r.Get("/changes", h.ListChanges)
+}
@@ -0,0 +1,13 @@
+package offboardinghandlerdto
+
+import "time"
+
+type StartOffboardingRequest struct {
+	LastWorkingDay time.Time `json:"last_working_day" validate:"required"`
+	Reason         string    `json:"reason" validate:"required,oneof=RESIGNATION DISMISSAL END_OF_CONTRACT RETIREMENT MUTUAL_AGREEMENT"`
+	Note           *string   `json:"note" validate:"omitempty,max=1000"`
+}
+
+type CompleteTaskRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
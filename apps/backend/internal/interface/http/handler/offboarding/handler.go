@@ -0,0 +1,174 @@
+package offboardinghandler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	offboardinghandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/offboarding/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	offboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type OffboardingHandler struct {
+	StartUC         *offboardingusecase.StartOffboardingUsecase
+	GetUC           *offboardingusecase.GetOffboardingUsecase
+	GetByEmployeeUC *offboardingusecase.GetEmployeeOffboardingUsecase
+	ListUC          *offboardingusecase.ListOffboardingsUsecase
+	CompleteTaskUC  *offboardingusecase.CompleteTaskUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewOffboardingHandler(
+	startUC *offboardingusecase.StartOffboardingUsecase,
+	getUC *offboardingusecase.GetOffboardingUsecase,
+	getByEmployeeUC *offboardingusecase.GetEmployeeOffboardingUsecase,
+	listUC *offboardingusecase.ListOffboardingsUsecase,
+	completeTaskUC *offboardingusecase.CompleteTaskUsecase,
+) *OffboardingHandler {
+	return &OffboardingHandler{
+		StartUC:         startUC,
+		GetUC:           getUC,
+		GetByEmployeeUC: getByEmployeeUC,
+		ListUC:          listUC,
+		CompleteTaskUC:  completeTaskUC,
+	}
+}
+
+// Start offboards the employee instead of deleting them; their records
+// are kept for legal retention.
+func (h *OffboardingHandler) Start(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	var body offboardinghandlerdto.StartOffboardingRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	o, err := h.StartUC.Execute(r.Context(), tenantID, chi.URLParam(r, "employeeId"), domain.OffboardingInput{
+		LastWorkingDay: body.LastWorkingDay,
+		Reason:         domain.Reason(body.Reason),
+		Note:           body.Note,
+	}, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, o, http.StatusCreated)
+}
+
+func (h *OffboardingHandler) Get(w http.ResponseWriter, r *http.Request) {
+	o, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, o, http.StatusOK)
+}
+
+func (h *OffboardingHandler) GetByEmployee(w http.ResponseWriter, r *http.Request) {
+	o, err := h.GetByEmployeeUC.Execute(r.Context(), chi.URLParam(r, "employeeId"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, o, http.StatusOK)
+}
+
+// List lists the tenant's offboardings, optionally filtered by ?status=.
+func (h *OffboardingHandler) List(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	status := domain.Status(r.URL.Query().Get("status"))
+	switch status {
+	case "", domain.StatusInProgress, domain.StatusCompleted:
+	default:
+		http.Error(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	offboardings, err := h.ListUC.Execute(r.Context(), tenantID, status)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, offboardings, http.StatusOK)
+}
+
+func (h *OffboardingHandler) CompleteTask(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// The body is optional.
+	var body offboardinghandlerdto.CompleteTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	o, err := h.CompleteTaskUC.Execute(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "taskId"), userID, body.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, o, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, offboardingrepository.ErrOffboardingNotFound),
+		errors.Is(err, domain.ErrTaskNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, offboardingrepository.ErrOffboardingExists),
+		errors.Is(err, domain.ErrAlreadyResigned),
+		errors.Is(err, domain.ErrTaskAlreadyDone),
+		errors.Is(err, domain.ErrOffboardingComplete):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrInvalidReason),
+		errors.Is(err, domain.ErrLastWorkingDay),
+		errors.Is(err, domain.ErrBeforeJoinDate),
+		errors.Is(err, domain.ErrLaterJobChange),
+		errors.Is(err, domain.ErrInitiatorRequired):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,11 @@
+package offboardinghandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *OffboardingHandler) Routes(r chi.Router) {
+	r.Get("/", h.List)
+	r.Get("/employee/{employeeId}", h.GetByEmployee)
+	r.Post("/employee/{employeeId}", h.Start)
+	r.Get("/{id}", h.Get)
+	r.Post("/{id}/tasks/{taskId}/complete", h.CompleteTask)
+}
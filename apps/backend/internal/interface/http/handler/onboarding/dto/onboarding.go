@@ -0,0 +1,18 @@
+package onboardinghandlerdto
+
+type TemplateTaskRequest struct {
+	Title         string  `json:"title" validate:"required,max=255"`
+	Description   *string `json:"description" validate:"omitempty,max=2000"`
+	Assignee      string  `json:"assignee" validate:"required,oneof=HR IT MANAGER NEW_HIRE"`
+	DueOffsetDays int     `json:"due_offset_days" validate:"min=-365,max=365"`
+}
+
+type TemplateRequest struct {
+	Name         string                `json:"name" validate:"required,max=255"`
+	DepartmentID *string               `json:"department_id" validate:"omitempty,uuid"`
+	Tasks        []TemplateTaskRequest `json:"tasks" validate:"required,min=1,max=100,dive"`
+}
+
+type CompleteTaskRequest struct {
+	Note *string `json:"note" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,272 @@
+package onboardinghandler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	onboardinghandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/onboarding/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
+	onboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type OnboardingHandler struct {
+	CreateTemplateUC *onboardingusecase.CreateTemplateUsecase
+	UpdateTemplateUC *onboardingusecase.UpdateTemplateUsecase
+	DeleteTemplateUC *onboardingusecase.DeleteTemplateUsecase
+	ListTemplatesUC  *onboardingusecase.ListTemplatesUsecase
+	ListTasksUC      *onboardingusecase.ListTasksUsecase
+	ListMyTasksUC    *onboardingusecase.ListMyTasksUsecase
+	CompleteTaskUC   *onboardingusecase.CompleteTaskUsecase
+	GetEmployeeUC    *onboardingusecase.GetEmployeeOnboardingUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewOnboardingHandler(
+	createTemplateUC *onboardingusecase.CreateTemplateUsecase,
+	updateTemplateUC *onboardingusecase.UpdateTemplateUsecase,
+	deleteTemplateUC *onboardingusecase.DeleteTemplateUsecase,
+	listTemplatesUC *onboardingusecase.ListTemplatesUsecase,
+	listTasksUC *onboardingusecase.ListTasksUsecase,
+	listMyTasksUC *onboardingusecase.ListMyTasksUsecase,
+	completeTaskUC *onboardingusecase.CompleteTaskUsecase,
+	getEmployeeUC *onboardingusecase.GetEmployeeOnboardingUsecase,
+) *OnboardingHandler {
+	return &OnboardingHandler{
+		CreateTemplateUC: createTemplateUC,
+		UpdateTemplateUC: updateTemplateUC,
+		DeleteTemplateUC: deleteTemplateUC,
+		ListTemplatesUC:  listTemplatesUC,
+		ListTasksUC:      listTasksUC,
+		ListMyTasksUC:    listMyTasksUC,
+		CompleteTaskUC:   completeTaskUC,
+		GetEmployeeUC:    getEmployeeUC,
+	}
+}
+
+func (h *OnboardingHandler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	templates, err := h.ListTemplatesUC.Execute(r.Context(), tenantID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, templates, http.StatusOK)
+}
+
+func (h *OnboardingHandler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	in, ok := decodeTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	template, err := h.CreateTemplateUC.Execute(r.Context(), tenantID, in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, template, http.StatusCreated)
+}
+
+func (h *OnboardingHandler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	template, err := h.UpdateTemplateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, template, http.StatusOK)
+}
+
+func (h *OnboardingHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	if err := h.DeleteTemplateUC.Execute(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTasks lists the tenant's onboarding tasks, filtered by ?employeeId=,
+// ?assignee= and ?status=.
+func (h *OnboardingHandler) ListTasks(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	f := onboardingrepository.TaskFilter{
+		EmployeeID: q.Get("employeeId"),
+		Assignee:   domain.Assignee(q.Get("assignee")),
+		Status:     domain.TaskStatus(q.Get("status")),
+	}
+
+	switch f.Assignee {
+	case "", domain.AssigneeHR, domain.AssigneeIT, domain.AssigneeManager, domain.AssigneeNewHire:
+	default:
+		http.Error(w, "invalid assignee", http.StatusBadRequest)
+		return
+	}
+	switch f.Status {
+	case "", domain.TaskPending, domain.TaskDone:
+	default:
+		http.Error(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	tasks, err := h.ListTasksUC.Execute(r.Context(), tenantID, f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, tasks, http.StatusOK)
+}
+
+// ListMyTasks lists the pending tasks assigned to the caller as a new hire
+// or as a manager.
+func (h *OnboardingHandler) ListMyTasks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	tasks, err := h.ListMyTasksUC.Execute(r.Context(), tenantID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, tasks, http.StatusOK)
+}
+
+func (h *OnboardingHandler) CompleteTask(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// The body is optional.
+	var body onboardinghandlerdto.CompleteTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	task, err := h.CompleteTaskUC.Execute(r.Context(), chi.URLParam(r, "id"), userID, body.Note)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, task, http.StatusOK)
+}
+
+// GetEmployeeOnboarding returns a new hire's tasks and progress.
+func (h *OnboardingHandler) GetEmployeeOnboarding(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	onboarding, err := h.GetEmployeeUC.Execute(r.Context(), tenantID, chi.URLParam(r, "employeeId"), time.Now().UTC())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, onboarding, http.StatusOK)
+}
+
+func decodeTemplate(w http.ResponseWriter, r *http.Request) (domain.TemplateInput, bool) {
+	var body onboardinghandlerdto.TemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.TemplateInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.TemplateInput{}, false
+	}
+
+	tasks := make([]domain.TemplateTask, 0, len(body.Tasks))
+	for _, t := range body.Tasks {
+		tasks = append(tasks, domain.TemplateTask{
+			Title:         t.Title,
+			Description:   t.Description,
+			Assignee:      domain.Assignee(t.Assignee),
+			DueOffsetDays: t.DueOffsetDays,
+		})
+	}
+
+	return domain.TemplateInput{
+		Name:         body.Name,
+		DepartmentID: body.DepartmentID,
+		Tasks:        tasks,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, onboardingrepository.ErrTemplateNotFound),
+		errors.Is(err, onboardingrepository.ErrTaskNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNotAssignee):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, onboardingrepository.ErrTemplateExists),
+		errors.Is(err, domain.ErrTaskAlreadyDone):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrNameRequired),
+		errors.Is(err, domain.ErrTasksRequired),
+		errors.Is(err, domain.ErrTooManyTasks),
+		errors.Is(err, domain.ErrTaskTitleRequired),
+		errors.Is(err, domain.ErrInvalidAssignee),
+		errors.Is(err, domain.ErrDueOffset):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
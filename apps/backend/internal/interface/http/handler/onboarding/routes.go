@@ -0,0 +1,15 @@
+package onboardinghandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *OnboardingHandler) Routes(r chi.Router) {
+	r.Get("/templates", h.ListTemplates)
+	r.Post("/templates", h.CreateTemplate)
+	r.Put("/templates/{id}", h.UpdateTemplate)
+	r.Delete("/templates/{id}", h.DeleteTemplate)
+
+	r.Get("/tasks", h.ListTasks)
+	r.Get("/tasks/mine", h.ListMyTasks)
+	r.Post("/tasks/{id}/complete", h.CompleteTask)
+	r.Get("/employees/{employeeId}", h.GetEmployeeOnboarding)
+}
@@ -0,0 +1,75 @@
+package orgcharthandler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/org_chart/domain"
+	orgchartusecase "github.com/smart-hmm/smart-hmm/internal/modules/org_chart/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type OrgChartHandler struct {
+	GetUC *orgchartusecase.GetOrgChartUsecase
+}
+
+func NewOrgChartHandler(getUC *orgchartusecase.GetOrgChartUsecase) *OrgChartHandler {
+	return &OrgChartHandler{GetUC: getUC}
+}
+
+// Get returns the tenant's management hierarchy. ?rootId= loads the
+// subtree of one employee, ?depth= how many levels below the roots are
+// included and ?groupBy=department one chart per department.
+func (h *OrgChartHandler) Get(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	tenantID := query.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	q := orgchartusecase.OrgChartQuery{RootID: query.Get("rootId")}
+
+	if raw := query.Get("depth"); raw != "" {
+		depth, err := strconv.Atoi(raw)
+		if err != nil {
+			http.Error(w, "invalid depth", http.StatusBadRequest)
+			return
+		}
+		q.Depth = depth
+	}
+
+	switch query.Get("groupBy") {
+	case "":
+	case "department":
+		if q.RootID != "" {
+			http.Error(w, "rootId cannot be combined with groupBy", http.StatusBadRequest)
+			return
+		}
+		q.ByDepartment = true
+	default:
+		http.Error(w, "invalid groupBy", http.StatusBadRequest)
+		return
+	}
+
+	chart, err := h.GetUC.Execute(r.Context(), tenantID, q)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, chart, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEmployeeNotInChart):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrDepth):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,7 @@
+package orgcharthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *OrgChartHandler) Routes(r chi.Router) {
+	r.Get("/", h.Get)
+}
@@ -0,0 +1,13 @@
+package payrollhandlerdto
+
+type CreatePayrollRunRequest struct {
+	TenantID string `json:"tenant_id" validate:"required"`
+	Period   string `json:"period" validate:"required"`
+
+	// Type defaults to REGULAR. The remaining fields apply to off-cycle
+	// runs only.
+	Type             string   `json:"type" validate:"omitempty,oneof=REGULAR BONUS THIRTEENTH_MONTH TERMINATION"`
+	SalaryMultiplier *float64 `json:"salary_multiplier" validate:"omitempty,gte=0,lt=1000"`
+	ProrateYear      *int     `json:"prorate_year"`
+	EmployeeIDs      []string `json:"employee_ids" validate:"omitempty,dive,uuid"`
+}
@@ -0,0 +1,11 @@
+package payrollhandlerdto
+
+import "time"
+
+type ExportBankFileRequest struct {
+	Format        string     `json:"format" validate:"required"`
+	Currency      string     `json:"currency" validate:"omitempty,len=3"`
+	DebtorAccount string     `json:"debtor_account" validate:"omitempty,max=34"`
+	DebtorBankBIC string     `json:"debtor_bank_bic" validate:"omitempty,max=11"`
+	ExecutionDate *time.Time `json:"execution_date"`
+}
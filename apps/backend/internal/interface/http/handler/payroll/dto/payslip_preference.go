@@ -0,0 +1,5 @@
+package payrollhandlerdto
+
+type UpdatePayslipPreferenceRequest struct {
+	PasswordProtected *bool `json:"password_protected" validate:"required"`
+}
@@ -0,0 +1,6 @@
+package payrollhandlerdto
+
+type ComputeRetroAdjustmentsRequest struct {
+	EmployeeIDs []string `json:"employee_ids" validate:"omitempty,dive,required"`
+	Reason      string   `json:"reason" validate:"required,max=500"`
+}
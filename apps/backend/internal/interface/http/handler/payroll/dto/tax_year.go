@@ -0,0 +1,12 @@
+package payrollhandlerdto
+
+type ExportTaxDeclarationRequest struct {
+	TenantID       string `json:"tenant_id" validate:"required,uuid"`
+	Format         string `json:"format" validate:"required"`
+	CompanyTaxCode string `json:"company_tax_code" validate:"required,max=20"`
+}
+
+type GenerateTaxCertificatesRequest struct {
+	TenantID       string `json:"tenant_id" validate:"required,uuid"`
+	CompanyTaxCode string `json:"company_tax_code" validate:"required,max=20"`
+}
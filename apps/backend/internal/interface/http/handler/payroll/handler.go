@@ -2,32 +2,157 @@ package payrollhandler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	payrollhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/payroll/dto"
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
 	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
 	payrollusecase "github.com/smart-hmm/smart-hmm/internal/modules/payroll/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
 	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
 )
 
 type PayrollHandler struct {
-	GenerateUC *payrollusecase.GeneratePayrollUsecase
-	Repo       payrollrepository.PayrollRepository
+	CreateRunUC      *payrollusecase.CreatePayrollRunUsecase
+	RecalculateRunUC *payrollusecase.RecalculatePayrollRunUsecase
+	ApproveRunUC     *payrollusecase.ApprovePayrollRunUsecase
+	MarkRunPaidUC    *payrollusecase.MarkPayrollRunPaidUsecase
+	RegenPayslipsUC  *payrollusecase.RegeneratePayslipsUsecase
+	PayslipURLUC     *payrollusecase.GetPayslipDownloadURLUsecase
+	MyPayslipsUC     *payrollusecase.ListMyPayslipsUsecase
+	PayslipPrefUC    *payrollusecase.UpdatePayslipPreferenceUsecase
+	ExportBankUC     *payrollusecase.ExportBankFileUsecase
+	RetroUC          *payrollusecase.ComputeRetroAdjustmentsUsecase
+	TaxYearUC        *payrollusecase.GetTaxYearUsecase
+	TaxDeclarationUC *payrollusecase.ExportTaxDeclarationUsecase
+	TaxCertsUC       *payrollusecase.RequestTaxCertificatesUsecase
+	ListTaxCertsUC   *payrollusecase.ListTaxCertificatesUsecase
+	TaxCertURLUC     *payrollusecase.GetTaxCertificateDownloadURLUsecase
+	GetRecordUC      *payrollusecase.GetPayrollRecordUsecase
+	EmployeeRecsUC   *payrollusecase.ListEmployeePayrollRecordsUsecase
+	PeriodRecsUC     *payrollusecase.ListPeriodPayrollRecordsUsecase
+	RunRecsUC        *payrollusecase.ListRunRecordsUsecase
+	RunRepo          payrollrepository.PayrollRunRepository
+	AdjustmentRepo   payrollrepository.AdjustmentRepository
 }
 
 var validate = validator.New(validator.WithRequiredStructEnabled())
 
-func NewPayrollHandler(generateUC *payrollusecase.GeneratePayrollUsecase, repo payrollrepository.PayrollRepository) *PayrollHandler {
+func NewPayrollHandler(
+	createRunUC *payrollusecase.CreatePayrollRunUsecase,
+	recalculateRunUC *payrollusecase.RecalculatePayrollRunUsecase,
+	approveRunUC *payrollusecase.ApprovePayrollRunUsecase,
+	markRunPaidUC *payrollusecase.MarkPayrollRunPaidUsecase,
+	regenPayslipsUC *payrollusecase.RegeneratePayslipsUsecase,
+	payslipURLUC *payrollusecase.GetPayslipDownloadURLUsecase,
+	myPayslipsUC *payrollusecase.ListMyPayslipsUsecase,
+	payslipPrefUC *payrollusecase.UpdatePayslipPreferenceUsecase,
+	exportBankUC *payrollusecase.ExportBankFileUsecase,
+	retroUC *payrollusecase.ComputeRetroAdjustmentsUsecase,
+	taxYearUC *payrollusecase.GetTaxYearUsecase,
+	taxDeclarationUC *payrollusecase.ExportTaxDeclarationUsecase,
+	taxCertsUC *payrollusecase.RequestTaxCertificatesUsecase,
+	listTaxCertsUC *payrollusecase.ListTaxCertificatesUsecase,
+	taxCertURLUC *payrollusecase.GetTaxCertificateDownloadURLUsecase,
+	getRecordUC *payrollusecase.GetPayrollRecordUsecase,
+	employeeRecsUC *payrollusecase.ListEmployeePayrollRecordsUsecase,
+	periodRecsUC *payrollusecase.ListPeriodPayrollRecordsUsecase,
+	runRecsUC *payrollusecase.ListRunRecordsUsecase,
+	runRepo payrollrepository.PayrollRunRepository,
+	adjustmentRepo payrollrepository.AdjustmentRepository,
+) *PayrollHandler {
 	return &PayrollHandler{
-		GenerateUC: generateUC,
-		Repo:       repo,
+		CreateRunUC:      createRunUC,
+		RecalculateRunUC: recalculateRunUC,
+		ApproveRunUC:     approveRunUC,
+		MarkRunPaidUC:    markRunPaidUC,
+		RegenPayslipsUC:  regenPayslipsUC,
+		PayslipURLUC:     payslipURLUC,
+		MyPayslipsUC:     myPayslipsUC,
+		PayslipPrefUC:    payslipPrefUC,
+		ExportBankUC:     exportBankUC,
+		RetroUC:          retroUC,
+		TaxYearUC:        taxYearUC,
+		TaxDeclarationUC: taxDeclarationUC,
+		TaxCertsUC:       taxCertsUC,
+		ListTaxCertsUC:   listTaxCertsUC,
+		TaxCertURLUC:     taxCertURLUC,
+		GetRecordUC:      getRecordUC,
+		EmployeeRecsUC:   employeeRecsUC,
+		PeriodRecsUC:     periodRecsUC,
+		RunRecsUC:        runRecsUC,
+		RunRepo:          runRepo,
+		AdjustmentRepo:   adjustmentRepo,
 	}
 }
 
-func (h *PayrollHandler) Generate(w http.ResponseWriter, r *http.Request) {
-	var body payrollhandlerdto.GeneratePayrollRequest
+func (h *PayrollHandler) Get(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	record, err := h.GetRecordUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+	if record == nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	httpx.WriteJSON(w, record, http.StatusOK)
+}
+
+func (h *PayrollHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	records, err := h.EmployeeRecsUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, records, http.StatusOK)
+}
+
+func (h *PayrollHandler) ListByPeriod(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	records, err := h.PeriodRecsUC.Execute(r.Context(), tenantID, chi.URLParam(r, "period"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, records, http.StatusOK)
+}
+
+func (h *PayrollHandler) CreateRun(w http.ResponseWriter, r *http.Request) {
+	var body payrollhandlerdto.CreatePayrollRunRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		http.Error(w, "invalid json", http.StatusBadRequest)
 		return
@@ -38,58 +163,486 @@ func (h *PayrollHandler) Generate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	record, err := h.GenerateUC.Execute(
-		r.Context(),
-		body.EmployeeID,
-		body.Period,
-		body.BaseSalary,
-		body.Allowances,
-		body.Deductions,
-	)
+	in := payrolldomain.NewPayrollRunInput{
+		TenantID:         body.TenantID,
+		Type:             payrolldomain.RunRegular,
+		Period:           body.Period,
+		SalaryMultiplier: 1,
+		ProrateYear:      body.ProrateYear,
+		EmployeeIDs:      body.EmployeeIDs,
+	}
+	if body.Type != "" {
+		in.Type = payrolldomain.RunType(body.Type)
+	}
+	if body.SalaryMultiplier != nil {
+		in.SalaryMultiplier = *body.SalaryMultiplier
+	}
+
+	run, err := h.CreateRunUC.Execute(r.Context(), in)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		writeRunError(w, err)
 		return
 	}
 
-	httpx.WriteJSON(w, record, http.StatusCreated)
+	httpx.WriteJSON(w, run, http.StatusAccepted)
 }
 
-func (h *PayrollHandler) Get(w http.ResponseWriter, r *http.Request) {
-	id := chi.URLParam(r, "id")
+func (h *PayrollHandler) ListRuns(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
 
-	record, err := h.Repo.FindByID(id)
+	runs, err := h.RunRepo.ListByTenant(r.Context(), tenantID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	if record == nil {
-		http.Error(w, "not found", http.StatusNotFound)
+
+	httpx.WriteJSON(w, runs, http.StatusOK)
+}
+
+func (h *PayrollHandler) GetRun(w http.ResponseWriter, r *http.Request) {
+	run, err := h.RunRepo.GetByID(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeRunError(w, err)
 		return
 	}
 
-	httpx.WriteJSON(w, record, http.StatusOK)
+	httpx.WriteJSON(w, run, http.StatusOK)
 }
 
-func (h *PayrollHandler) ListByEmployee(w http.ResponseWriter, r *http.Request) {
-	employeeID := chi.URLParam(r, "employeeId")
+func (h *PayrollHandler) ListRunRecords(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
 
-	records, err := h.Repo.ListByEmployee(employeeID)
+	records, err := h.RunRecsUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeRunError(w, err)
 		return
 	}
 
 	httpx.WriteJSON(w, records, http.StatusOK)
 }
 
-func (h *PayrollHandler) ListByPeriod(w http.ResponseWriter, r *http.Request) {
-	period := chi.URLParam(r, "period")
+func (h *PayrollHandler) RecalculateRun(w http.ResponseWriter, r *http.Request) {
+	run, err := h.RecalculateRunUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, run, http.StatusAccepted)
+}
+
+func (h *PayrollHandler) ApproveRun(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	run, err := h.ApproveRunUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, run, http.StatusOK)
+}
+
+func (h *PayrollHandler) MarkRunPaid(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	run, err := h.MarkRunPaidUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
 
-	records, err := h.Repo.ListByPeriod(period)
+	httpx.WriteJSON(w, run, http.StatusOK)
+}
+
+func (h *PayrollHandler) RegeneratePayslips(w http.ResponseWriter, r *http.Request) {
+	run, err := h.RegenPayslipsUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, run, http.StatusAccepted)
+}
+
+func (h *PayrollHandler) DownloadPayslip(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	download, err := h.PayslipURLUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, download, http.StatusOK)
+}
+
+func (h *PayrollHandler) ListMyPayslips(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	payslips, err := h.MyPayslipsUC.Execute(r.Context(), userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	httpx.WriteJSON(w, records, http.StatusOK)
+	httpx.WriteJSON(w, payslips, http.StatusOK)
+}
+
+func (h *PayrollHandler) UpdatePayslipPreference(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body payrollhandlerdto.UpdatePayslipPreferenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pref, err := h.PayslipPrefUC.Execute(r.Context(), chi.URLParam(r, "employeeId"), *body.PasswordProtected, userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, pref, http.StatusOK)
+}
+
+func (h *PayrollHandler) ExportBankFile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body payrollhandlerdto.ExportBankFileRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var currency money.Currency
+	if body.Currency != "" {
+		parsed, err := money.ParseCurrency(body.Currency)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		currency = parsed
+	}
+
+	file, err := h.ExportBankUC.Execute(r.Context(), payrollusecase.ExportBankFileInput{
+		RunID:         chi.URLParam(r, "id"),
+		Format:        body.Format,
+		Currency:      currency,
+		DebtorAccount: strings.ReplaceAll(strings.ToUpper(body.DebtorAccount), " ", ""),
+		DebtorBankBIC: strings.ToUpper(body.DebtorBankBIC),
+		ExecutionDate: body.ExecutionDate,
+		UserID:        userID,
+	})
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.Export.FileName))
+	w.Header().Set("X-Checksum-SHA256", file.Export.Checksum)
+	w.WriteHeader(http.StatusOK)
+	w.Write(file.Data)
+}
+
+func (h *PayrollHandler) ListBankExports(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	exports, err := h.ExportBankUC.ListExports(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, exports, http.StatusOK)
+}
+
+func (h *PayrollHandler) ListBankFormats(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(w, h.ExportBankUC.Formats(), http.StatusOK)
+}
+
+func (h *PayrollHandler) ComputeRetroAdjustments(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body payrollhandlerdto.ComputeRetroAdjustmentsRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	adjustments, err := h.RetroUC.Execute(r.Context(), payrollusecase.ComputeRetroAdjustmentsInput{
+		RunID:       chi.URLParam(r, "id"),
+		EmployeeIDs: body.EmployeeIDs,
+		Reason:      body.Reason,
+		UserID:      userID,
+	})
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, adjustments, http.StatusCreated)
+}
+
+// ListRunAdjustments lists the adjustments correcting the run's records.
+func (h *PayrollHandler) ListRunAdjustments(w http.ResponseWriter, r *http.Request) {
+	adjustments, err := h.AdjustmentRepo.ListBySourceRunID(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, adjustments, http.StatusOK)
+}
+
+// ListPaidAdjustments lists the adjustments the run pays.
+func (h *PayrollHandler) ListPaidAdjustments(w http.ResponseWriter, r *http.Request) {
+	adjustments, err := h.AdjustmentRepo.ListByTargetRunID(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, adjustments, http.StatusOK)
+}
+
+func (h *PayrollHandler) GetTaxYear(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		http.Error(w, "invalid year", http.StatusBadRequest)
+		return
+	}
+
+	taxYear, err := h.TaxYearUC.Execute(r.Context(), tenantID, year, userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, taxYear, http.StatusOK)
+}
+
+func (h *PayrollHandler) ExportTaxDeclaration(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		http.Error(w, "invalid year", http.StatusBadRequest)
+		return
+	}
+
+	var body payrollhandlerdto.ExportTaxDeclarationRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	file, err := h.TaxDeclarationUC.Execute(r.Context(), payrollusecase.ExportTaxDeclarationInput{
+		TenantID:       body.TenantID,
+		Year:           year,
+		Format:         body.Format,
+		CompanyTaxCode: strings.TrimSpace(body.CompanyTaxCode),
+		UserID:         userID,
+	})
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	w.WriteHeader(http.StatusOK)
+	w.Write(file.Data)
+}
+
+func (h *PayrollHandler) ListTaxFormats(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJSON(w, h.TaxDeclarationUC.Formats(), http.StatusOK)
+}
+
+// GenerateTaxCertificates queues the withholding certificates of the year.
+func (h *PayrollHandler) GenerateTaxCertificates(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		http.Error(w, "invalid year", http.StatusBadRequest)
+		return
+	}
+
+	var body payrollhandlerdto.GenerateTaxCertificatesRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	taxYear, err := h.TaxCertsUC.Execute(r.Context(), body.TenantID, year, strings.TrimSpace(body.CompanyTaxCode), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, taxYear, http.StatusAccepted)
+}
+
+func (h *PayrollHandler) ListTaxCertificates(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		http.Error(w, "invalid year", http.StatusBadRequest)
+		return
+	}
+
+	certificates, err := h.ListTaxCertsUC.Execute(r.Context(), tenantID, year, userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, certificates, http.StatusOK)
+}
+
+func (h *PayrollHandler) DownloadTaxCertificate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	download, err := h.TaxCertURLUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeRunError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, download, http.StatusOK)
+}
+
+func writeRunError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, payrollrepository.ErrPayrollRunNotFound),
+		errors.Is(err, payrollrepository.ErrPayslipNotFound),
+		errors.Is(err, payrollrepository.ErrTaxCertificateNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, payrolldomain.ErrPayslipForbidden),
+		errors.Is(err, payrolldomain.ErrPayrollForbidden),
+		errors.Is(err, payrolldomain.ErrRunForbidden),
+		errors.Is(err, payrolldomain.ErrTaxCertificateForbidden):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, payrollrepository.ErrPayrollRunAlreadyExists),
+		errors.Is(err, payrolldomain.ErrPayrollRunLocked),
+		errors.Is(err, payrolldomain.ErrInvalidRunTransition),
+		errors.Is(err, payrolldomain.ErrPayslipRunNotApproved),
+		errors.Is(err, payrolldomain.ErrBankExportRunNotApproved),
+		errors.Is(err, payrolldomain.ErrRetroRunNotLocked):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, payrolldomain.ErrMissingBankAccounts),
+		errors.Is(err, payrolldomain.ErrBankCurrency),
+		errors.Is(err, payrolldomain.ErrMixedRunCurrencies),
+		errors.Is(err, payrolldomain.ErrNoTransfers),
+		errors.Is(err, payrolldomain.ErrAdjustmentEmployees),
+		errors.Is(err, payrolldomain.ErrNoTaxYearRecords),
+		errors.Is(err, payrolldomain.ErrMixedTaxYearCurrencies),
+		errors.Is(err, payrolldomain.ErrTaxYearUnsupported):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
 }
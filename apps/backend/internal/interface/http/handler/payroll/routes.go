@@ -3,7 +3,33 @@ package payrollhandler
 import "github.com/go-chi/chi/v5"
 
 func (h *PayrollHandler) Routes(r chi.Router) {
-	r.Post("/", h.Generate)
+	r.Route("/runs", func(rr chi.Router) {
+		rr.Post("/", h.CreateRun)
+		rr.Get("/", h.ListRuns)
+		rr.Get("/{id}", h.GetRun)
+		rr.Get("/{id}/records", h.ListRunRecords)
+		rr.Post("/{id}/recalculate", h.RecalculateRun)
+		rr.Post("/{id}/approve", h.ApproveRun)
+		rr.Post("/{id}/pay", h.MarkRunPaid)
+		rr.Post("/{id}/payslips", h.RegeneratePayslips)
+		rr.Post("/{id}/bank-file", h.ExportBankFile)
+		rr.Get("/{id}/bank-exports", h.ListBankExports)
+		rr.Post("/{id}/adjustments", h.ComputeRetroAdjustments)
+		rr.Get("/{id}/adjustments", h.ListRunAdjustments)
+		rr.Get("/{id}/paid-adjustments", h.ListPaidAdjustments)
+	})
+	r.Route("/tax-years/{year}", func(rr chi.Router) {
+		rr.Get("/", h.GetTaxYear)
+		rr.Post("/declaration", h.ExportTaxDeclaration)
+		rr.Post("/certificates", h.GenerateTaxCertificates)
+		rr.Get("/certificates", h.ListTaxCertificates)
+	})
+	r.Get("/bank-formats", h.ListBankFormats)
+	r.Get("/tax-formats", h.ListTaxFormats)
+	r.Get("/tax-certificates/{id}/download", h.DownloadTaxCertificate)
+	r.Get("/me/payslips", h.ListMyPayslips)
+	r.Put("/payslip-preferences/{employeeId}", h.UpdatePayslipPreference)
+	r.Get("/{id}/payslip", h.DownloadPayslip)
 	r.Get("/employee/{employeeId}", h.ListByEmployee)
 	r.Get("/period/{period}", h.ListByPeriod)
 	r.Get("/{id}", h.Get)
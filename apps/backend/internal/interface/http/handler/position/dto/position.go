@@ -0,0 +1,17 @@
+package positionhandlerdto
+
+import "encoding/json"
+
+type PositionRequest struct {
+	DepartmentID string      `json:"department_id" validate:"required,uuid"`
+	Title        string      `json:"title" validate:"required,max=200"`
+	Grade        *string     `json:"grade" validate:"omitempty,max=20"`
+	SalaryMin    json.Number `json:"salary_min" validate:"required"`
+	SalaryMax    json.Number `json:"salary_max" validate:"required"`
+	Currency     string      `json:"currency" validate:"omitempty,len=3"`
+	FTE          float64     `json:"fte" validate:"required,gt=0,lte=1"`
+}
+
+type FillPositionRequest struct {
+	EmployeeID string `json:"employee_id" validate:"required,uuid"`
+}
@@ -0,0 +1,268 @@
+package positionhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	positionhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/position/dto"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+	positionusecase "github.com/smart-hmm/smart-hmm/internal/modules/position/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type PositionHandler struct {
+	CreateUC *positionusecase.CreatePositionUsecase
+	UpdateUC *positionusecase.UpdatePositionUsecase
+	GetUC    *positionusecase.GetPositionUsecase
+	ListUC   *positionusecase.ListPositionsUsecase
+	FillUC   *positionusecase.FillPositionUsecase
+	VacateUC *positionusecase.VacatePositionUsecase
+	FreezeUC *positionusecase.FreezePositionUsecase
+	ReportUC *positionusecase.GetHeadcountReportUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewPositionHandler(
+	createUC *positionusecase.CreatePositionUsecase,
+	updateUC *positionusecase.UpdatePositionUsecase,
+	getUC *positionusecase.GetPositionUsecase,
+	listUC *positionusecase.ListPositionsUsecase,
+	fillUC *positionusecase.FillPositionUsecase,
+	vacateUC *positionusecase.VacatePositionUsecase,
+	freezeUC *positionusecase.FreezePositionUsecase,
+	reportUC *positionusecase.GetHeadcountReportUsecase,
+) *PositionHandler {
+	return &PositionHandler{
+		CreateUC: createUC,
+		UpdateUC: updateUC,
+		GetUC:    getUC,
+		ListUC:   listUC,
+		FillUC:   fillUC,
+		VacateUC: vacateUC,
+		FreezeUC: freezeUC,
+		ReportUC: reportUC,
+	}
+}
+
+func (h *PositionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	p, err := h.CreateUC.Execute(r.Context(), tenantID, in, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusCreated)
+}
+
+func (h *PositionHandler) Update(w http.ResponseWriter, r *http.Request) {
+	in, ok := decodeInput(w, r)
+	if !ok {
+		return
+	}
+
+	p, err := h.UpdateUC.Execute(r.Context(), chi.URLParam(r, "id"), in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusOK)
+}
+
+func (h *PositionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	p, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusOK)
+}
+
+// List lists the tenant's positions, optionally filtered by
+// ?departmentId=, ?employeeId= and ?status=.
+func (h *PositionHandler) List(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	status := domain.Status(q.Get("status"))
+	switch status {
+	case "", domain.StatusOpen, domain.StatusFilled, domain.StatusFrozen:
+	default:
+		http.Error(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	positions, err := h.ListUC.Execute(r.Context(), tenantID, positionrepository.PositionFilter{
+		DepartmentID: q.Get("departmentId"),
+		EmployeeID:   q.Get("employeeId"),
+		Status:       status,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, positions, http.StatusOK)
+}
+
+func (h *PositionHandler) Fill(w http.ResponseWriter, r *http.Request) {
+	var body positionhandlerdto.FillPositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	p, err := h.FillUC.Execute(r.Context(), chi.URLParam(r, "id"), body.EmployeeID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusOK)
+}
+
+func (h *PositionHandler) Vacate(w http.ResponseWriter, r *http.Request) {
+	p, err := h.VacateUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusOK)
+}
+
+func (h *PositionHandler) Freeze(w http.ResponseWriter, r *http.Request) {
+	h.setFrozen(w, r, true)
+}
+
+func (h *PositionHandler) Unfreeze(w http.ResponseWriter, r *http.Request) {
+	h.setFrozen(w, r, false)
+}
+
+func (h *PositionHandler) setFrozen(w http.ResponseWriter, r *http.Request, frozen bool) {
+	p, err := h.FreezeUC.Execute(r.Context(), chi.URLParam(r, "id"), frozen)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, p, http.StatusOK)
+}
+
+// Report compares each department's budgeted positions with the
+// employees working in it today.
+func (h *PositionHandler) Report(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.ReportUC.Execute(r.Context(), tenantID, time.Now().UTC())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, report, http.StatusOK)
+}
+
+func decodeInput(w http.ResponseWriter, r *http.Request) (domain.PositionInput, bool) {
+	var body positionhandlerdto.PositionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return domain.PositionInput{}, false
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.PositionInput{}, false
+	}
+
+	currency := money.DefaultCurrency
+	if body.Currency != "" {
+		parsed, err := money.ParseCurrency(body.Currency)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return domain.PositionInput{}, false
+		}
+		currency = parsed
+	}
+
+	salaryMin, err := money.Parse(body.SalaryMin.String(), currency)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.PositionInput{}, false
+	}
+	salaryMax, err := money.Parse(body.SalaryMax.String(), currency)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return domain.PositionInput{}, false
+	}
+
+	return domain.PositionInput{
+		DepartmentID: body.DepartmentID,
+		Title:        body.Title,
+		Grade:        body.Grade,
+		SalaryMin:    salaryMin,
+		SalaryMax:    salaryMax,
+		FTE:          body.FTE,
+	}, true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, positionrepository.ErrPositionNotFound),
+		errors.Is(err, departmentrepository.ErrDepartmentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, positionrepository.ErrEmployeeHasPosition),
+		errors.Is(err, domain.ErrNotOpen),
+		errors.Is(err, domain.ErrNotFilled),
+		errors.Is(err, domain.ErrNotFrozen):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrTitleRequired),
+		errors.Is(err, domain.ErrDepartmentRequired),
+		errors.Is(err, domain.ErrFTE),
+		errors.Is(err, domain.ErrSalaryRange),
+		errors.Is(err, domain.ErrEmployeeNotEmployed):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,15 @@
+package positionhandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *PositionHandler) Routes(r chi.Router) {
+	r.Post("/", h.Create)
+	r.Get("/", h.List)
+	r.Get("/report", h.Report)
+	r.Get("/{id}", h.Get)
+	r.Put("/{id}", h.Update)
+	r.Post("/{id}/fill", h.Fill)
+	r.Post("/{id}/vacate", h.Vacate)
+	r.Post("/{id}/freeze", h.Freeze)
+	r.Post("/{id}/unfreeze", h.Unfreeze)
+}
@@ -0,0 +1,18 @@
+package probationhandlerdto
+
+import (
+	"time"
+
+	contracthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/contract/dto"
+)
+
+type DecideEvaluationRequest struct {
+	PerformanceRating int     `json:"performance_rating" validate:"required,min=1,max=5"`
+	ConductRating     int     `json:"conduct_rating" validate:"required,min=1,max=5"`
+	Comments          *string `json:"comments" validate:"omitempty,max=4000"`
+	Decision          string  `json:"decision" validate:"required,oneof=PASS EXTEND TERMINATE"`
+	// ExtendedUntil is the new last day of probation of an EXTEND.
+	ExtendedUntil *time.Time `json:"extended_until" validate:"required_if=Decision EXTEND"`
+	// NextContract follows a probation contract when the employee passes.
+	NextContract *contracthandlerdto.ContractRequest `json:"next_contract"`
+}
@@ -0,0 +1,209 @@
+package probationhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	probationhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/probation/dto"
+	contractdomain "github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	offboardingdomain "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	probationusecase "github.com/smart-hmm/smart-hmm/internal/modules/probation/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type ProbationHandler struct {
+	DashboardUC *probationusecase.GetDashboardUsecase
+	ListUC      *probationusecase.ListEvaluationsUsecase
+	ListMineUC  *probationusecase.ListMyEvaluationsUsecase
+	GetUC       *probationusecase.GetEvaluationUsecase
+	DecideUC    *probationusecase.DecideEvaluationUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewProbationHandler(
+	dashboardUC *probationusecase.GetDashboardUsecase,
+	listUC *probationusecase.ListEvaluationsUsecase,
+	listMineUC *probationusecase.ListMyEvaluationsUsecase,
+	getUC *probationusecase.GetEvaluationUsecase,
+	decideUC *probationusecase.DecideEvaluationUsecase,
+) *ProbationHandler {
+	return &ProbationHandler{
+		DashboardUC: dashboardUC,
+		ListUC:      listUC,
+		ListMineUC:  listMineUC,
+		GetUC:       getUC,
+		DecideUC:    decideUC,
+	}
+}
+
+// Dashboard shows the tenant's outstanding evaluations.
+func (h *ProbationHandler) Dashboard(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	d, err := h.DashboardUC.Execute(r.Context(), tenantID, time.Now().UTC())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, d, http.StatusOK)
+}
+
+// List lists the tenant's evaluations, optionally filtered by ?status=
+// and ?employeeId=.
+func (h *ProbationHandler) List(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	status := domain.Status(q.Get("status"))
+	switch status {
+	case "", domain.StatusPending, domain.StatusDecided:
+	default:
+		http.Error(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	evaluations, err := h.ListUC.Execute(r.Context(), tenantID, probationrepository.EvaluationFilter{
+		EmployeeID: q.Get("employeeId"),
+		Status:     status,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, evaluations, http.StatusOK)
+}
+
+// ListMine lists the evaluations the caller has to make as manager.
+func (h *ProbationHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	evaluations, err := h.ListMineUC.Execute(r.Context(), tenantID, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, evaluations, http.StatusOK)
+}
+
+func (h *ProbationHandler) Get(w http.ResponseWriter, r *http.Request) {
+	e, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, e, http.StatusOK)
+}
+
+func (h *ProbationHandler) Decide(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body probationhandlerdto.DecideEvaluationRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	in := probationusecase.DecideEvaluationInput{
+		DecisionInput: domain.DecisionInput{
+			PerformanceRating: body.PerformanceRating,
+			ConductRating:     body.ConductRating,
+			Comments:          body.Comments,
+			Decision:          domain.Decision(body.Decision),
+			ExtendedUntil:     body.ExtendedUntil,
+		},
+	}
+	if c := body.NextContract; c != nil {
+		in.NextContract = &contractdomain.ContractInput{
+			Type:             contractdomain.ContractType(c.Type),
+			Number:           c.Number,
+			StartDate:        c.StartDate,
+			EndDate:          c.EndDate,
+			ProbationEndDate: c.ProbationEndDate,
+			SignedFileID:     c.SignedFileID,
+			Note:             c.Note,
+		}
+	}
+
+	e, err := h.DecideUC.Execute(r.Context(), chi.URLParam(r, "id"), in, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, e, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, probationrepository.ErrEvaluationNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNotEvaluator):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrAlreadyDecided),
+		errors.Is(err, domain.ErrAlreadyExtended),
+		errors.Is(err, offboardingrepository.ErrOffboardingExists),
+		errors.Is(err, offboardingdomain.ErrAlreadyResigned),
+		errors.Is(err, contractrepository.ErrContractNumberExists),
+		errors.Is(err, contractrepository.ErrContractAlreadyRenewed):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrInvalidDecision),
+		errors.Is(err, domain.ErrRating),
+		errors.Is(err, domain.ErrExtendedUntil),
+		errors.Is(err, domain.ErrContractRequired),
+		errors.Is(err, domain.ErrContractForbidden),
+		errors.Is(err, offboardingdomain.ErrLaterJobChange),
+		errors.Is(err, contractdomain.ErrInvalidType),
+		errors.Is(err, contractdomain.ErrNumberRequired),
+		errors.Is(err, contractdomain.ErrStartDate),
+		errors.Is(err, contractdomain.ErrEndDateRequired),
+		errors.Is(err, contractdomain.ErrEndDateNotAllowed),
+		errors.Is(err, contractdomain.ErrEndBeforeStart),
+		errors.Is(err, contractdomain.ErrFixedTermTooLong),
+		errors.Is(err, contractdomain.ErrProbationPeriod),
+		errors.Is(err, contractdomain.ErrRenewalLimit):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
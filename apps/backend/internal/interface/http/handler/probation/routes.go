@@ -0,0 +1,11 @@
+package probationhandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *ProbationHandler) Routes(r chi.Router) {
+	r.Get("/dashboard", h.Dashboard)
+	r.Get("/evaluations", h.List)
+	r.Get("/evaluations/mine", h.ListMine)
+	r.Get("/evaluations/{id}", h.Get)
+	r.Post("/evaluations/{id}/decision", h.Decide)
+}
@@ -0,0 +1,27 @@
+package profilechangehandlerdto
+
+// SubmitChangesRequest lists the fields to change; omitted fields are
+// kept and an empty address clears it.
+type SubmitChangesRequest struct {
+	Phone       *string             `json:"phone" validate:"omitempty,max=20"`
+	Address     *string             `json:"address" validate:"omitempty,max=500"`
+	TaxCode     *string             `json:"tax_code" validate:"omitempty,max=20"`
+	BankAccount *BankAccountRequest `json:"bank_account"`
+	Reason      *string             `json:"reason" validate:"omitempty,max=1000"`
+}
+
+// BankAccountRequest proposes a new primary salary account.
+type BankAccountRequest struct {
+	BankName      string  `json:"bank_name" validate:"required,max=255"`
+	BankCode      string  `json:"bank_code" validate:"required,max=35"`
+	BranchCode    *string `json:"branch_code" validate:"omitempty,max=35"`
+	AccountHolder string  `json:"account_holder" validate:"required,max=255"`
+	AccountNumber string  `json:"account_number" validate:"required,max=64"`
+	Currency      string  `json:"currency" validate:"omitempty,len=3"`
+}
+
+type ReviewRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=APPROVE REJECT"`
+	// Note is required to reject.
+	Note *string `json:"note" validate:"required_if=Decision REJECT,omitempty,max=1000"`
+}
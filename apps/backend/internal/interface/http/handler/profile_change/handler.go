@@ -0,0 +1,237 @@
+package profilechangehandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	profilechangehandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/profile_change/dto"
+	bankdomain "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
+	profilechangeusecase "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/usecase"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type ProfileChangeHandler struct {
+	SubmitUC   *profilechangeusecase.SubmitChangesUsecase
+	ListUC     *profilechangeusecase.ListChangeRequestsUsecase
+	ListMineUC *profilechangeusecase.ListMyChangeRequestsUsecase
+	GetUC      *profilechangeusecase.GetChangeRequestUsecase
+	ReviewUC   *profilechangeusecase.ReviewChangeRequestUsecase
+	CancelUC   *profilechangeusecase.CancelChangeRequestUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewProfileChangeHandler(
+	submitUC *profilechangeusecase.SubmitChangesUsecase,
+	listUC *profilechangeusecase.ListChangeRequestsUsecase,
+	listMineUC *profilechangeusecase.ListMyChangeRequestsUsecase,
+	getUC *profilechangeusecase.GetChangeRequestUsecase,
+	reviewUC *profilechangeusecase.ReviewChangeRequestUsecase,
+	cancelUC *profilechangeusecase.CancelChangeRequestUsecase,
+) *ProfileChangeHandler {
+	return &ProfileChangeHandler{
+		SubmitUC:   submitUC,
+		ListUC:     listUC,
+		ListMineUC: listMineUC,
+		GetUC:      getUC,
+		ReviewUC:   reviewUC,
+		CancelUC:   cancelUC,
+	}
+}
+
+// Submit lets the caller change their own record.
+func (h *ProfileChangeHandler) Submit(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body profilechangehandlerdto.SubmitChangesRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	in := domain.ProposalInput{
+		Phone:   body.Phone,
+		Address: body.Address,
+		TaxCode: body.TaxCode,
+		Reason:  body.Reason,
+	}
+	if a := body.BankAccount; a != nil {
+		currency := money.DefaultCurrency
+		if a.Currency != "" {
+			parsed, err := money.ParseCurrency(a.Currency)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			currency = parsed
+		}
+		in.BankAccount = &bankdomain.BankAccountInput{
+			BankName:      a.BankName,
+			BankCode:      a.BankCode,
+			BranchCode:    a.BranchCode,
+			AccountHolder: a.AccountHolder,
+			AccountNumber: a.AccountNumber,
+			Currency:      currency,
+			IsPrimary:     true,
+		}
+	}
+
+	submitted, err := h.SubmitUC.Execute(r.Context(), userID, in)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	if submitted.Pending != nil {
+		status = http.StatusAccepted
+	}
+	httpx.WriteJSON(w, submitted, status)
+}
+
+// ListMine lists the caller's own requests.
+func (h *ProfileChangeHandler) ListMine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	requests, err := h.ListMineUC.Execute(r.Context(), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, requests, http.StatusOK)
+}
+
+// List lists the tenant's requests, optionally filtered by ?status= and
+// ?employeeId=.
+func (h *ProfileChangeHandler) List(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	tenantID := q.Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	status := domain.Status(q.Get("status"))
+	switch status {
+	case "", domain.StatusPending, domain.StatusApplied, domain.StatusRejected, domain.StatusCancelled:
+	default:
+		http.Error(w, "invalid status", http.StatusBadRequest)
+		return
+	}
+
+	requests, err := h.ListUC.Execute(r.Context(), tenantID, profilechangerepository.ChangeRequestFilter{
+		EmployeeID: q.Get("employeeId"),
+		Status:     status,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, requests, http.StatusOK)
+}
+
+func (h *ProfileChangeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	c, err := h.GetUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusOK)
+}
+
+func (h *ProfileChangeHandler) Review(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var body profilechangehandlerdto.ReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	c, err := h.ReviewUC.Execute(r.Context(), chi.URLParam(r, "id"), profilechangeusecase.ReviewInput{
+		Approve: body.Decision == "APPROVE",
+		Note:    body.Note,
+	}, userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusOK)
+}
+
+// Cancel withdraws one of the caller's pending requests.
+func (h *ProfileChangeHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	c, err := h.CancelUC.Execute(r.Context(), chi.URLParam(r, "id"), userID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, c, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, profilechangerepository.ErrChangeRequestNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, domain.ErrNotReviewer),
+		errors.Is(err, domain.ErrOwnRequest),
+		errors.Is(err, domain.ErrNotRequester),
+		errors.Is(err, domain.ErrNoEmployee):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	case errors.Is(err, domain.ErrNotPending),
+		errors.Is(err, profilechangerepository.ErrPendingRequestExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, domain.ErrNoChanges),
+		errors.Is(err, employeedomain.ErrPhone),
+		errors.Is(err, domain.ErrAddress),
+		errors.Is(err, statutorydomain.ErrTaxCode),
+		errors.Is(err, domain.ErrReviewNoteRequired),
+		errors.Is(err, bankdomain.ErrInvalidAccountNumber),
+		errors.Is(err, bankdomain.ErrBankRequired),
+		errors.Is(err, bankdomain.ErrAccountHolder):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,12 @@
+package profilechangehandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *ProfileChangeHandler) Routes(r chi.Router) {
+	r.Post("/me", h.Submit)
+	r.Get("/me", h.ListMine)
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Get)
+	r.Post("/{id}/review", h.Review)
+	r.Post("/{id}/cancel", h.Cancel)
+}
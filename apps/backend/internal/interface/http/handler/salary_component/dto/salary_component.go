@@ -0,0 +1,27 @@
+package salarycomponenthandlerdto
+
+import (
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+)
+
+type CreateSalaryComponentRequest struct {
+	TenantID  string                  `json:"tenantId" validate:"required"`
+	Code      string                  `json:"code" validate:"required"`
+	Name      string                  `json:"name" validate:"required"`
+	Kind      domain.ComponentKind    `json:"kind" validate:"required,oneof=EARNING DEDUCTION"`
+	Taxable   bool                    `json:"taxable"`
+	Formula   string                  `json:"formula" validate:"required"`
+	RunTypes  []payrolldomain.RunType `json:"runTypes" validate:"omitempty,dive,oneof=REGULAR BONUS THIRTEENTH_MONTH TERMINATION"`
+	SortOrder int                     `json:"sortOrder"`
+}
+
+type UpdateSalaryComponentRequest struct {
+	Name      string                  `json:"name" validate:"required"`
+	Kind      domain.ComponentKind    `json:"kind" validate:"required,oneof=EARNING DEDUCTION"`
+	Taxable   bool                    `json:"taxable"`
+	Formula   string                  `json:"formula" validate:"required"`
+	RunTypes  []payrolldomain.RunType `json:"runTypes" validate:"omitempty,dive,oneof=REGULAR BONUS THIRTEENTH_MONTH TERMINATION"`
+	SortOrder int                     `json:"sortOrder"`
+	IsActive  bool                    `json:"isActive"`
+}
@@ -0,0 +1,155 @@
+package salarycomponenthandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	salarycomponenthandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/salary_component/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+	salarycomponentusecase "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type SalaryComponentHandler struct {
+	CreateUC *salarycomponentusecase.CreateSalaryComponentUsecase
+	UpdateUC *salarycomponentusecase.UpdateSalaryComponentUsecase
+	DeleteUC *salarycomponentusecase.DeleteSalaryComponentUsecase
+	GetUC    *salarycomponentusecase.GetSalaryComponentUsecase
+	ListUC   *salarycomponentusecase.ListSalaryComponentsUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewSalaryComponentHandler(
+	createUC *salarycomponentusecase.CreateSalaryComponentUsecase,
+	updateUC *salarycomponentusecase.UpdateSalaryComponentUsecase,
+	deleteUC *salarycomponentusecase.DeleteSalaryComponentUsecase,
+	getUC *salarycomponentusecase.GetSalaryComponentUsecase,
+	listUC *salarycomponentusecase.ListSalaryComponentsUsecase,
+) *SalaryComponentHandler {
+	return &SalaryComponentHandler{
+		CreateUC: createUC,
+		UpdateUC: updateUC,
+		DeleteUC: deleteUC,
+		GetUC:    getUC,
+		ListUC:   listUC,
+	}
+}
+
+func (h *SalaryComponentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var body salarycomponenthandlerdto.CreateSalaryComponentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	component, err := h.CreateUC.Execute(r.Context(), domain.NewSalaryComponentInput{
+		TenantID:  body.TenantID,
+		Code:      body.Code,
+		Name:      body.Name,
+		Kind:      body.Kind,
+		Taxable:   body.Taxable,
+		Formula:   body.Formula,
+		RunTypes:  body.RunTypes,
+		SortOrder: body.SortOrder,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, component, http.StatusCreated)
+}
+
+func (h *SalaryComponentHandler) List(w http.ResponseWriter, r *http.Request) {
+	tenantID := r.URL.Query().Get("tenantId")
+	if tenantID == "" {
+		http.Error(w, "missing tenantId query", http.StatusBadRequest)
+		return
+	}
+
+	activeOnly := r.URL.Query().Get("active") == "true"
+
+	components, err := h.ListUC.Execute(r.Context(), tenantID, activeOnly)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, components, http.StatusOK)
+}
+
+func (h *SalaryComponentHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	component, err := h.GetUC.Execute(r.Context(), id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, component, http.StatusOK)
+}
+
+func (h *SalaryComponentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	var body salarycomponenthandlerdto.UpdateSalaryComponentRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	component, err := h.UpdateUC.Execute(r.Context(), salarycomponentusecase.UpdateSalaryComponentInput{
+		ID:        id,
+		Name:      body.Name,
+		Kind:      body.Kind,
+		Taxable:   body.Taxable,
+		Formula:   body.Formula,
+		RunTypes:  body.RunTypes,
+		SortOrder: body.SortOrder,
+		IsActive:  body.IsActive,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	httpx.WriteJSON(w, component, http.StatusOK)
+}
+
+func (h *SalaryComponentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	if err := h.DeleteUC.Execute(r.Context(), id); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, salarycomponentrepository.ErrSalaryComponentNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, salarycomponentrepository.ErrSalaryComponentAlreadyExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
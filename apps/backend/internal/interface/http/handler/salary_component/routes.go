@@ -0,0 +1,11 @@
+package salarycomponenthandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *SalaryComponentHandler) Routes(r chi.Router) {
+	r.Post("/", h.Create)
+	r.Get("/", h.List)
+	r.Get("/{id}", h.Get)
+	r.Put("/{id}", h.Update)
+	r.Delete("/{id}", h.Delete)
+}
@@ -0,0 +1,8 @@
+package statutoryhandlerdto
+
+type UpdateEmployeeProfileRequest struct {
+	WageRegion      int      `json:"wageRegion" validate:"required,min=1,max=4"`
+	Dependents      int      `json:"dependents" validate:"min=0"`
+	InsuranceSalary *float64 `json:"insuranceSalary" validate:"omitempty,min=0"`
+	TaxCode         *string  `json:"taxCode" validate:"omitempty,max=20"`
+}
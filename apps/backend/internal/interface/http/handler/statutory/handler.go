@@ -0,0 +1,83 @@
+package statutoryhandler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	statutoryhandlerdto "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/statutory/dto"
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryusecase "github.com/smart-hmm/smart-hmm/internal/modules/statutory/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/authctx"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/httpx"
+)
+
+type StatutoryHandler struct {
+	GetProfileUC    *statutoryusecase.GetEmployeeProfileUsecase
+	UpdateProfileUC *statutoryusecase.UpdateEmployeeProfileUsecase
+}
+
+var validate = validator.New(validator.WithRequiredStructEnabled())
+
+func NewStatutoryHandler(
+	getProfileUC *statutoryusecase.GetEmployeeProfileUsecase,
+	updateProfileUC *statutoryusecase.UpdateEmployeeProfileUsecase,
+) *StatutoryHandler {
+	return &StatutoryHandler{
+		GetProfileUC:    getProfileUC,
+		UpdateProfileUC: updateProfileUC,
+	}
+}
+
+func (h *StatutoryHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	employeeID := chi.URLParam(r, "employeeId")
+
+	profile, err := h.GetProfileUC.Execute(r.Context(), employeeID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, profile, http.StatusOK)
+}
+
+func (h *StatutoryHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := authctx.UserID(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	employeeID := chi.URLParam(r, "employeeId")
+
+	var body statutoryhandlerdto.UpdateEmployeeProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	if err := validate.Struct(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	profile, err := h.UpdateProfileUC.Execute(r.Context(), statutoryusecase.UpdateEmployeeProfileInput{
+		EmployeeID:      employeeID,
+		WageRegion:      body.WageRegion,
+		Dependents:      body.Dependents,
+		InsuranceSalary: body.InsuranceSalary,
+		TaxCode:         body.TaxCode,
+	}, userID)
+	if errors.Is(err, domain.ErrNotHR) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpx.WriteJSON(w, profile, http.StatusOK)
+}
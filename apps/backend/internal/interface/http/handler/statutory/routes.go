@@ -0,0 +1,8 @@
+package statutoryhandler
+
+import "github.com/go-chi/chi/v5"
+
+func (h *StatutoryHandler) Routes(r chi.Router) {
+	r.Get("/profiles/{employeeId}", h.GetProfile)
+	r.Put("/profiles/{employeeId}", h.UpdateProfile)
+}
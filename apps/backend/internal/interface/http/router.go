@@ -11,15 +11,32 @@ import (
 	aihandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/ai"
 	attendancehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/attendance"
 	authhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/auth"
+	bankaccounthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/bank_account"
+	compensationhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/compensation"
+	contracthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/contract"
+	customfieldhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/custom_field"
 	departmenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/department"
+	dependenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/dependent"
 	documenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/document"
 	emailtemplatehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/email_template"
+	emergencycontacthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/emergency_contact"
 	employeehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee"
+	employeeexporthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee_export"
+	employeeimporthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employee_import"
+	employmenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/employment"
 	filehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/file"
 	leaverequesthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/leave_request"
 	leavetypehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/leave_type"
 	metadatahandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/metadata"
+	offboardinghandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/offboarding"
+	onboardinghandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/onboarding"
+	orgcharthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/org_chart"
 	payrollhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/payroll"
+	positionhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/position"
+	probationhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/probation"
+	profilechangehandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/profile_change"
+	salarycomponenthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/salary_component"
+	statutoryhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/statutory"
 	systemsettingshandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/system_settings"
 	tenanthandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/tenant"
 	uploadhandler "github.com/smart-hmm/smart-hmm/internal/interface/http/handler/upload"
@@ -30,24 +47,41 @@ import (
 )
 
 type Args struct {
-	UserHandler           *userhandler.UserHandler
-	AttendanceHandler     *attendancehandler.AttendanceHandler
-	PayrollHandler        *payrollhandler.PayrollHandler
-	DepartmentHandler     *departmenthandler.DepartmentHandler
-	EmployeeHandler       *employeehandler.EmployeeHandler
-	EmailTemplateHandler  *emailtemplatehandler.EmailTemplateHandler
-	LeaveRequestHandler   *leaverequesthandler.LeaveRequestHandler
-	LeaveTypeHandler      *leavetypehandler.LeaveTypeHandler
-	SystemSettingsHandler *systemsettingshandler.SystemSettingsHandler
-	UserSettingsHandler   *usersettingshandler.UserSettingsHandler
-	AuthHandler           *authhandler.AuthHandler
-	UploadHandler         *uploadhandler.UploadHandler
-	FileHandler           *filehandler.FileHandler
-	DocumentHandler       *documenthandler.DocumentHandler
-	AIHandler             *aihandler.AIHandler
-	TenantHandler         *tenanthandler.TenantHandler
-	MetadataHandler       *metadatahandler.MetadataHandler
-	TokenService          tokenports.Service
+	UserHandler             *userhandler.UserHandler
+	AttendanceHandler       *attendancehandler.AttendanceHandler
+	PayrollHandler          *payrollhandler.PayrollHandler
+	BankAccountHandler      *bankaccounthandler.BankAccountHandler
+	CompensationHandler     *compensationhandler.CompensationHandler
+	EmploymentHandler       *employmenthandler.EmploymentHandler
+	ContractHandler         *contracthandler.ContractHandler
+	OffboardingHandler      *offboardinghandler.OffboardingHandler
+	OnboardingHandler       *onboardinghandler.OnboardingHandler
+	ProbationHandler        *probationhandler.ProbationHandler
+	OrgChartHandler         *orgcharthandler.OrgChartHandler
+	PositionHandler         *positionhandler.PositionHandler
+	ProfileChangeHandler    *profilechangehandler.ProfileChangeHandler
+	DependentHandler        *dependenthandler.DependentHandler
+	EmergencyContactHandler *emergencycontacthandler.EmergencyContactHandler
+	EmployeeImportHandler   *employeeimporthandler.EmployeeImportHandler
+	EmployeeExportHandler   *employeeexporthandler.EmployeeExportHandler
+	CustomFieldHandler      *customfieldhandler.CustomFieldHandler
+	SalaryComponentHandler  *salarycomponenthandler.SalaryComponentHandler
+	StatutoryHandler        *statutoryhandler.StatutoryHandler
+	DepartmentHandler       *departmenthandler.DepartmentHandler
+	EmployeeHandler         *employeehandler.EmployeeHandler
+	EmailTemplateHandler    *emailtemplatehandler.EmailTemplateHandler
+	LeaveRequestHandler     *leaverequesthandler.LeaveRequestHandler
+	LeaveTypeHandler        *leavetypehandler.LeaveTypeHandler
+	SystemSettingsHandler   *systemsettingshandler.SystemSettingsHandler
+	UserSettingsHandler     *usersettingshandler.UserSettingsHandler
+	AuthHandler             *authhandler.AuthHandler
+	UploadHandler           *uploadhandler.UploadHandler
+	FileHandler             *filehandler.FileHandler
+	DocumentHandler         *documenthandler.DocumentHandler
+	AIHandler               *aihandler.AIHandler
+	TenantHandler           *tenanthandler.TenantHandler
+	MetadataHandler         *metadatahandler.MetadataHandler
+	TokenService            tokenports.Service
 }
 
 func GetRouter(args Args) *chi.Mux {
@@ -88,6 +122,23 @@ func GetRouter(args Args) *chi.Mux {
 			pr.Route("/users", args.UserHandler.Routes)
 			pr.Route("/attendance", args.AttendanceHandler.Routes)
 			pr.Route("/payrolls", args.PayrollHandler.Routes)
+			pr.Route("/bank-accounts", args.BankAccountHandler.Routes)
+			pr.Route("/compensation", args.CompensationHandler.Routes)
+			pr.Route("/employment", args.EmploymentHandler.Routes)
+			pr.Route("/contracts", args.ContractHandler.Routes)
+			pr.Route("/onboarding", args.OnboardingHandler.Routes)
+			pr.Route("/offboardings", args.OffboardingHandler.Routes)
+			pr.Route("/probation", args.ProbationHandler.Routes)
+			pr.Route("/org-chart", args.OrgChartHandler.Routes)
+			pr.Route("/positions", args.PositionHandler.Routes)
+			pr.Route("/profile-changes", args.ProfileChangeHandler.Routes)
+			pr.Route("/dependents", args.DependentHandler.Routes)
+			pr.Route("/emergency-contacts", args.EmergencyContactHandler.Routes)
+			pr.Route("/employee-imports", args.EmployeeImportHandler.Routes)
+			pr.Route("/employee-exports", args.EmployeeExportHandler.Routes)
+			pr.Route("/custom-fields", args.CustomFieldHandler.Routes)
+			pr.Route("/salary-components", args.SalaryComponentHandler.Routes)
+			pr.Route("/statutory", args.StatutoryHandler.Routes)
 			pr.Route("/departments", args.DepartmentHandler.Routes)
 			pr.Route("/employees", args.EmployeeHandler.Routes)
 			pr.Route("/email-templates", args.EmailTemplateHandler.Routes)
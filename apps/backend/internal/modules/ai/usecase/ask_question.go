@@ -4,14 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"strings"
 
-	"github.com/Knetic/govaluate"
-
 	aido "github.com/smart-hmm/smart-hmm/internal/modules/ai/domain"
 	docdomain "github.com/smart-hmm/smart-hmm/internal/modules/document/domain"
 	documentrepository "github.com/smart-hmm/smart-hmm/internal/modules/document/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/formula"
 )
 
 type AskQuestionUseCase struct {
@@ -142,10 +140,7 @@ Chỉ trả về JSON đúng format trên.
 		*parsed.FormulaExpression != "" &&
 		parsed.Variables != nil {
 
-		expr, err := govaluate.NewEvaluableExpressionWithFunctions(
-			*parsed.FormulaExpression,
-			govaluateFunctions(),
-		)
+		expr, err := formula.Compile(*parsed.FormulaExpression)
 
 		if err == nil {
 			if result, err := expr.Evaluate(parsed.Variables); err == nil {
@@ -168,74 +163,23 @@ Chỉ trả về JSON đúng format trên.
 	}, nil
 }
 
-func govaluateFunctions() map[string]govaluate.ExpressionFunction {
-	return map[string]govaluate.ExpressionFunction{
-		"if": func(args ...interface{}) (interface{}, error) {
-			if len(args) != 3 {
-				return nil, fmt.Errorf("if expects 3 args")
-			}
-			cond, ok := args[0].(bool)
-			if !ok {
-				return nil, fmt.Errorf("if condition must be bool")
-			}
-			if cond {
-				return args[1], nil
-			}
-			return args[2], nil
-		},
-		"max": func(args ...interface{}) (interface{}, error) {
-			return math.Max(toFloat(args[0]), toFloat(args[1])), nil
-		},
-		"min": func(args ...interface{}) (interface{}, error) {
-			return math.Min(toFloat(args[0]), toFloat(args[1])), nil
-		},
-		"floor": func(args ...interface{}) (interface{}, error) {
-			return math.Floor(toFloat(args[0])), nil
-		},
-		"ceil": func(args ...interface{}) (interface{}, error) {
-			return math.Ceil(toFloat(args[0])), nil
-		},
-		"round": func(args ...interface{}) (interface{}, error) {
-			return math.Round(toFloat(args[0])), nil
-		},
-	}
-}
-
-func toFloat(v interface{}) float64 {
-	switch n := v.(type) {
-	case float64:
-		return n
-	case float32:
-		return float64(n)
-	case int:
-		return float64(n)
-	case int64:
-		return float64(n)
-	case json.Number:
-		f, _ := n.Float64()
-		return f
-	default:
-		return 0
-	}
-}
-
 func buildNaturalHRAnswer(
 	explanation string,
-	formula *string,
+	expression *string,
 	vars map[string]interface{},
 	unit string,
 	result interface{},
 ) string {
 
-	if formula == nil || result == nil {
+	if expression == nil || result == nil {
 		return explanation
 	}
 
-	val := toFloat(result)
+	val := formula.ToFloat(result)
 
 	switch unit {
 	case "months_of_salary":
-		salary := toFloat(vars["salary"])
+		salary := formula.ToFloat(vars["salary"])
 		total := val * salary
 		return fmt.Sprintf(
 			"%s\n\nÁp dụng vào trường hợp của bạn, bạn được thưởng khoảng %.2f tháng lương. Với mức lương hiện tại, số tiền thưởng ước tính khoảng %.0f.",
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+var (
+	ErrInvalidAccountNumber = errors.New("account number must be 4 to 34 letters or digits")
+	ErrBankRequired         = errors.New("bank name and bank code are required")
+	ErrAccountHolder        = errors.New("account holder is required")
+	ErrNotHR                = errors.New("only HR can change bank accounts; employees request the change for review")
+	ErrAccountsForbidden    = errors.New("only the employee and HR of their tenant can view these bank accounts")
+)
+
+var (
+	accountNumberPattern = regexp.MustCompile(`^[A-Z0-9]{4,34}$`)
+	ibanPattern          = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	bicPattern           = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+)
+
+// BankAccount is where an employee's salary is paid. AccountNumber is
+// only held in memory; storage keeps it encrypted and exposes the last
+// four characters for display.
+type BankAccount struct {
+	ID         string `json:"id"`
+	EmployeeID string `json:"employeeId"`
+
+	BankName   string  `json:"bankName"`
+	BankCode   string  `json:"bankCode"` // BIC or a local bank code
+	BranchCode *string `json:"branchCode,omitempty"`
+
+	AccountHolder string         `json:"accountHolder"`
+	AccountNumber string         `json:"-"`
+	AccountLast4  string         `json:"accountLast4"`
+	Currency      money.Currency `json:"currency"`
+	IsPrimary     bool           `json:"isPrimary"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type BankAccountInput struct {
+	BankName      string
+	BankCode      string
+	BranchCode    *string
+	AccountHolder string
+	AccountNumber string
+	Currency      money.Currency
+	IsPrimary     bool
+}
+
+func NewBankAccount(employeeID string, in BankAccountInput) (*BankAccount, error) {
+	if employeeID == "" {
+		return nil, errors.New("employeeID is required")
+	}
+
+	now := time.Now().UTC()
+	a := &BankAccount{
+		EmployeeID: employeeID,
+		CreatedAt:  now,
+	}
+
+	if err := a.Update(in); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (a *BankAccount) Update(in BankAccountInput) error {
+	number := NormalizeAccountNumber(in.AccountNumber)
+	if !accountNumberPattern.MatchString(number) {
+		return ErrInvalidAccountNumber
+	}
+	if strings.TrimSpace(in.BankName) == "" || strings.TrimSpace(in.BankCode) == "" {
+		return ErrBankRequired
+	}
+	if strings.TrimSpace(in.AccountHolder) == "" {
+		return ErrAccountHolder
+	}
+
+	a.BankName = strings.TrimSpace(in.BankName)
+	a.BankCode = strings.ToUpper(strings.TrimSpace(in.BankCode))
+	a.BranchCode = in.BranchCode
+	a.AccountHolder = strings.TrimSpace(in.AccountHolder)
+	a.AccountNumber = number
+	a.AccountLast4 = number[len(number)-4:]
+	a.Currency = in.Currency
+	a.IsPrimary = in.IsPrimary
+	a.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// NormalizeAccountNumber drops the spaces and dashes people type into
+// account numbers and IBANs.
+func NormalizeAccountNumber(s string) string {
+	s = strings.ToUpper(s)
+	return strings.NewReplacer(" ", "", "-", "", ".", "").Replace(s)
+}
+
+func IsIBAN(account string) bool {
+	return ibanPattern.MatchString(account)
+}
+
+func IsBIC(code string) bool {
+	return bicPattern.MatchString(code)
+}
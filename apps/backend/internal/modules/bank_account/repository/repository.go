@@ -0,0 +1,25 @@
+package bankaccountrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+)
+
+var ErrBankAccountNotFound = errors.New("bank account not found")
+
+type BankAccountRepository interface {
+	Create(ctx context.Context, a *domain.BankAccount) error
+	Update(ctx context.Context, a *domain.BankAccount) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.BankAccount, error)
+	ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.BankAccount, error)
+	// ListPrimaryByEmployeeIDs returns the primary account of each employee
+	// that has one, keyed by employee ID.
+	ListPrimaryByEmployeeIDs(ctx context.Context, employeeIDs []string) (map[string]*domain.BankAccount, error)
+
+	// ClearPrimary unsets the primary flag on every account of the employee.
+	ClearPrimary(ctx context.Context, employeeID string) error
+}
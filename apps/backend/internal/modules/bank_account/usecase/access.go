@@ -0,0 +1,22 @@
+package bankaccountusecase
+
+import (
+	"fmt"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+// requireHR lets admins and HR change accounts directly. Everyone else
+// goes through a profile change request, which HR approves.
+func requireHR(userRepo userrepository.UserRepository, userID string) error {
+	user, err := userRepo.FindByID(userID)
+	if err != nil {
+		return fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.Role != userdomain.Admin && user.Role != userdomain.HR {
+		return domain.ErrNotHR
+	}
+	return nil
+}
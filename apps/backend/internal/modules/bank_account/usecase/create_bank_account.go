@@ -0,0 +1,68 @@
+package bankaccountusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type CreateBankAccountUsecase struct {
+	repo      bankaccountrepository.BankAccountRepository
+	userRepo  userrepository.UserRepository
+	txManager txpkg.Manager
+}
+
+func NewCreateBankAccountUsecase(
+	repo bankaccountrepository.BankAccountRepository,
+	userRepo userrepository.UserRepository,
+	txManager txpkg.Manager,
+) *CreateBankAccountUsecase {
+	return &CreateBankAccountUsecase{repo: repo, userRepo: userRepo, txManager: txManager}
+}
+
+// Execute adds an account. An employee's first account becomes primary,
+// and a new primary account demotes the previous one. Only admins and HR
+// add accounts.
+func (uc *CreateBankAccountUsecase) Execute(
+	ctx context.Context,
+	employeeID string,
+	in domain.BankAccountInput,
+	userID string,
+) (*domain.BankAccount, error) {
+
+	if err := requireHR(uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	account, err := domain.NewBankAccount(employeeID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		existing, err := uc.repo.ListByEmployeeID(txCtx, employeeID)
+		if err != nil {
+			return err
+		}
+
+		if len(existing) == 0 {
+			account.IsPrimary = true
+		}
+
+		if account.IsPrimary {
+			if err := uc.repo.ClearPrimary(txCtx, employeeID); err != nil {
+				return err
+			}
+		}
+
+		return uc.repo.Create(txCtx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
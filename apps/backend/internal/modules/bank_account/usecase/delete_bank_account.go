@@ -0,0 +1,29 @@
+package bankaccountusecase
+
+import (
+	"context"
+
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+type DeleteBankAccountUsecase struct {
+	repo     bankaccountrepository.BankAccountRepository
+	userRepo userrepository.UserRepository
+}
+
+func NewDeleteBankAccountUsecase(
+	repo bankaccountrepository.BankAccountRepository,
+	userRepo userrepository.UserRepository,
+) *DeleteBankAccountUsecase {
+	return &DeleteBankAccountUsecase{repo: repo, userRepo: userRepo}
+}
+
+// Execute removes an account. Only admins and HR remove accounts.
+func (uc *DeleteBankAccountUsecase) Execute(ctx context.Context, id, userID string) error {
+	if err := requireHR(uc.userRepo, userID); err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
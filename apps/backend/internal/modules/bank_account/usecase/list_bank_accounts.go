@@ -0,0 +1,42 @@
+package bankaccountusecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	payrollusecase "github.com/smart-hmm/smart-hmm/internal/modules/payroll/usecase"
+)
+
+type ListBankAccountsUsecase struct {
+	repo   bankaccountrepository.BankAccountRepository
+	access *payrollusecase.PayAccess
+}
+
+func NewListBankAccountsUsecase(
+	repo bankaccountrepository.BankAccountRepository,
+	access *payrollusecase.PayAccess,
+) *ListBankAccountsUsecase {
+	return &ListBankAccountsUsecase{repo: repo, access: access}
+}
+
+// Execute lists the employee's accounts to whoever may see their pay: the
+// employee, and HR and admins of their tenant.
+func (uc *ListBankAccountsUsecase) Execute(ctx context.Context, employeeID, userID string) ([]*domain.BankAccount, error) {
+	if err := uc.access.Employee(ctx, userID, employeeID); err != nil {
+		if errors.Is(err, payrollusecase.ErrNoPayAccess) {
+			return nil, domain.ErrAccountsForbidden
+		}
+		return nil, err
+	}
+
+	accounts, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if accounts == nil {
+		accounts = []*domain.BankAccount{}
+	}
+	return accounts, nil
+}
@@ -0,0 +1,64 @@
+package bankaccountusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type UpdateBankAccountUsecase struct {
+	repo      bankaccountrepository.BankAccountRepository
+	userRepo  userrepository.UserRepository
+	txManager txpkg.Manager
+}
+
+func NewUpdateBankAccountUsecase(
+	repo bankaccountrepository.BankAccountRepository,
+	userRepo userrepository.UserRepository,
+	txManager txpkg.Manager,
+) *UpdateBankAccountUsecase {
+	return &UpdateBankAccountUsecase{repo: repo, userRepo: userRepo, txManager: txManager}
+}
+
+// Execute changes an account. Only admins and HR change accounts.
+func (uc *UpdateBankAccountUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.BankAccountInput,
+	userID string,
+) (*domain.BankAccount, error) {
+
+	if err := requireHR(uc.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	var account *domain.BankAccount
+
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		account, err = uc.repo.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := account.Update(in); err != nil {
+			return err
+		}
+
+		if account.IsPrimary {
+			if err := uc.repo.ClearPrimary(txCtx, account.EmployeeID); err != nil {
+				return err
+			}
+		}
+
+		return uc.repo.Update(txCtx, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
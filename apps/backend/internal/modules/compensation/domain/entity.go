@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type ChangeReason string
+
+const (
+	ReasonHire       ChangeReason = "HIRE"
+	ReasonPromotion  ChangeReason = "PROMOTION"
+	ReasonMerit      ChangeReason = "MERIT"
+	ReasonAdjustment ChangeReason = "ADJUSTMENT"
+)
+
+var (
+	ErrInvalidReason    = errors.New("reason must be HIRE, PROMOTION, MERIT or ADJUSTMENT")
+	ErrNegativeSalary   = errors.New("base salary cannot be negative")
+	ErrEffectiveDate    = errors.New("effective date is required")
+	ErrApproverRequired = errors.New("approver is required")
+)
+
+// SalaryChange is one entry of an employee's compensation history. The
+// salary applies from EffectiveFrom until the next change.
+type SalaryChange struct {
+	ID         string `json:"id"`
+	EmployeeID string `json:"employee_id"`
+
+	EffectiveFrom time.Time    `json:"effective_from"` // date, UTC midnight
+	BaseSalary    money.Money  `json:"base_salary"`
+	Reason        ChangeReason `json:"reason"`
+	Note          *string      `json:"note,omitempty"`
+
+	// ApprovedBy is empty for the hire entry recorded with the employee.
+	ApprovedBy string    `json:"approved_by,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewSalaryChange(
+	employeeID string,
+	effectiveFrom time.Time,
+	base money.Money,
+	reason ChangeReason,
+	note *string,
+	approvedBy string,
+) (*SalaryChange, error) {
+
+	if employeeID == "" {
+		return nil, errors.New("employeeID is required")
+	}
+	if effectiveFrom.IsZero() {
+		return nil, ErrEffectiveDate
+	}
+	if base.IsNegative() {
+		return nil, ErrNegativeSalary
+	}
+
+	switch reason {
+	case ReasonHire:
+	case ReasonPromotion, ReasonMerit, ReasonAdjustment:
+		if approvedBy == "" {
+			return nil, ErrApproverRequired
+		}
+	default:
+		return nil, ErrInvalidReason
+	}
+
+	y, m, d := effectiveFrom.Date()
+
+	return &SalaryChange{
+		EmployeeID:    employeeID,
+		EffectiveFrom: time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		BaseSalary:    base,
+		Reason:        reason,
+		Note:          note,
+		ApprovedBy:    approvedBy,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// History is an employee's salary changes, in any order.
+type History []*SalaryChange
+
+// SalaryOn returns the salary in effect on day. Later entries win over
+// earlier ones with the same effective date. ok is false when no change
+// is effective yet.
+func (h History) SalaryOn(day time.Time) (money.Money, bool) {
+	var current *SalaryChange
+	for _, c := range h {
+		if c.EffectiveFrom.After(day) {
+			continue
+		}
+		if current == nil ||
+			c.EffectiveFrom.After(current.EffectiveFrom) ||
+			(c.EffectiveFrom.Equal(current.EffectiveFrom) && c.CreatedAt.After(current.CreatedAt)) {
+			current = c
+		}
+	}
+
+	if current == nil {
+		return money.Money{}, false
+	}
+	return current.BaseSalary, true
+}
+
+// Segment is a stretch of working days paid at one salary.
+type Segment struct {
+	Salary money.Money
+	Days   int
+}
+
+// earliest returns the first change by effective date.
+func (h History) earliest() *SalaryChange {
+	var first *SalaryChange
+	for _, c := range h {
+		if first == nil || c.EffectiveFrom.Before(first.EffectiveFrom) {
+			first = c
+		}
+	}
+	return first
+}
+
+// Segments splits the weekdays of [from, to) by the salary in effect on
+// each one. Days before the first change are paid at the first salary, and
+// an empty history pays fallback throughout.
+func (h History) Segments(from, to time.Time, fallback money.Money) []Segment {
+	if first := h.earliest(); first != nil {
+		fallback = first.BaseSalary
+	}
+
+	var segments []Segment
+
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday {
+			continue
+		}
+
+		salary, ok := h.SalaryOn(d)
+		if !ok {
+			salary = fallback
+		}
+
+		last := len(segments) - 1
+		if last >= 0 && segments[last].Salary.Currency() == salary.Currency() && segments[last].Salary.Cmp(salary) == 0 {
+			segments[last].Days++
+			continue
+		}
+		segments = append(segments, Segment{Salary: salary, Days: 1})
+	}
+
+	return segments
+}
+
+// ProratedSalary is the base salary for [from, to), weighting each salary
+// by the working days it was in effect. The weighted sum is exact and
+// rounded once, so the period never pays a cent more or less than its
+// days. A period with a single salary returns it unchanged.
+func (h History) ProratedSalary(from, to time.Time, fallback money.Money) (money.Money, error) {
+	segments := h.Segments(from, to, fallback)
+
+	switch len(segments) {
+	case 0:
+		return fallback, nil
+	case 1:
+		return segments[0].Salary, nil
+	}
+
+	weighted, err := weightedSum(segments)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return weighted.MulRat(1, int64(totalDays(segments)))
+}
+
+// EarnedSalary is the salary earned in the month starting at monthStart
+// by working its weekdays from from through lastDay: each salary in effect
+// times its days, over the weekdays of the whole month. It is the final
+// month's pay of an employee who leaves on lastDay.
+func (h History) EarnedSalary(monthStart, from, lastDay time.Time, fallback money.Money) (money.Money, error) {
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	if from.Before(monthStart) {
+		from = monthStart
+	}
+	through := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day()+1, 0, 0, 0, 0, time.UTC)
+	if through.After(monthEnd) {
+		through = monthEnd
+	}
+
+	worked := h.Segments(from, through, fallback)
+	if len(worked) == 0 {
+		return money.Zero(fallback.Currency()), nil
+	}
+
+	weighted, err := weightedSum(worked)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return weighted.MulRat(1, int64(totalDays(h.Segments(monthStart, monthEnd, fallback))))
+}
+
+func totalDays(segments []Segment) int {
+	days := 0
+	for _, s := range segments {
+		days += s.Days
+	}
+	return days
+}
+
+// weightedSum is the sum of every segment's salary times its days.
+func weightedSum(segments []Segment) (money.Money, error) {
+	currency := segments[0].Salary.Currency()
+	weighted := money.Zero(currency)
+
+	for _, s := range segments {
+		if s.Salary.Currency() != currency {
+			return money.Money{}, money.ErrCurrencyMismatch
+		}
+
+		days, err := s.Salary.MulRat(int64(s.Days), 1)
+		if err != nil {
+			return money.Money{}, err
+		}
+
+		if weighted, err = weighted.Add(days); err != nil {
+			return money.Money{}, err
+		}
+	}
+
+	return weighted, nil
+}
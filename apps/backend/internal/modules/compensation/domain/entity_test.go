@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func vnd(s string) money.Money {
+	m, err := money.Parse(s, money.Currency("VND"))
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func change(from, salary string, created int) *SalaryChange {
+	return &SalaryChange{
+		EffectiveFrom: day(from),
+		BaseSalary:    vnd(salary),
+		CreatedAt:     time.Date(2025, 1, 1, created, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewSalaryChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		employeeID string
+		from       time.Time
+		base       string
+		reason     ChangeReason
+		approver   string
+		wantErr    error
+	}{
+		{name: "hire without approver", employeeID: "e1", from: day("2025-03-01"), base: "10000000", reason: ReasonHire},
+		{name: "merit", employeeID: "e1", from: day("2025-03-01"), base: "12000000", reason: ReasonMerit, approver: "u1"},
+		{name: "raise without approver", employeeID: "e1", from: day("2025-03-01"), base: "12000000", reason: ReasonPromotion, wantErr: ErrApproverRequired},
+		{name: "unknown reason", employeeID: "e1", from: day("2025-03-01"), base: "12000000", reason: "BONUS", approver: "u1", wantErr: ErrInvalidReason},
+		{name: "negative salary", employeeID: "e1", from: day("2025-03-01"), base: "-1", reason: ReasonHire, wantErr: ErrNegativeSalary},
+		{name: "no effective date", employeeID: "e1", base: "1", reason: ReasonHire, wantErr: ErrEffectiveDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewSalaryChange(tt.employeeID, tt.from, vnd(tt.base), tt.reason, nil, tt.approver)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewSalaryChange() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && !c.EffectiveFrom.Equal(tt.from) {
+				t.Fatalf("effective from = %v, want %v", c.EffectiveFrom, tt.from)
+			}
+		})
+	}
+}
+
+func TestNewSalaryChangeTruncatesToDate(t *testing.T) {
+	local := time.FixedZone("ICT", 7*3600)
+	c, err := NewSalaryChange("e1", time.Date(2025, 3, 1, 23, 30, 0, 0, local), vnd("1"), ReasonHire, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.EffectiveFrom.Equal(day("2025-03-01")) {
+		t.Fatalf("effective from = %v", c.EffectiveFrom)
+	}
+}
+
+func TestHistorySalaryOn(t *testing.T) {
+	history := History{
+		change("2025-01-01", "10000000", 0),
+		change("2025-03-10", "12000000", 0),
+		change("2025-03-10", "12500000", 1),
+	}
+
+	tests := []struct {
+		day    string
+		want   string
+		wantOK bool
+	}{
+		{day: "2024-12-31"},
+		{day: "2025-01-01", want: "10000000", wantOK: true},
+		{day: "2025-03-09", want: "10000000", wantOK: true},
+		{day: "2025-03-10", want: "12500000", wantOK: true},
+		{day: "2026-01-01", want: "12500000", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			got, ok := history.SalaryOn(day(tt.day))
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got.String() != tt.want {
+				t.Fatalf("salary = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryProratedSalary(t *testing.T) {
+	tests := []struct {
+		name     string
+		history  History
+		fallback string
+		want     string
+	}{
+		{name: "empty history pays fallback", fallback: "9000000", want: "9000000"},
+		{
+			name:     "single salary",
+			history:  History{change("2024-06-01", "10000000", 0)},
+			fallback: "1",
+			want:     "10000000",
+		},
+		{
+			// March 2025 has 21 weekdays; the raise covers the last 11.
+			name:     "mid-month raise",
+			history:  History{change("2024-06-01", "10000000", 0), change("2025-03-17", "21000000", 0)},
+			fallback: "1",
+			want:     "15761905",
+		},
+		{
+			// Each third rounds down on its own; together they make a dong.
+			name: "rounded once",
+			history: History{
+				change("2024-06-01", "10000000", 0),
+				change("2025-03-10", "12000016", 0),
+				change("2025-03-24", "15000000", 0),
+			},
+			fallback: "1",
+			want:     "12380960",
+		},
+		{
+			name:     "joined mid-month pays first salary before it",
+			history:  History{change("2025-03-17", "21000000", 0)},
+			fallback: "1",
+			want:     "21000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.history.ProratedSalary(day("2025-03-01"), day("2025-04-01"), vnd(tt.fallback))
+			if err != nil {
+				t.Fatalf("ProratedSalary() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("salary = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryEarnedSalary(t *testing.T) {
+	history := History{change("2024-06-01", "21000000", 0), change("2025-03-17", "42000000", 0)}
+
+	// March 2025 has 21 weekdays; the raise covers the last 11.
+	tests := []struct {
+		name    string
+		from    string
+		lastDay string
+		want    string
+	}{
+		{name: "left on the first friday", from: "2025-03-01", lastDay: "2025-03-07", want: "5000000"},
+		{name: "left on a sunday", from: "2025-03-01", lastDay: "2025-03-09", want: "5000000"},
+		{name: "left after the raise", from: "2025-03-01", lastDay: "2025-03-18", want: "14000000"},
+		{name: "worked the whole month", from: "2025-03-01", lastDay: "2025-03-31", want: "32000000"},
+		{name: "left after the month", from: "2025-03-01", lastDay: "2025-05-02", want: "32000000"},
+		{name: "left before the month", from: "2025-03-01", lastDay: "2025-02-28", want: "0"},
+		{name: "joined and left in the month", from: "2025-03-10", lastDay: "2025-03-14", want: "5000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := history.EarnedSalary(day("2025-03-01"), day(tt.from), day(tt.lastDay), vnd("1"))
+			if err != nil {
+				t.Fatalf("EarnedSalary() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("salary = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package compensationrepository
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+)
+
+type SalaryChangeRepository interface {
+	Create(ctx context.Context, c *domain.SalaryChange) error
+	ListByEmployeeID(ctx context.Context, employeeID string) (domain.History, error)
+}
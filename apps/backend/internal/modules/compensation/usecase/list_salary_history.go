@@ -0,0 +1,27 @@
+package compensationusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+)
+
+type ListSalaryHistoryUsecase struct {
+	repo compensationrepository.SalaryChangeRepository
+}
+
+func NewListSalaryHistoryUsecase(repo compensationrepository.SalaryChangeRepository) *ListSalaryHistoryUsecase {
+	return &ListSalaryHistoryUsecase{repo: repo}
+}
+
+func (uc *ListSalaryHistoryUsecase) Execute(ctx context.Context, employeeID string) (domain.History, error) {
+	history, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = domain.History{}
+	}
+	return history, nil
+}
@@ -0,0 +1,63 @@
+package compensationusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type RecordSalaryChangeUsecase struct {
+	repo         compensationrepository.SalaryChangeRepository
+	employeeRepo employeerepository.EmployeeRepository
+}
+
+func NewRecordSalaryChangeUsecase(
+	repo compensationrepository.SalaryChangeRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+) *RecordSalaryChangeUsecase {
+	return &RecordSalaryChangeUsecase{repo: repo, employeeRepo: employeeRepo}
+}
+
+type RecordSalaryChangeInput struct {
+	EmployeeID    string
+	EffectiveFrom time.Time
+	BaseSalary    money.Money
+	Reason        domain.ChangeReason
+	Note          *string
+	ApprovedBy    string
+}
+
+// Execute appends a change to the employee's history. Changes dated in an
+// approved payroll period are paid through retroactive adjustments.
+func (uc *RecordSalaryChangeUsecase) Execute(
+	ctx context.Context,
+	in RecordSalaryChangeInput,
+) (*domain.SalaryChange, error) {
+
+	if _, err := uc.employeeRepo.FindByID(in.EmployeeID); err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", in.EmployeeID, err)
+	}
+
+	change, err := domain.NewSalaryChange(
+		in.EmployeeID,
+		in.EffectiveFrom,
+		in.BaseSalary,
+		in.Reason,
+		in.Note,
+		in.ApprovedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, change); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type ContractType string
+
+const (
+	TypeIndefinite ContractType = "INDEFINITE"
+	TypeFixedTerm  ContractType = "FIXED_TERM"
+	TypeProbation  ContractType = "PROBATION"
+)
+
+const (
+	// MaxFixedTermMonths is the longest fixed-term contract the Labor Code
+	// allows.
+	MaxFixedTermMonths = 36
+	// MaxProbationDays is the longest probation, reserved for enterprise
+	// managers; shorter limits for other roles are left to HR.
+	MaxProbationDays = 180
+	// MaxFixedTermRenewals is how often a fixed-term contract may be
+	// renewed as fixed-term; the next contract must be indefinite.
+	MaxFixedTermRenewals = 1
+)
+
+var (
+	ErrInvalidType       = errors.New("contract type must be INDEFINITE, FIXED_TERM or PROBATION")
+	ErrNumberRequired    = errors.New("contract number is required")
+	ErrStartDate         = errors.New("start date is required")
+	ErrEndDateRequired   = errors.New("end date is required for fixed-term and probation contracts")
+	ErrEndDateNotAllowed = errors.New("indefinite contracts have no end date")
+	ErrEndBeforeStart    = errors.New("end date must be after start date")
+	ErrFixedTermTooLong  = errors.New("fixed-term contracts cannot exceed 36 months")
+	ErrProbationPeriod   = errors.New("probation must end within 180 days of the start date and before the contract ends")
+	ErrRenewalLimit      = errors.New("a fixed-term contract can be renewed as fixed-term only once; the next contract must be indefinite")
+	ErrNotRenewable      = errors.New("only fixed-term and probation contracts can be renewed")
+)
+
+// Contract is a labor contract signed with an employee. Dates are UTC
+// midnight; EndDate is nil for indefinite contracts.
+type Contract struct {
+	ID         string `json:"id"`
+	TenantID   string `json:"tenant_id"`
+	EmployeeID string `json:"employee_id"`
+
+	Type   ContractType `json:"type"`
+	Number string       `json:"number"`
+
+	StartDate        time.Time  `json:"start_date"`
+	EndDate          *time.Time `json:"end_date,omitempty"`
+	ProbationEndDate *time.Time `json:"probation_end_date,omitempty"`
+
+	// SignedFileID references the uploaded scan of the signed contract.
+	SignedFileID *string `json:"signed_file_id,omitempty"`
+
+	// RenewalCount is how many fixed-term contracts precede this one in
+	// its renewal chain.
+	RenewalCount       int     `json:"renewal_count"`
+	PreviousContractID *string `json:"previous_contract_id,omitempty"`
+	Note               *string `json:"note,omitempty"`
+
+	EndReminderSentAt       *time.Time `json:"end_reminder_sent_at,omitempty"`
+	ProbationReminderSentAt *time.Time `json:"probation_reminder_sent_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Read-only, filled by listings.
+	EmployeeCode string `json:"employee_code,omitempty"`
+	EmployeeName string `json:"employee_name,omitempty"`
+}
+
+type ContractInput struct {
+	Type             ContractType
+	Number           string
+	StartDate        time.Time
+	EndDate          *time.Time
+	ProbationEndDate *time.Time
+	SignedFileID     *string
+	Note             *string
+}
+
+func NewContract(tenantID, employeeID string, in ContractInput) (*Contract, error) {
+	if tenantID == "" || employeeID == "" {
+		return nil, errors.New("tenantID and employeeID are required")
+	}
+
+	now := time.Now().UTC()
+	c := &Contract{
+		TenantID:   tenantID,
+		EmployeeID: employeeID,
+		CreatedAt:  now,
+	}
+
+	if err := c.Update(in); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Update replaces the contract terms. Moving a date clears the reminder
+// sent for it so the new date is reminded again.
+func (c *Contract) Update(in ContractInput) error {
+	switch in.Type {
+	case TypeIndefinite, TypeFixedTerm, TypeProbation:
+	default:
+		return ErrInvalidType
+	}
+
+	number := strings.TrimSpace(in.Number)
+	if number == "" {
+		return ErrNumberRequired
+	}
+	if in.StartDate.IsZero() {
+		return ErrStartDate
+	}
+
+	start := day(in.StartDate)
+	end := dayPtr(in.EndDate)
+	probationEnd := dayPtr(in.ProbationEndDate)
+
+	if in.Type == TypeIndefinite {
+		if end != nil {
+			return ErrEndDateNotAllowed
+		}
+	} else {
+		if end == nil {
+			return ErrEndDateRequired
+		}
+		if !end.After(start) {
+			return ErrEndBeforeStart
+		}
+	}
+
+	if in.Type == TypeFixedTerm && end.After(start.AddDate(0, MaxFixedTermMonths, 0)) {
+		return ErrFixedTermTooLong
+	}
+
+	// A probation contract is the probation itself.
+	if in.Type == TypeProbation {
+		probationEnd = end
+	}
+	if probationEnd != nil {
+		if probationEnd.Before(start) ||
+			probationEnd.After(start.AddDate(0, 0, MaxProbationDays)) ||
+			(end != nil && probationEnd.After(*end)) {
+			return ErrProbationPeriod
+		}
+	}
+
+	if !sameDate(c.EndDate, end) {
+		c.EndReminderSentAt = nil
+	}
+	if !sameDate(c.ProbationEndDate, probationEnd) {
+		c.ProbationReminderSentAt = nil
+	}
+
+	c.Type = in.Type
+	c.Number = number
+	c.StartDate = start
+	c.EndDate = end
+	c.ProbationEndDate = probationEnd
+	c.SignedFileID = in.SignedFileID
+	c.Note = in.Note
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Renew builds the contract that follows c. A fixed-term successor of a
+// fixed-term contract counts as a renewal and is limited by
+// MaxFixedTermRenewals.
+func (c *Contract) Renew(in ContractInput) (*Contract, error) {
+	if c.Type == TypeIndefinite {
+		return nil, ErrNotRenewable
+	}
+
+	next, err := NewContract(c.TenantID, c.EmployeeID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.EndDate != nil && next.StartDate.Before(*c.EndDate) {
+		return nil, ErrEndBeforeStart
+	}
+
+	if c.Type == TypeFixedTerm && next.Type == TypeFixedTerm {
+		if c.RenewalCount >= MaxFixedTermRenewals {
+			return nil, ErrRenewalLimit
+		}
+		next.RenewalCount = c.RenewalCount + 1
+	}
+
+	next.PreviousContractID = &c.ID
+	return next, nil
+}
+
+// ReminderKind is the date a reminder is about.
+type ReminderKind string
+
+const (
+	ReminderContractEnd  ReminderKind = "CONTRACT_END"
+	ReminderProbationEnd ReminderKind = "PROBATION_END"
+)
+
+// Reminder is a contract whose end or probation end falls due soon.
+type Reminder struct {
+	Kind     ReminderKind
+	Contract *Contract
+	DueDate  time.Time
+}
+
+func day(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func dayPtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	d := day(*t)
+	return &d
+}
+
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,35 @@
+package contractrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+)
+
+var (
+	ErrContractNotFound       = errors.New("contract not found")
+	ErrContractNumberExists   = errors.New("contract number already exists")
+	ErrContractAlreadyRenewed = errors.New("contract has already been renewed")
+)
+
+type ContractRepository interface {
+	Create(ctx context.Context, c *domain.Contract) error
+	Update(ctx context.Context, c *domain.Contract) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.Contract, error)
+	ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Contract, error)
+	// ListExpiring returns the tenant's contracts ending or leaving
+	// probation in [from, to], soonest first.
+	ListExpiring(ctx context.Context, tenantID string, from, to time.Time) ([]*domain.Contract, error)
+
+	// ClaimReminders marks the contracts whose kind of date falls in
+	// [from, to] and has not been reminded yet, and returns them. Claimed
+	// rows stay locked until the surrounding transaction ends.
+	ClaimReminders(ctx context.Context, kind domain.ReminderKind, from, to time.Time) ([]*domain.Reminder, error)
+	// ListReminderRecipients returns the e-mail addresses of the tenant's
+	// HR users and its owner.
+	ListReminderRecipients(ctx context.Context, tenantID string) ([]string, error)
+}
@@ -0,0 +1,45 @@
+package contractusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+)
+
+type CreateContractUsecase struct {
+	repo         contractrepository.ContractRepository
+	employeeRepo employeerepository.EmployeeRepository
+}
+
+func NewCreateContractUsecase(
+	repo contractrepository.ContractRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+) *CreateContractUsecase {
+	return &CreateContractUsecase{repo: repo, employeeRepo: employeeRepo}
+}
+
+func (uc *CreateContractUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	employeeID string,
+	in domain.ContractInput,
+) (*domain.Contract, error) {
+
+	if _, err := uc.employeeRepo.FindByID(employeeID); err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", employeeID, err)
+	}
+
+	contract, err := domain.NewContract(tenantID, employeeID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
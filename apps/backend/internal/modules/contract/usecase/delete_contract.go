@@ -0,0 +1,19 @@
+package contractusecase
+
+import (
+	"context"
+
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+)
+
+type DeleteContractUsecase struct {
+	repo contractrepository.ContractRepository
+}
+
+func NewDeleteContractUsecase(repo contractrepository.ContractRepository) *DeleteContractUsecase {
+	return &DeleteContractUsecase{repo: repo}
+}
+
+func (uc *DeleteContractUsecase) Execute(ctx context.Context, id string) error {
+	return uc.repo.Delete(ctx, id)
+}
@@ -0,0 +1,44 @@
+package contractusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+)
+
+type ListContractsUsecase struct {
+	repo contractrepository.ContractRepository
+}
+
+func NewListContractsUsecase(repo contractrepository.ContractRepository) *ListContractsUsecase {
+	return &ListContractsUsecase{repo: repo}
+}
+
+func (uc *ListContractsUsecase) Execute(ctx context.Context, employeeID string) ([]*domain.Contract, error) {
+	return uc.repo.ListByEmployeeID(ctx, employeeID)
+}
+
+type ListExpiringContractsUsecase struct {
+	repo contractrepository.ContractRepository
+}
+
+func NewListExpiringContractsUsecase(repo contractrepository.ContractRepository) *ListExpiringContractsUsecase {
+	return &ListExpiringContractsUsecase{repo: repo}
+}
+
+// Execute lists the contracts not yet renewed whose end or probation end
+// falls within the next days, starting today.
+func (uc *ListExpiringContractsUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	today time.Time,
+	days int,
+) ([]*domain.Contract, error) {
+
+	y, m, d := today.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	return uc.repo.ListExpiring(ctx, tenantID, from, from.AddDate(0, 0, days))
+}
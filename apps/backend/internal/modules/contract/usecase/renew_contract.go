@@ -0,0 +1,41 @@
+package contractusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+)
+
+type RenewContractUsecase struct {
+	repo contractrepository.ContractRepository
+}
+
+func NewRenewContractUsecase(repo contractrepository.ContractRepository) *RenewContractUsecase {
+	return &RenewContractUsecase{repo: repo}
+}
+
+// Execute signs the contract that follows id. Each contract can be
+// renewed once; the renewal chain carries the fixed-term renewal count.
+func (uc *RenewContractUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.ContractInput,
+) (*domain.Contract, error) {
+
+	previous, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := previous.Renew(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, next); err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
@@ -0,0 +1,125 @@
+package contractusecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type SendContractRemindersUsecase struct {
+	repo      contractrepository.ContractRepository
+	txManager txpkg.Manager
+	queueSvc  queueports.QueueService
+}
+
+func NewSendContractRemindersUsecase(
+	repo contractrepository.ContractRepository,
+	txManager txpkg.Manager,
+	queueSvc queueports.QueueService,
+) *SendContractRemindersUsecase {
+	return &SendContractRemindersUsecase{
+		repo:      repo,
+		txManager: txManager,
+		queueSvc:  queueSvc,
+	}
+}
+
+// Execute e-mails each tenant's HR a digest of the contracts and
+// probations ending within daysBefore days of today. Every date is
+// reminded once; a failed publish leaves the claim to the next run.
+func (uc *SendContractRemindersUsecase) Execute(ctx context.Context, today time.Time, daysBefore int) error {
+	y, m, d := today.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, daysBefore)
+
+	return uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var reminders []*domain.Reminder
+		for _, kind := range []domain.ReminderKind{domain.ReminderContractEnd, domain.ReminderProbationEnd} {
+			claimed, err := uc.repo.ClaimReminders(txCtx, kind, from, to)
+			if err != nil {
+				return err
+			}
+			reminders = append(reminders, claimed...)
+		}
+
+		byTenant := map[string][]*domain.Reminder{}
+		var tenantIDs []string
+		for _, r := range reminders {
+			if _, ok := byTenant[r.Contract.TenantID]; !ok {
+				tenantIDs = append(tenantIDs, r.Contract.TenantID)
+			}
+			byTenant[r.Contract.TenantID] = append(byTenant[r.Contract.TenantID], r)
+		}
+
+		for _, tenantID := range tenantIDs {
+			recipients, err := uc.repo.ListReminderRecipients(txCtx, tenantID)
+			if err != nil {
+				return err
+			}
+			if len(recipients) == 0 {
+				log.Println("no contract reminder recipients for tenant:", tenantID)
+				continue
+			}
+
+			subject, body := reminderDigest(byTenant[tenantID], from)
+			for _, recipient := range recipients {
+				data, err := json.Marshal(worker.SendEmailPayload{
+					To:      recipient,
+					Subject: subject,
+					Body:    body,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := uc.queueSvc.Publish(txCtx, worker.SendEmailTopic, queueports.Message{
+					Body: data,
+				}); err != nil {
+					return fmt.Errorf("publish contract reminder: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func reminderDigest(reminders []*domain.Reminder, today time.Time) (string, string) {
+	subject := fmt.Sprintf("%d employment contract deadline(s) coming up", len(reminders))
+
+	var b strings.Builder
+	b.WriteString("The following deadlines fall due soon:\n\n")
+
+	for _, r := range reminders {
+		c := r.Contract
+		days := int(r.DueDate.Sub(today).Hours() / 24)
+
+		what := "Contract ends"
+		if r.Kind == domain.ReminderProbationEnd {
+			what = "Probation ends"
+		}
+
+		fmt.Fprintf(&b, "- %s %s (%s), contract %s: %s on %s, in %d day(s)",
+			c.EmployeeCode, c.EmployeeName, c.Type, c.Number,
+			what, r.DueDate.Format(time.DateOnly), days,
+		)
+
+		if r.Kind == domain.ReminderContractEnd &&
+			c.Type == domain.TypeFixedTerm &&
+			c.RenewalCount >= domain.MaxFixedTermRenewals {
+			b.WriteString(". Already renewed; the next contract must be indefinite")
+		}
+		b.WriteString("\n")
+	}
+
+	return subject, b.String()
+}
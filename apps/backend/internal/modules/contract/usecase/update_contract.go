@@ -0,0 +1,38 @@
+package contractusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+)
+
+type UpdateContractUsecase struct {
+	repo contractrepository.ContractRepository
+}
+
+func NewUpdateContractUsecase(repo contractrepository.ContractRepository) *UpdateContractUsecase {
+	return &UpdateContractUsecase{repo: repo}
+}
+
+func (uc *UpdateContractUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.ContractInput,
+) (*domain.Contract, error) {
+
+	contract, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := contract.Update(in); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+)
+
+type FieldType string
+
+const (
+	TypeText    FieldType = "TEXT"
+	TypeNumber  FieldType = "NUMBER"
+	TypeDate    FieldType = "DATE"
+	TypeBoolean FieldType = "BOOLEAN"
+	TypeSelect  FieldType = "SELECT"
+)
+
+var (
+	ErrInvalidKey          = errors.New("key must start with a lowercase letter and contain only lowercase letters, digits and underscores, at most 50 characters")
+	ErrLabelRequired       = errors.New("label is required")
+	ErrInvalidType         = errors.New("type must be TEXT, NUMBER, DATE, BOOLEAN or SELECT")
+	ErrOptionsRequired     = errors.New("select fields need at least one option")
+	ErrOptionsNotAllowed   = errors.New("only select fields have options")
+	ErrPatternNotAllowed   = errors.New("only text fields have a pattern")
+	ErrInvalidPattern      = errors.New("pattern is not a valid regular expression")
+	ErrRangeNotAllowed     = errors.New("only number fields have a minimum and maximum")
+	ErrInvalidRange        = errors.New("minimum must not exceed maximum")
+	ErrMaxLengthNotAllowed = errors.New("only text fields have a maximum length")
+	ErrInvalidRole         = errors.New("visible_to roles must be ADMIN, HR, MANAGER or EMPLOYEE")
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// Definition is a tenant-defined employee attribute. Its values live in
+// the employee's custom fields under Key.
+type Definition struct {
+	ID       string    `json:"id"`
+	TenantID string    `json:"tenant_id"`
+	Key      string    `json:"key"`
+	Label    string    `json:"label"`
+	Type     FieldType `json:"type"`
+	Required bool      `json:"required"`
+
+	// Options are the choices of a SELECT field.
+	Options []string `json:"options,omitempty"`
+	// Pattern and MaxLength constrain TEXT values.
+	Pattern   *string `json:"pattern,omitempty"`
+	MaxLength *int    `json:"max_length,omitempty"`
+	// Min and Max bound NUMBER values.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+
+	// VisibleTo lists the roles that see the field; empty means every
+	// role. Admins always see every field.
+	VisibleTo []userdomain.UserRole `json:"visible_to"`
+	Position  int                   `json:"position"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	pattern *regexp.Regexp
+}
+
+type DefinitionInput struct {
+	Key       string
+	Label     string
+	Type      FieldType
+	Required  bool
+	Options   []string
+	Pattern   *string
+	MaxLength *int
+	Min       *float64
+	Max       *float64
+	VisibleTo []userdomain.UserRole
+	Position  int
+}
+
+func NewDefinition(tenantID string, in DefinitionInput) (*Definition, error) {
+	if tenantID == "" {
+		return nil, errors.New("tenantID is required")
+	}
+	if !keyPattern.MatchString(in.Key) {
+		return nil, ErrInvalidKey
+	}
+
+	now := time.Now().UTC()
+	d := &Definition{
+		TenantID:  tenantID,
+		Key:       in.Key,
+		Type:      in.Type,
+		CreatedAt: now,
+	}
+	if err := d.apply(in); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Update changes everything but the key and the type, which stored
+// values depend on. Stricter rules apply to values written afterwards;
+// existing values are not re-checked.
+func (d *Definition) Update(in DefinitionInput) error {
+	in.Type = d.Type
+	return d.apply(in)
+}
+
+func (d *Definition) apply(in DefinitionInput) error {
+	label := strings.TrimSpace(in.Label)
+	if label == "" {
+		return ErrLabelRequired
+	}
+
+	switch in.Type {
+	case TypeText, TypeNumber, TypeDate, TypeBoolean, TypeSelect:
+	default:
+		return ErrInvalidType
+	}
+
+	options := make([]string, 0, len(in.Options))
+	for _, o := range in.Options {
+		if o = strings.TrimSpace(o); o != "" {
+			options = append(options, o)
+		}
+	}
+	if in.Type == TypeSelect && len(options) == 0 {
+		return ErrOptionsRequired
+	}
+	if in.Type != TypeSelect && len(options) > 0 {
+		return ErrOptionsNotAllowed
+	}
+
+	var pattern *regexp.Regexp
+	if in.Pattern != nil && *in.Pattern != "" {
+		if in.Type != TypeText {
+			return ErrPatternNotAllowed
+		}
+		compiled, err := regexp.Compile(*in.Pattern)
+		if err != nil {
+			return ErrInvalidPattern
+		}
+		pattern = compiled
+	} else {
+		in.Pattern = nil
+	}
+	if in.MaxLength != nil && in.Type != TypeText {
+		return ErrMaxLengthNotAllowed
+	}
+
+	if (in.Min != nil || in.Max != nil) && in.Type != TypeNumber {
+		return ErrRangeNotAllowed
+	}
+	if in.Min != nil && in.Max != nil && *in.Min > *in.Max {
+		return ErrInvalidRange
+	}
+
+	roles := make([]userdomain.UserRole, 0, len(in.VisibleTo))
+	for _, r := range in.VisibleTo {
+		switch r {
+		case userdomain.Admin, userdomain.HR, userdomain.Manager, userdomain.Employee:
+			roles = append(roles, r)
+		default:
+			return ErrInvalidRole
+		}
+	}
+
+	d.Label = label
+	d.Required = in.Required
+	d.Options = options
+	d.Pattern = in.Pattern
+	d.MaxLength = in.MaxLength
+	d.Min = in.Min
+	d.Max = in.Max
+	d.VisibleTo = roles
+	d.Position = in.Position
+	d.UpdatedAt = time.Now().UTC()
+	d.pattern = pattern
+
+	return nil
+}
+
+// Visible reports whether users with role see the field.
+func (d *Definition) Visible(role userdomain.UserRole) bool {
+	if role == userdomain.Admin || len(d.VisibleTo) == 0 {
+		return true
+	}
+	for _, r := range d.VisibleTo {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Definition) compiledPattern() *regexp.Regexp {
+	if d.pattern == nil && d.Pattern != nil {
+		d.pattern, _ = regexp.Compile(*d.Pattern)
+	}
+	return d.pattern
+}
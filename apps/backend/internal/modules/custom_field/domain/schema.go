@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+)
+
+// Prefix marks a custom field where it sits among built-in employee
+// attributes: in query parameters (custom.blood_type=O), export columns
+// and import headers.
+const Prefix = "custom."
+
+// Schema is the custom field definitions of a tenant.
+type Schema []*Definition
+
+// ValueError is a custom field value that does not fit its definition.
+type ValueError struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+func (e ValueError) Error() string {
+	return fmt.Sprintf("custom field %s: %s", e.Key, e.Message)
+}
+
+// ValueErrors collects every problem with a set of values.
+type ValueErrors []ValueError
+
+func (e ValueErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, v := range e {
+		msgs[i] = v.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (s Schema) Get(key string) (*Definition, bool) {
+	for _, d := range s {
+		if d.Key == key {
+			return d, true
+		}
+	}
+	return nil, false
+}
+
+// For returns the fields users with role see.
+func (s Schema) For(role userdomain.UserRole) Schema {
+	visible := Schema{}
+	for _, d := range s {
+		if d.Visible(role) {
+			visible = append(visible, d)
+		}
+	}
+	return visible
+}
+
+// Redact drops the values of fields outside the schema.
+func (s Schema) Redact(values map[string]any) map[string]any {
+	kept := make(map[string]any, len(values))
+	for key, v := range values {
+		if _, ok := s.Get(key); ok {
+			kept[key] = v
+		}
+	}
+	return kept
+}
+
+// ParseFilter normalizes filter values typed as text so that they
+// compare equal to the stored values, e.g. "Yes" to "true".
+func (s Schema) ParseFilter(filter map[string]string) (map[string]string, error) {
+	if len(filter) == 0 {
+		return nil, nil
+	}
+
+	parsed := make(map[string]string, len(filter))
+	var errs ValueErrors
+
+	for key, text := range filter {
+		d, ok := s.Get(key)
+		if !ok {
+			errs = append(errs, ValueError{Key: key, Message: "is not defined"})
+			continue
+		}
+		v, err := d.Parse(text)
+		if err != nil {
+			errs = append(errs, ValueError{Key: key, Message: err.Error()})
+			continue
+		}
+		parsed[key] = FormatValue(v)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return parsed, nil
+}
+
+// Validate checks the complete values of a new employee and returns them
+// normalized. Empty values are dropped.
+func (s Schema) Validate(values map[string]any) (map[string]any, error) {
+	return s.Merge(map[string]any{}, values, true)
+}
+
+// Merge applies changes to the current values; a nil or empty change
+// removes the value. Only the changed keys are checked unless complete
+// is set, so values stored before a definition was tightened do not
+// block unrelated edits.
+func (s Schema) Merge(current, changes map[string]any, complete bool) (map[string]any, error) {
+	merged := make(map[string]any, len(current)+len(changes))
+	for key, v := range current {
+		merged[key] = v
+	}
+
+	var errs ValueErrors
+
+	for key, v := range changes {
+		d, ok := s.Get(key)
+		if !ok {
+			errs = append(errs, ValueError{Key: key, Message: "is not defined"})
+			continue
+		}
+
+		normalized, err := d.Normalize(v)
+		if err != nil {
+			errs = append(errs, ValueError{Key: key, Message: err.Error()})
+			continue
+		}
+
+		if normalized == nil {
+			delete(merged, key)
+		} else {
+			merged[key] = normalized
+		}
+	}
+
+	for _, d := range s {
+		if !d.Required {
+			continue
+		}
+		if _, changed := changes[d.Key]; !changed && !complete {
+			continue
+		}
+		if _, ok := merged[d.Key]; !ok {
+			errs = append(errs, ValueError{Key: d.Key, Message: "is required"})
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return merged, nil
+}
+
+// Normalize checks a JSON value against the definition. It returns nil
+// for an empty value.
+func (d *Definition) Normalize(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch d.Type {
+	case TypeText:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be text")
+		}
+		if s = strings.TrimSpace(s); s == "" {
+			return nil, nil
+		}
+		if d.MaxLength != nil && utf8.RuneCountInString(s) > *d.MaxLength {
+			return nil, fmt.Errorf("must be at most %d characters", *d.MaxLength)
+		}
+		if p := d.compiledPattern(); p != nil && !p.MatchString(s) {
+			return nil, fmt.Errorf("does not match the expected format")
+		}
+		return s, nil
+
+	case TypeNumber:
+		n, ok := toFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("must be a number")
+		}
+		if d.Min != nil && n < *d.Min {
+			return nil, fmt.Errorf("must be at least %s", FormatValue(*d.Min))
+		}
+		if d.Max != nil && n > *d.Max {
+			return nil, fmt.Errorf("must be at most %s", FormatValue(*d.Max))
+		}
+		return n, nil
+
+	case TypeDate:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be a date in YYYY-MM-DD format")
+		}
+		if s = strings.TrimSpace(s); s == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("must be a date in YYYY-MM-DD format")
+		}
+		return t.Format(time.DateOnly), nil
+
+	case TypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("must be true or false")
+		}
+		return b, nil
+
+	case TypeSelect:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("must be one of %s", strings.Join(d.Options, ", "))
+		}
+		if s = strings.TrimSpace(s); s == "" {
+			return nil, nil
+		}
+		for _, o := range d.Options {
+			if strings.EqualFold(o, s) {
+				return o, nil
+			}
+		}
+		return nil, fmt.Errorf("must be one of %s", strings.Join(d.Options, ", "))
+
+	default:
+		return nil, ErrInvalidType
+	}
+}
+
+// Parse reads a value typed as text, as in a sheet cell or a query
+// string, and normalizes it.
+func (d *Definition) Parse(text string) (any, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, nil
+	}
+
+	switch d.Type {
+	case TypeNumber:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number")
+		}
+		return d.Normalize(n)
+
+	case TypeBoolean:
+		switch strings.ToLower(text) {
+		case "true", "yes", "y", "1", "x", "có", "co":
+			return true, nil
+		case "false", "no", "n", "0", "không", "khong":
+			return false, nil
+		default:
+			return nil, fmt.Errorf("must be true or false")
+		}
+
+	default:
+		return d.Normalize(text)
+	}
+}
+
+// FormatValue writes a stored value as text.
+func FormatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toFloat(v any) (float64, bool) {
+	var n float64
+	switch v := v.(type) {
+	case float64:
+		n = v
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		n = f
+	default:
+		return 0, false
+	}
+	return n, !math.IsNaN(n) && !math.IsInf(n, 0)
+}
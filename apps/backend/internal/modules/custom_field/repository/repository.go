@@ -0,0 +1,25 @@
+package customfieldrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+)
+
+var (
+	ErrDefinitionNotFound = errors.New("custom field not found")
+	ErrKeyExists          = errors.New("a custom field with this key already exists")
+)
+
+type DefinitionRepository interface {
+	Create(ctx context.Context, d *domain.Definition) error
+	Update(ctx context.Context, d *domain.Definition) error
+	// Delete removes the definition and its values from every employee
+	// of the tenant.
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.Definition, error)
+	// ListByTenantID returns the schema ordered by position.
+	ListByTenantID(ctx context.Context, tenantID string) (domain.Schema, error)
+}
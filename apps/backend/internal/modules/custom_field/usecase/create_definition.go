@@ -0,0 +1,34 @@
+package customfieldusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+)
+
+type CreateDefinitionUsecase struct {
+	repo customfieldrepository.DefinitionRepository
+}
+
+func NewCreateDefinitionUsecase(repo customfieldrepository.DefinitionRepository) *CreateDefinitionUsecase {
+	return &CreateDefinitionUsecase{repo: repo}
+}
+
+func (uc *CreateDefinitionUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	in domain.DefinitionInput,
+) (*domain.Definition, error) {
+
+	d, err := domain.NewDefinition(tenantID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,20 @@
+package customfieldusecase
+
+import (
+	"context"
+
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+)
+
+type DeleteDefinitionUsecase struct {
+	repo customfieldrepository.DefinitionRepository
+}
+
+func NewDeleteDefinitionUsecase(repo customfieldrepository.DefinitionRepository) *DeleteDefinitionUsecase {
+	return &DeleteDefinitionUsecase{repo: repo}
+}
+
+// Execute deletes the field together with its values on every employee.
+func (uc *DeleteDefinitionUsecase) Execute(ctx context.Context, id string) error {
+	return uc.repo.Delete(ctx, id)
+}
@@ -0,0 +1,48 @@
+package customfieldusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+type ListDefinitionsUsecase struct {
+	repo customfieldrepository.DefinitionRepository
+}
+
+func NewListDefinitionsUsecase(repo customfieldrepository.DefinitionRepository) *ListDefinitionsUsecase {
+	return &ListDefinitionsUsecase{repo: repo}
+}
+
+func (uc *ListDefinitionsUsecase) Execute(ctx context.Context, tenantID string) (domain.Schema, error) {
+	return uc.repo.ListByTenantID(ctx, tenantID)
+}
+
+type VisibleFieldsUsecase struct {
+	repo     customfieldrepository.DefinitionRepository
+	userRepo userrepository.UserRepository
+}
+
+func NewVisibleFieldsUsecase(
+	repo customfieldrepository.DefinitionRepository,
+	userRepo userrepository.UserRepository,
+) *VisibleFieldsUsecase {
+	return &VisibleFieldsUsecase{repo: repo, userRepo: userRepo}
+}
+
+// Execute returns the fields of the tenant the user's role sees.
+func (uc *VisibleFieldsUsecase) Execute(ctx context.Context, tenantID, userID string) (domain.Schema, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := uc.repo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.For(user.Role), nil
+}
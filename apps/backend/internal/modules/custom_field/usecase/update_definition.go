@@ -0,0 +1,38 @@
+package customfieldusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+)
+
+type UpdateDefinitionUsecase struct {
+	repo customfieldrepository.DefinitionRepository
+}
+
+func NewUpdateDefinitionUsecase(repo customfieldrepository.DefinitionRepository) *UpdateDefinitionUsecase {
+	return &UpdateDefinitionUsecase{repo: repo}
+}
+
+func (uc *UpdateDefinitionUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.DefinitionInput,
+) (*domain.Definition, error) {
+
+	d, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Update(in); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -2,14 +2,29 @@ package domain
 
 import (
 	"errors"
+	"regexp"
+	"strings"
 	"time"
 
 	empDomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
 )
 
+var (
+	ErrCostCenter        = errors.New("cost center must be 1-20 letters, digits or dashes")
+	ErrDepartmentCycle   = errors.New("a department cannot be moved under itself or one of its sub-departments")
+	ErrParentOtherTenant = errors.New("the parent department belongs to another tenant")
+)
+
+var costCenterPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]{0,19}$`)
+
 type Department struct {
-	ID             string              `json:"id"`
-	Name           string              `json:"name"`
+	ID       string  `json:"id"`
+	TenantID *string `json:"tenantId,omitempty"`
+	Name     string  `json:"name"`
+	// ParentID is the department this one sits under; nil at the top of
+	// the tree.
+	ParentID       *string             `json:"parentId,omitempty"`
+	CostCenter     *string             `json:"costCenter,omitempty"`
 	ManagerID      *string             `json:"managerId,omitempty"`
 	Manager        *empDomain.Employee `json:"manager,omitempty"`
 	TotalEmployees *int                `json:"totalEmployees,omitempty"`
@@ -37,3 +52,62 @@ func (d *Department) ChangeManager(managerID *string) {
 	d.ManagerID = managerID
 	d.UpdatedAt = time.Now().UTC()
 }
+
+// SetCostCenter stores the code in upper case; empty clears it.
+func (d *Department) SetCostCenter(code *string) error {
+	if code == nil || strings.TrimSpace(*code) == "" {
+		d.CostCenter = nil
+		return nil
+	}
+
+	c := strings.ToUpper(strings.TrimSpace(*code))
+	if !costCenterPattern.MatchString(c) {
+		return ErrCostCenter
+	}
+
+	d.CostCenter = &c
+	return nil
+}
+
+// CanSitUnder checks that parent, a department of the same tenant, may
+// hold d. subtree lists d's sub-departments at any depth.
+func (d *Department) CanSitUnder(parent *Department, subtree []*Descendant) error {
+	if parent.ID == d.ID {
+		return ErrDepartmentCycle
+	}
+	if !sameTenant(d.TenantID, parent.TenantID) {
+		return ErrParentOtherTenant
+	}
+	for _, s := range subtree {
+		if s.ID == parent.ID {
+			return ErrDepartmentCycle
+		}
+	}
+	return nil
+}
+
+func sameTenant(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Descendant is a department below another, Depth levels down.
+type Descendant struct {
+	*Department
+	Depth int `json:"depth"`
+}
+
+// Move re-parents a department, with everything below it, from
+// EffectiveFrom on. Employees keep their department, so their job history
+// is untouched; only the tree the department rolls up into changes.
+type Move struct {
+	ID            string    `json:"id"`
+	DepartmentID  string    `json:"departmentId"`
+	FromParentID  *string   `json:"fromParentId,omitempty"`
+	ToParentID    *string   `json:"toParentId,omitempty"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	ChangedBy     string    `json:"changedBy"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
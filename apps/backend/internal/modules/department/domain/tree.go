@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// Costs holds an amount per currency, as a tenant may pay in several.
+type Costs map[money.Currency]money.Money
+
+// Add adds m to the amount in its currency.
+func (c Costs) Add(m money.Money) {
+	// Same currency by construction, so Add cannot fail.
+	c[m.Currency()], _ = c[m.Currency()].WithCurrency(m.Currency()).Add(m)
+}
+
+func (c Costs) addAll(o Costs) {
+	for _, m := range o {
+		c.Add(m)
+	}
+}
+
+// Rollup is what is booked to each department itself, by department ID.
+type Rollup struct {
+	Headcount   map[string]int
+	PayrollCost map[string]Costs
+}
+
+// TreeNode is a department with its own figures and those of everything
+// below it.
+type TreeNode struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	ParentID   *string `json:"parentId,omitempty"`
+	CostCenter *string `json:"costCenter,omitempty"`
+	ManagerID  *string `json:"managerId,omitempty"`
+
+	Headcount        int   `json:"headcount"`
+	TotalHeadcount   int   `json:"totalHeadcount"`
+	PayrollCost      Costs `json:"payrollCost"`
+	TotalPayrollCost Costs `json:"totalPayrollCost"`
+
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+// BuildTree arranges departments under their parents, children by name,
+// and rolls the figures up to the roots. Departments whose parent is not
+// among them are roots, and so is one department of any loop.
+func BuildTree(departments []*Department, r Rollup) []*TreeNode {
+	nodes := make(map[string]*TreeNode, len(departments))
+	for _, d := range departments {
+		nodes[d.ID] = &TreeNode{
+			ID:               d.ID,
+			Name:             d.Name,
+			ParentID:         d.ParentID,
+			CostCenter:       d.CostCenter,
+			ManagerID:        d.ManagerID,
+			Headcount:        r.Headcount[d.ID],
+			PayrollCost:      Costs{},
+			TotalPayrollCost: Costs{},
+		}
+		nodes[d.ID].PayrollCost.addAll(r.PayrollCost[d.ID])
+	}
+
+	children := map[string][]*TreeNode{}
+	var roots []*TreeNode
+	for _, d := range departments {
+		if d.ParentID != nil && *d.ParentID != d.ID {
+			if _, ok := nodes[*d.ParentID]; ok {
+				children[*d.ParentID] = append(children[*d.ParentID], nodes[d.ID])
+				continue
+			}
+		}
+		roots = append(roots, nodes[d.ID])
+	}
+
+	visited := make(map[string]bool, len(departments))
+	var attach func(n *TreeNode)
+	attach = func(n *TreeNode) {
+		visited[n.ID] = true
+		n.TotalHeadcount = n.Headcount
+		n.TotalPayrollCost.addAll(n.PayrollCost)
+
+		for _, c := range children[n.ID] {
+			if visited[c.ID] {
+				continue
+			}
+			attach(c)
+			n.Children = append(n.Children, c)
+			n.TotalHeadcount += c.TotalHeadcount
+			n.TotalPayrollCost.addAll(c.TotalPayrollCost)
+		}
+		sortByName(n.Children)
+	}
+
+	for _, n := range roots {
+		attach(n)
+	}
+	// Departments still unvisited sit in a loop.
+	for _, d := range departments {
+		if !visited[d.ID] {
+			roots = append(roots, nodes[d.ID])
+			attach(nodes[d.ID])
+		}
+	}
+
+	sortByName(roots)
+	return roots
+}
+
+func sortByName(nodes []*TreeNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+}
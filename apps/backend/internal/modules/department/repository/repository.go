@@ -1,6 +1,17 @@
 package departmentrepository
 
-import domain "github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
+import (
+	"context"
+	"errors"
+	"time"
+
+	domain "github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
+)
+
+var (
+	ErrDepartmentNotFound = errors.New("department not found")
+	ErrCostCenterExists   = errors.New("cost center is already used by another department")
+)
 
 type DepartmentRepository interface {
 	Create(d *domain.Department) error
@@ -10,4 +21,19 @@ type DepartmentRepository interface {
 	FindByName(name string) (*domain.Department, error)
 
 	ListAll() ([]*domain.Department, error)
+
+	GetByID(ctx context.Context, id string) (*domain.Department, error)
+	// LockTree locks the tenant's departments for the rest of the
+	// transaction, so concurrent moves cannot close a loop.
+	LockTree(ctx context.Context, tenantID *string) error
+	// ListDescendants returns the departments below id at any depth,
+	// shallowest first.
+	ListDescendants(ctx context.Context, id string) ([]*domain.Descendant, error)
+	// ListOn returns the tenant's departments, each under the parent it
+	// had on day.
+	ListOn(ctx context.Context, tenantID string, day time.Time) ([]*domain.Department, error)
+
+	// Move re-parents the department and records the move.
+	Move(ctx context.Context, m *domain.Move) error
+	ListMoves(ctx context.Context, departmentID string) ([]*domain.Move, error)
 }
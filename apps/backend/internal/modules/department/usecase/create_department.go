@@ -15,10 +15,36 @@ func NewCreateDepartmentUsecase(repo departmentrepository.DepartmentRepository)
 	return &CreateDepartmentUsecase{repo: repo}
 }
 
-func (uc *CreateDepartmentUsecase) Execute(ctx context.Context, name string, managerID *string) (*domain.Department, error) {
-	newDep, err := domain.NewDepartment(name, managerID)
+type CreateDepartmentInput struct {
+	TenantID   *string
+	Name       string
+	ManagerID  *string
+	ParentID   *string
+	CostCenter *string
+}
+
+func (uc *CreateDepartmentUsecase) Execute(ctx context.Context, in CreateDepartmentInput) (*domain.Department, error) {
+	newDep, err := domain.NewDepartment(in.Name, in.ManagerID)
 	if err != nil {
 		return nil, err
 	}
+	newDep.TenantID = in.TenantID
+
+	if err := newDep.SetCostCenter(in.CostCenter); err != nil {
+		return nil, err
+	}
+
+	if in.ParentID != nil {
+		parent, err := uc.repo.GetByID(ctx, *in.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		// A new department has nothing below it yet.
+		if err := newDep.CanSitUnder(parent, nil); err != nil {
+			return nil, err
+		}
+		newDep.ParentID = &parent.ID
+	}
+
 	return newDep, uc.repo.Create(newDep)
 }
@@ -0,0 +1,135 @@
+package departmentusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type GetDepartmentTreeUsecase struct {
+	repo        departmentrepository.DepartmentRepository
+	jobRepo     employmentrepository.JobRecordRepository
+	runRepo     payrollrepository.PayrollRunRepository
+	payrollRepo payrollrepository.PayrollRepository
+}
+
+func NewGetDepartmentTreeUsecase(
+	repo departmentrepository.DepartmentRepository,
+	jobRepo employmentrepository.JobRecordRepository,
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+) *GetDepartmentTreeUsecase {
+	return &GetDepartmentTreeUsecase{
+		repo:        repo,
+		jobRepo:     jobRepo,
+		runRepo:     runRepo,
+		payrollRepo: payrollRepo,
+	}
+}
+
+// DepartmentTree is the tenant's departments with headcount and payroll
+// cost rolled up, as of AsOf.
+type DepartmentTree struct {
+	Period      string             `json:"period"`
+	AsOf        time.Time          `json:"asOf"`
+	Departments []*domain.TreeNode `json:"departments"`
+	// Unassigned counts what belongs to no department.
+	UnassignedHeadcount   int          `json:"unassignedHeadcount"`
+	UnassignedPayrollCost domain.Costs `json:"unassignedPayrollCost"`
+}
+
+// Execute builds the tree of the period's last day, or of today for the
+// current month. Employees count where their job placed them that day,
+// in the tree as it stood then, so later moves leave the figures of past
+// periods unchanged. Payroll cost is the employer cost of the period's
+// approved and paid runs, booked to the department each paid employee
+// held that day.
+func (uc *GetDepartmentTreeUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	period string,
+	today time.Time,
+) (*DepartmentTree, error) {
+
+	start, err := payrolldomain.ParsePeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	day := start.AddDate(0, 1, -1)
+	if today.Before(day) {
+		y, m, d := today.Date()
+		day = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	departments, err := uc.repo.ListOn(ctx, tenantID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := uc.jobRepo.ListOn(ctx, tenantID, day)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &DepartmentTree{
+		Period:                period,
+		AsOf:                  day,
+		UnassignedPayrollCost: domain.Costs{},
+	}
+	rollup := domain.Rollup{
+		Headcount:   map[string]int{},
+		PayrollCost: map[string]domain.Costs{},
+	}
+
+	placedIn := make(map[string]*string, len(jobs))
+	for _, j := range jobs {
+		placedIn[j.EmployeeID] = j.DepartmentID
+		if !j.EmploymentStatus.Employed() {
+			continue
+		}
+		if j.DepartmentID == nil {
+			tree.UnassignedHeadcount++
+			continue
+		}
+		rollup.Headcount[*j.DepartmentID]++
+	}
+
+	runs, err := uc.runRepo.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		if run.Period != period ||
+			(run.Status != payrolldomain.RunApproved && run.Status != payrolldomain.RunPaid) {
+			continue
+		}
+
+		records, err := uc.payrollRepo.ListByRunID(ctx, run.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			deptID := placedIn[r.EmployeeID]
+			if deptID == nil {
+				tree.UnassignedPayrollCost.Add(r.EmployerCost())
+				continue
+			}
+			if rollup.PayrollCost[*deptID] == nil {
+				rollup.PayrollCost[*deptID] = domain.Costs{}
+			}
+			rollup.PayrollCost[*deptID].Add(r.EmployerCost())
+		}
+	}
+
+	tree.Departments = domain.BuildTree(departments, rollup)
+	if tree.Departments == nil {
+		tree.Departments = []*domain.TreeNode{}
+	}
+
+	return tree, nil
+}
@@ -0,0 +1,76 @@
+package departmentusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type MoveDepartmentUsecase struct {
+	repo      departmentrepository.DepartmentRepository
+	txManager txpkg.Manager
+}
+
+func NewMoveDepartmentUsecase(
+	repo departmentrepository.DepartmentRepository,
+	txManager txpkg.Manager,
+) *MoveDepartmentUsecase {
+	return &MoveDepartmentUsecase{repo: repo, txManager: txManager}
+}
+
+// Execute moves the department, and everything below it, under parentID
+// from today on; nil makes it a top-level department. Moves are never
+// backdated, so rollups of past periods keep the tree they had.
+func (uc *MoveDepartmentUsecase) Execute(
+	ctx context.Context,
+	id string,
+	parentID *string,
+	changedBy string,
+) (*domain.Move, error) {
+
+	var move *domain.Move
+
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		d, err := uc.repo.GetByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.LockTree(txCtx, d.TenantID); err != nil {
+			return err
+		}
+
+		if parentID != nil {
+			parent, err := uc.repo.GetByID(txCtx, *parentID)
+			if err != nil {
+				return err
+			}
+			subtree, err := uc.repo.ListDescendants(txCtx, d.ID)
+			if err != nil {
+				return err
+			}
+			if err := d.CanSitUnder(parent, subtree); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		y, m, day := now.Date()
+		move = &domain.Move{
+			DepartmentID:  d.ID,
+			FromParentID:  d.ParentID,
+			ToParentID:    parentID,
+			EffectiveFrom: time.Date(y, m, day, 0, 0, 0, 0, time.UTC),
+			ChangedBy:     changedBy,
+			CreatedAt:     now,
+		}
+		return uc.repo.Move(txCtx, move)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return move, nil
+}
@@ -17,10 +17,15 @@ func NewUpdateDepartmentUsecase(repo departmentrepository.DepartmentRepository)
 	return &UpdateDepartmentUsecase{repo: repo}
 }
 
+// Execute saves the name, manager and cost center; the parent changes
+// only by moving the department.
 func (uc *UpdateDepartmentUsecase) Execute(ctx context.Context, d *domain.Department) error {
 	if d.Name == "" {
 		return errors.New("name required")
 	}
+	if err := d.SetCostCenter(d.CostCenter); err != nil {
+		return err
+	}
 
 	d.UpdatedAt = time.Now().UTC()
 	return uc.repo.Update(d)
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+type Relationship string
+
+const (
+	RelationshipChild  Relationship = "CHILD"
+	RelationshipSpouse Relationship = "SPOUSE"
+	RelationshipParent Relationship = "PARENT"
+	RelationshipOther  Relationship = "OTHER"
+)
+
+var (
+	ErrNameRequired        = errors.New("full name is required")
+	ErrInvalidRelationship = errors.New("relationship must be CHILD, SPOUSE, PARENT or OTHER")
+	ErrDateOfBirth         = errors.New("date of birth is required and cannot be in the future")
+	ErrRegisteredFrom      = errors.New("registration must start on or after the date of birth")
+	ErrRegisteredUntil     = errors.New("registration cannot end before it starts")
+)
+
+// Dependent is someone the employee supports, registered for the family
+// allowance from RegisteredFrom until RegisteredUntil, both inclusive.
+// Dates are UTC midnight; RegisteredUntil is nil while still registered.
+type Dependent struct {
+	ID           string       `json:"id"`
+	EmployeeID   string       `json:"employee_id"`
+	FullName     string       `json:"full_name"`
+	Relationship Relationship `json:"relationship"`
+	DateOfBirth  time.Time    `json:"date_of_birth"`
+	TaxCode      *string      `json:"tax_code,omitempty"`
+
+	RegisteredFrom  time.Time  `json:"registered_from"`
+	RegisteredUntil *time.Time `json:"registered_until,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DependentInput struct {
+	FullName        string
+	Relationship    Relationship
+	DateOfBirth     time.Time
+	TaxCode         *string
+	RegisteredFrom  time.Time
+	RegisteredUntil *time.Time
+}
+
+func NewDependent(employeeID string, in DependentInput, now time.Time) (*Dependent, error) {
+	if employeeID == "" {
+		return nil, errors.New("employeeID is required")
+	}
+
+	d := &Dependent{
+		EmployeeID: employeeID,
+		CreatedAt:  now,
+	}
+
+	if err := d.Update(in, now); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *Dependent) Update(in DependentInput, now time.Time) error {
+	name := strings.TrimSpace(in.FullName)
+	if name == "" {
+		return ErrNameRequired
+	}
+
+	switch in.Relationship {
+	case RelationshipChild, RelationshipSpouse, RelationshipParent, RelationshipOther:
+	default:
+		return ErrInvalidRelationship
+	}
+
+	born := day(in.DateOfBirth)
+	if in.DateOfBirth.IsZero() || born.After(now) {
+		return ErrDateOfBirth
+	}
+
+	from := day(in.RegisteredFrom)
+	if in.RegisteredFrom.IsZero() || from.Before(born) {
+		return ErrRegisteredFrom
+	}
+
+	var until *time.Time
+	if in.RegisteredUntil != nil {
+		u := day(*in.RegisteredUntil)
+		if u.Before(from) {
+			return ErrRegisteredUntil
+		}
+		until = &u
+	}
+
+	var taxCode *string
+	if in.TaxCode != nil && strings.TrimSpace(*in.TaxCode) != "" {
+		code, err := statutorydomain.NormalizeTaxCode(*in.TaxCode)
+		if err != nil {
+			return err
+		}
+		taxCode = &code
+	}
+
+	d.FullName = name
+	d.Relationship = in.Relationship
+	d.DateOfBirth = born
+	d.TaxCode = taxCode
+	d.RegisteredFrom = from
+	d.RegisteredUntil = until
+	d.UpdatedAt = now
+	return nil
+}
+
+// RegisteredIn reports whether the dependent is registered on any day of
+// the month starting monthStart.
+func (d *Dependent) RegisteredIn(monthStart time.Time) bool {
+	first := day(monthStart)
+	last := first.AddDate(0, 1, -1)
+
+	return !d.RegisteredFrom.After(last) &&
+		(d.RegisteredUntil == nil || !d.RegisteredUntil.Before(first))
+}
+
+// EligibleIn counts the dependents registered in the month starting
+// monthStart.
+func EligibleIn(dependents []*Dependent, monthStart time.Time) int {
+	n := 0
+	for _, d := range dependents {
+		if d.RegisteredIn(monthStart) {
+			n++
+		}
+	}
+	return n
+}
+
+// YearCounts returns, for the year, how many dependents were registered
+// in any of its months and the sum over its months of those registered
+// in each, the basis of an annual allowance granted per dependent-month.
+func YearCounts(dependents []*Dependent, year int) (registered, months int) {
+	for _, d := range dependents {
+		counted := false
+		for m := time.January; m <= time.December; m++ {
+			if d.RegisteredIn(time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)) {
+				months++
+				counted = true
+			}
+		}
+		if counted {
+			registered++
+		}
+	}
+	return registered, months
+}
+
+func day(t time.Time) time.Time {
+	y, m, dd := t.Date()
+	return time.Date(y, m, dd, 0, 0, 0, 0, time.UTC)
+}
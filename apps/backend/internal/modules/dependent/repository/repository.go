@@ -0,0 +1,21 @@
+package dependentrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/dependent/domain"
+)
+
+var ErrDependentNotFound = errors.New("dependent not found")
+
+type DependentRepository interface {
+	Create(ctx context.Context, d *domain.Dependent) error
+	Update(ctx context.Context, d *domain.Dependent) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.Dependent, error)
+	// ListByEmployeeID returns the employee's dependents, earliest
+	// registration first.
+	ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Dependent, error)
+}
@@ -0,0 +1,101 @@
+package dependentusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/dependent/domain"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+)
+
+type CreateDependentUsecase struct {
+	repo dependentrepository.DependentRepository
+}
+
+func NewCreateDependentUsecase(repo dependentrepository.DependentRepository) *CreateDependentUsecase {
+	return &CreateDependentUsecase{repo: repo}
+}
+
+func (uc *CreateDependentUsecase) Execute(
+	ctx context.Context,
+	employeeID string,
+	in domain.DependentInput,
+) (*domain.Dependent, error) {
+
+	d, err := domain.NewDependent(employeeID, in, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+type UpdateDependentUsecase struct {
+	repo dependentrepository.DependentRepository
+}
+
+func NewUpdateDependentUsecase(repo dependentrepository.DependentRepository) *UpdateDependentUsecase {
+	return &UpdateDependentUsecase{repo: repo}
+}
+
+// Execute replaces the dependent's details. Ending a registration is an
+// update setting RegisteredUntil; months before it still count.
+func (uc *UpdateDependentUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.DependentInput,
+) (*domain.Dependent, error) {
+
+	d, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Update(in, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+type DeleteDependentUsecase struct {
+	repo dependentrepository.DependentRepository
+}
+
+func NewDeleteDependentUsecase(repo dependentrepository.DependentRepository) *DeleteDependentUsecase {
+	return &DeleteDependentUsecase{repo: repo}
+}
+
+// Execute removes a dependent registered by mistake; payroll already
+// calculated keeps the count it used.
+func (uc *DeleteDependentUsecase) Execute(ctx context.Context, id string) error {
+	return uc.repo.Delete(ctx, id)
+}
+
+type ListDependentsUsecase struct {
+	repo dependentrepository.DependentRepository
+}
+
+func NewListDependentsUsecase(repo dependentrepository.DependentRepository) *ListDependentsUsecase {
+	return &ListDependentsUsecase{repo: repo}
+}
+
+func (uc *ListDependentsUsecase) Execute(ctx context.Context, employeeID string) ([]*domain.Dependent, error) {
+	dependents, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if dependents == nil {
+		dependents = []*domain.Dependent{}
+	}
+
+	return dependents, nil
+}
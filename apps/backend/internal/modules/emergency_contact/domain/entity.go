@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+)
+
+const (
+	// MaxPriority bounds the order in which contacts are called.
+	MaxPriority = 9
+	// MaxContacts is how many contacts an employee may keep.
+	MaxContacts = 5
+)
+
+var (
+	ErrNameRequired         = errors.New("full name is required")
+	ErrRelationshipRequired = errors.New("relationship is required")
+	ErrEmail                = errors.New("email is not a valid address")
+	ErrPriority             = errors.New("priority must be between 1 and 9")
+	ErrTooManyContacts      = errors.New("an employee can keep at most 5 emergency contacts")
+	ErrNoEmployee           = errors.New("the user is not linked to an employee")
+)
+
+// EmergencyContact is someone to call when something happens to the
+// employee, lowest Priority first.
+type EmergencyContact struct {
+	ID           string  `json:"id"`
+	EmployeeID   string  `json:"employee_id"`
+	FullName     string  `json:"full_name"`
+	Relationship string  `json:"relationship"`
+	Phone        string  `json:"phone"`
+	Email        *string `json:"email,omitempty"`
+	Address      *string `json:"address,omitempty"`
+	Priority     int     `json:"priority"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EmergencyContactInput describes a contact; a zero Priority means 1.
+type EmergencyContactInput struct {
+	FullName     string
+	Relationship string
+	Phone        string
+	Email        *string
+	Address      *string
+	Priority     int
+}
+
+func NewEmergencyContact(employeeID string, in EmergencyContactInput) (*EmergencyContact, error) {
+	if employeeID == "" {
+		return nil, errors.New("employeeID is required")
+	}
+
+	c := &EmergencyContact{
+		EmployeeID: employeeID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	if err := c.Update(in); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *EmergencyContact) Update(in EmergencyContactInput) error {
+	name := strings.TrimSpace(in.FullName)
+	if name == "" {
+		return ErrNameRequired
+	}
+
+	relationship := strings.TrimSpace(in.Relationship)
+	if relationship == "" {
+		return ErrRelationshipRequired
+	}
+
+	phone, err := employeedomain.NormalizePhone(in.Phone)
+	if err != nil {
+		return err
+	}
+
+	email := trimmed(in.Email)
+	if email != nil {
+		if _, err := mail.ParseAddress(*email); err != nil {
+			return ErrEmail
+		}
+	}
+
+	priority := in.Priority
+	if priority == 0 {
+		priority = 1
+	}
+	if priority < 1 || priority > MaxPriority {
+		return ErrPriority
+	}
+
+	c.FullName = name
+	c.Relationship = relationship
+	c.Phone = phone
+	c.Email = email
+	c.Address = trimmed(in.Address)
+	c.Priority = priority
+	c.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func trimmed(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	return &t
+}
@@ -0,0 +1,20 @@
+package emergencycontactrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/domain"
+)
+
+var ErrEmergencyContactNotFound = errors.New("emergency contact not found")
+
+type EmergencyContactRepository interface {
+	Create(ctx context.Context, c *domain.EmergencyContact) error
+	Update(ctx context.Context, c *domain.EmergencyContact) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.EmergencyContact, error)
+	// ListByEmployeeID returns the employee's contacts in calling order.
+	ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.EmergencyContact, error)
+}
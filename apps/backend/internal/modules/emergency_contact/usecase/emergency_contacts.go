@@ -0,0 +1,104 @@
+package emergencycontactusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/domain"
+	emergencycontactrepository "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/repository"
+)
+
+type CreateEmergencyContactUsecase struct {
+	repo emergencycontactrepository.EmergencyContactRepository
+}
+
+func NewCreateEmergencyContactUsecase(repo emergencycontactrepository.EmergencyContactRepository) *CreateEmergencyContactUsecase {
+	return &CreateEmergencyContactUsecase{repo: repo}
+}
+
+func (uc *CreateEmergencyContactUsecase) Execute(
+	ctx context.Context,
+	employeeID string,
+	in domain.EmergencyContactInput,
+) (*domain.EmergencyContact, error) {
+
+	c, err := domain.NewEmergencyContact(employeeID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxContacts {
+		return nil, domain.ErrTooManyContacts
+	}
+
+	if err := uc.repo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type UpdateEmergencyContactUsecase struct {
+	repo emergencycontactrepository.EmergencyContactRepository
+}
+
+func NewUpdateEmergencyContactUsecase(repo emergencycontactrepository.EmergencyContactRepository) *UpdateEmergencyContactUsecase {
+	return &UpdateEmergencyContactUsecase{repo: repo}
+}
+
+func (uc *UpdateEmergencyContactUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in domain.EmergencyContactInput,
+) (*domain.EmergencyContact, error) {
+
+	c, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Update(in); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+type DeleteEmergencyContactUsecase struct {
+	repo emergencycontactrepository.EmergencyContactRepository
+}
+
+func NewDeleteEmergencyContactUsecase(repo emergencycontactrepository.EmergencyContactRepository) *DeleteEmergencyContactUsecase {
+	return &DeleteEmergencyContactUsecase{repo: repo}
+}
+
+func (uc *DeleteEmergencyContactUsecase) Execute(ctx context.Context, id string) error {
+	return uc.repo.Delete(ctx, id)
+}
+
+type ListEmergencyContactsUsecase struct {
+	repo emergencycontactrepository.EmergencyContactRepository
+}
+
+func NewListEmergencyContactsUsecase(repo emergencycontactrepository.EmergencyContactRepository) *ListEmergencyContactsUsecase {
+	return &ListEmergencyContactsUsecase{repo: repo}
+}
+
+func (uc *ListEmergencyContactsUsecase) Execute(ctx context.Context, employeeID string) ([]*domain.EmergencyContact, error) {
+	contacts, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if contacts == nil {
+		contacts = []*domain.EmergencyContact{}
+	}
+
+	return contacts, nil
+}
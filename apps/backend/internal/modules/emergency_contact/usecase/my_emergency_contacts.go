@@ -0,0 +1,145 @@
+package emergencycontactusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/domain"
+	emergencycontactrepository "github.com/smart-hmm/smart-hmm/internal/modules/emergency_contact/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+// The usecases below let employees keep their own contacts; the user
+// must be linked to an employee and may only touch that employee's
+// contacts. Others' contacts are reported as not found.
+
+type ListMyEmergencyContactsUsecase struct {
+	listUC   *ListEmergencyContactsUsecase
+	userRepo userrepository.UserRepository
+}
+
+func NewListMyEmergencyContactsUsecase(
+	listUC *ListEmergencyContactsUsecase,
+	userRepo userrepository.UserRepository,
+) *ListMyEmergencyContactsUsecase {
+	return &ListMyEmergencyContactsUsecase{listUC: listUC, userRepo: userRepo}
+}
+
+func (uc *ListMyEmergencyContactsUsecase) Execute(ctx context.Context, userID string) ([]*domain.EmergencyContact, error) {
+	employeeID, err := ownEmployeeID(uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.listUC.Execute(ctx, employeeID)
+}
+
+type CreateMyEmergencyContactUsecase struct {
+	createUC *CreateEmergencyContactUsecase
+	userRepo userrepository.UserRepository
+}
+
+func NewCreateMyEmergencyContactUsecase(
+	createUC *CreateEmergencyContactUsecase,
+	userRepo userrepository.UserRepository,
+) *CreateMyEmergencyContactUsecase {
+	return &CreateMyEmergencyContactUsecase{createUC: createUC, userRepo: userRepo}
+}
+
+func (uc *CreateMyEmergencyContactUsecase) Execute(
+	ctx context.Context,
+	userID string,
+	in domain.EmergencyContactInput,
+) (*domain.EmergencyContact, error) {
+
+	employeeID, err := ownEmployeeID(uc.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.createUC.Execute(ctx, employeeID, in)
+}
+
+type UpdateMyEmergencyContactUsecase struct {
+	repo     emergencycontactrepository.EmergencyContactRepository
+	updateUC *UpdateEmergencyContactUsecase
+	userRepo userrepository.UserRepository
+}
+
+func NewUpdateMyEmergencyContactUsecase(
+	repo emergencycontactrepository.EmergencyContactRepository,
+	updateUC *UpdateEmergencyContactUsecase,
+	userRepo userrepository.UserRepository,
+) *UpdateMyEmergencyContactUsecase {
+	return &UpdateMyEmergencyContactUsecase{repo: repo, updateUC: updateUC, userRepo: userRepo}
+}
+
+func (uc *UpdateMyEmergencyContactUsecase) Execute(
+	ctx context.Context,
+	userID, id string,
+	in domain.EmergencyContactInput,
+) (*domain.EmergencyContact, error) {
+
+	if err := ownContact(ctx, uc.repo, uc.userRepo, userID, id); err != nil {
+		return nil, err
+	}
+
+	return uc.updateUC.Execute(ctx, id, in)
+}
+
+type DeleteMyEmergencyContactUsecase struct {
+	repo     emergencycontactrepository.EmergencyContactRepository
+	deleteUC *DeleteEmergencyContactUsecase
+	userRepo userrepository.UserRepository
+}
+
+func NewDeleteMyEmergencyContactUsecase(
+	repo emergencycontactrepository.EmergencyContactRepository,
+	deleteUC *DeleteEmergencyContactUsecase,
+	userRepo userrepository.UserRepository,
+) *DeleteMyEmergencyContactUsecase {
+	return &DeleteMyEmergencyContactUsecase{repo: repo, deleteUC: deleteUC, userRepo: userRepo}
+}
+
+func (uc *DeleteMyEmergencyContactUsecase) Execute(ctx context.Context, userID, id string) error {
+	if err := ownContact(ctx, uc.repo, uc.userRepo, userID, id); err != nil {
+		return err
+	}
+
+	return uc.deleteUC.Execute(ctx, id)
+}
+
+func ownEmployeeID(userRepo userrepository.UserRepository, userID string) (string, error) {
+	user, err := userRepo.FindByID(userID)
+	if err != nil {
+		return "", fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.EmployeeID == nil {
+		return "", domain.ErrNoEmployee
+	}
+
+	return *user.EmployeeID, nil
+}
+
+func ownContact(
+	ctx context.Context,
+	repo emergencycontactrepository.EmergencyContactRepository,
+	userRepo userrepository.UserRepository,
+	userID, id string,
+) error {
+
+	employeeID, err := ownEmployeeID(userRepo, userID)
+	if err != nil {
+		return err
+	}
+
+	c, err := repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if c.EmployeeID != employeeID {
+		return emergencycontactrepository.ErrEmergencyContactNotFound
+	}
+
+	return nil
+}
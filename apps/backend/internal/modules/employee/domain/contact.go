@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrPhone = errors.New("phone must be 6-20 digits, spaces or dashes, optionally starting with +")
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 -]{4,18}[0-9]$`)
+
+// NormalizePhone collapses runs of spaces and checks the number.
+func NormalizePhone(phone string) (string, error) {
+	phone = strings.Join(strings.Fields(phone), " ")
+	if !phonePattern.MatchString(phone) {
+		return "", ErrPhone
+	}
+	return phone, nil
+}
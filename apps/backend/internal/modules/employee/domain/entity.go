@@ -3,6 +3,8 @@ package domain
 import (
 	"errors"
 	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
 )
 
 type EmploymentType string
@@ -16,19 +18,37 @@ const (
 )
 
 const (
-	Active   EmploymentStatus = "ACTIVE"
-	Inactive EmploymentStatus = "INACTIVE"
-	Resigned EmploymentStatus = "RESIGNED"
+	Active    EmploymentStatus = "ACTIVE"
+	Probation EmploymentStatus = "PROBATION"
+	Inactive  EmploymentStatus = "INACTIVE"
+	Resigned  EmploymentStatus = "RESIGNED"
+)
+
+// Employed reports whether the status counts as on the payroll.
+func (s EmploymentStatus) Employed() bool {
+	return s == Active || s == Probation
+}
+
+// MaxProbationDays is the longest probation the Labor Code allows for
+// roles requiring a college degree; longer probations of managers are
+// agreed in their contract.
+const MaxProbationDays = 60
+
+var (
+	ErrCustomFieldsWithoutTenant = errors.New("custom fields need a tenant")
+	ErrProbationPeriod           = errors.New("probation must end after the join date and within 60 days of it")
 )
 
 type Employee struct {
-	ID   string `json:"id"`
-	Code string `json:"code,omitempty"`
+	ID       string `json:"id"`
+	TenantID string `json:"tenantId,omitempty"`
+	Code     string `json:"code,omitempty"`
 
 	FirstName   string     `json:"firstName,omitempty"`
 	LastName    string     `json:"lastName,omitempty"`
 	Email       string     `json:"email,omitempty"`
 	Phone       string     `json:"phone,omitempty"`
+	Address     *string    `json:"address,omitempty"`
 	DateOfBirth *time.Time `json:"dateOfBirth,omitempty"`
 
 	DepartmentID *string `json:"departmentID,omitempty"`
@@ -38,8 +58,14 @@ type Employee struct {
 	EmploymentType   EmploymentType   `json:"employmentType,omitempty"`
 	EmploymentStatus EmploymentStatus `json:"employmentStatus,omitempty"`
 	JoinDate         time.Time        `json:"joinDate"`
+	// ProbationEndDate is the last day of probation, UTC midnight; nil
+	// when the employee was hired without one.
+	ProbationEndDate *time.Time `json:"probationEndDate,omitempty"`
+
+	BaseSalary money.Money `json:"baseSalary"`
 
-	BaseSalary float64 `json:"baseSalary,omitempty"`
+	// CustomFields holds the values of the tenant's custom fields by key.
+	CustomFields map[string]any `json:"customFields"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -47,11 +73,11 @@ type Employee struct {
 	DepartmentName *string `json:"departmentName,omitempty"`
 }
 
-func NewEmployee(code, firstName, lastName, email, phone, position string, base float64) (*Employee, error) {
+func NewEmployee(code, firstName, lastName, email, phone, position string, base money.Money) (*Employee, error) {
 	if code == "" || firstName == "" || email == "" {
 		return nil, errors.New("missing required fields")
 	}
-	if base < 0 {
+	if base.IsNegative() {
 		return nil, errors.New("base salary cannot be negative")
 	}
 
@@ -66,12 +92,29 @@ func NewEmployee(code, firstName, lastName, email, phone, position string, base
 		JoinDate:   now,
 		Position:   position,
 		Phone:      phone,
-		CreatedAt:  now,
-		UpdatedAt:  now,
+
+		EmploymentType:   FullTime,
+		EmploymentStatus: Active,
+		CustomFields:     map[string]any{},
+
+		CreatedAt: now,
+		UpdatedAt: now,
 	}, nil
 }
 
-func (e *Employee) UpdatePosition(pos string) {
-	e.Position = pos
-	e.UpdatedAt = time.Now().UTC()
+// SetProbation puts a new hire on probation until end, inclusive.
+func (e *Employee) SetProbation(end time.Time) error {
+	y, m, d := end.Date()
+	last := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	jy, jm, jd := e.JoinDate.Date()
+	joined := time.Date(jy, jm, jd, 0, 0, 0, 0, time.UTC)
+
+	if !last.After(joined) || last.After(joined.AddDate(0, 0, MaxProbationDays)) {
+		return ErrProbationPeriod
+	}
+
+	e.ProbationEndDate = &last
+	e.EmploymentStatus = Probation
+	return nil
 }
@@ -0,0 +1,14 @@
+package domain
+
+// Filter selects employees of a tenant. Name, email and code match
+// partially and any of them may match; departments and custom field
+// values narrow the result. Custom field values match whole and ignore
+// case.
+type Filter struct {
+	TenantID      string            `json:"tenantId"`
+	Name          string            `json:"name,omitempty"`
+	Email         string            `json:"email,omitempty"`
+	Code          string            `json:"code,omitempty"`
+	DepartmentIDs []string          `json:"departmentIds,omitempty"`
+	CustomFields  map[string]string `json:"customFields,omitempty"`
+}
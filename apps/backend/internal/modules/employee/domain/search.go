@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"errors"
+	"html"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// SearchMinLength is the shortest query searched; shorter ones match
+	// too much to rank usefully.
+	SearchMinLength = 2
+	SearchMaxLength = 100
+	// SuggestLimit caps type-ahead suggestions.
+	SuggestLimit = 20
+)
+
+var ErrSearchQuery = errors.New("search query must be 2-100 characters")
+
+// SearchQuery is a free-text lookup across name, email, code and phone.
+// Case and Vietnamese diacritics are ignored, and words may be misspelt.
+type SearchQuery struct {
+	TenantID string
+	Text     string
+	Offset   int
+	Limit    int
+}
+
+// SearchHit is an employee found by a search, best matches first.
+type SearchHit struct {
+	ID               string           `json:"id"`
+	Code             string           `json:"code"`
+	Name             string           `json:"name"`
+	Email            string           `json:"email"`
+	Phone            string           `json:"phone,omitempty"`
+	Position         string           `json:"position"`
+	DepartmentName   *string          `json:"departmentName,omitempty"`
+	EmploymentStatus EmploymentStatus `json:"employmentStatus"`
+	Score            float64          `json:"score"`
+
+	// Highlights holds, by field, the HTML-escaped value with the query
+	// words wrapped in <mark>. Fields matched only fuzzily are left out.
+	Highlights map[string]string `json:"highlights,omitempty"`
+}
+
+// NormalizeSearch trims the query and checks its length.
+func NormalizeSearch(text string) (string, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	if n := len([]rune(text)); n < SearchMinLength || n > SearchMaxLength {
+		return "", ErrSearchQuery
+	}
+	return text, nil
+}
+
+// Highlight fills the hit's highlights for the query words.
+func (h *SearchHit) Highlight(query string) {
+	fields := map[string]string{
+		"name":  h.Name,
+		"email": h.Email,
+		"code":  h.Code,
+		"phone": h.Phone,
+	}
+
+	words := strings.Fields(string(fold(query)))
+	for field, value := range fields {
+		if marked, ok := highlight(value, words); ok {
+			if h.Highlights == nil {
+				h.Highlights = map[string]string{}
+			}
+			h.Highlights[field] = marked
+		}
+	}
+}
+
+// highlight wraps the occurrences of words in value with <mark>,
+// comparing folded text so the original spelling is kept.
+func highlight(value string, words []string) (string, bool) {
+	original := []rune(value)
+	folded := fold(value)
+	marked := make([]bool, len(original))
+
+	found := false
+	for _, w := range words {
+		word := []rune(w)
+		for i := 0; i+len(word) <= len(folded); i++ {
+			if string(folded[i:i+len(word)]) == w {
+				for j := i; j < i+len(word); j++ {
+					marked[j] = true
+				}
+				found = true
+			}
+		}
+	}
+	if !found {
+		return "", false
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(original); {
+		j := i
+		for j < len(original) && marked[j] == marked[i] {
+			j++
+		}
+		segment := html.EscapeString(string(original[i:j]))
+		if marked[i] {
+			b.WriteString("<mark>" + segment + "</mark>")
+		} else {
+			b.WriteString(segment)
+		}
+		i = j
+	}
+
+	return b.String(), true
+}
+
+// fold lower-cases s and strips diacritics rune by rune, like the
+// database's unaccent, so folded and original runes line up.
+func fold(s string) []rune {
+	runes := []rune(s)
+	out := make([]rune, len(runes))
+	for i, r := range runes {
+		switch r {
+		case 'đ', 'Đ':
+			out[i] = 'd'
+			continue
+		}
+		base := []rune(norm.NFD.String(string(r)))
+		out[i] = unicode.ToLower(base[0])
+	}
+	return out
+}
@@ -1,17 +1,33 @@
 package employeerepository
 
-import domain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+import (
+	"context"
+
+	domain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+)
 
 type EmployeeRepository interface {
-	Create(e *domain.Employee) (string, error)
+	Create(ctx context.Context, e *domain.Employee) (string, error)
 	Update(e *domain.Employee) error
+	// UpdateContact writes the phone and address only.
+	UpdateContact(ctx context.Context, e *domain.Employee) error
 	Delete(id string) error
 
-	Find(tenantId, name, email, code string, departmentIds []string, page, limit int) ([]*domain.Employee, int, int, error)
+	Find(f domain.Filter, page, limit int) ([]*domain.Employee, int, int, error)
 	FindByID(id string) (*domain.Employee, error)
 	FindByEmail(email string) (*domain.Employee, error)
 	FindByCode(code string) (*domain.Employee, error)
 
+	Count(ctx context.Context, f domain.Filter) (int, error)
+	// Each calls fn for every employee matching f, in code order, and
+	// stops at the first error fn returns.
+	Each(ctx context.Context, f domain.Filter, fn func(*domain.Employee) error) error
+
 	ListAll() ([]*domain.Employee, error)
 	ListByDepartment(deptID string) ([]*domain.Employee, error)
+	ListActiveByTenant(ctx context.Context, tenantID string) ([]*domain.Employee, error)
+
+	// Search returns a page of the tenant's employees matching q, best
+	// first, and how many match in all.
+	Search(ctx context.Context, q domain.SearchQuery) ([]*domain.SearchHit, int, error)
 }
@@ -2,28 +2,124 @@ package employeeusecase
 
 import (
 	"context"
+	"fmt"
 
+	compensationdomain "github.com/smart-hmm/smart-hmm/internal/modules/compensation/domain"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
 	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
 	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	employmentdomain "github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 )
 
 type CreateEmployeeUsecase struct {
-	repo employeerepository.EmployeeRepository
+	repo       employeerepository.EmployeeRepository
+	salaryRepo compensationrepository.SalaryChangeRepository
+	jobRepo    employmentrepository.JobRecordRepository
+	fieldRepo  customfieldrepository.DefinitionRepository
+	txManager  txpkg.Manager
 }
 
-func NewCreateEmployeeUsecase(repo employeerepository.EmployeeRepository) *CreateEmployeeUsecase {
-	return &CreateEmployeeUsecase{repo: repo}
+func NewCreateEmployeeUsecase(
+	repo employeerepository.EmployeeRepository,
+	salaryRepo compensationrepository.SalaryChangeRepository,
+	jobRepo employmentrepository.JobRecordRepository,
+	fieldRepo customfieldrepository.DefinitionRepository,
+	txManager txpkg.Manager,
+) *CreateEmployeeUsecase {
+	return &CreateEmployeeUsecase{
+		repo:       repo,
+		salaryRepo: salaryRepo,
+		jobRepo:    jobRepo,
+		fieldRepo:  fieldRepo,
+		txManager:  txManager,
+	}
 }
 
+// Execute creates the employee and opens their compensation and
+// employment histories with the hire salary and job. Tenant, date of
+// birth, department, manager, join date, employment type and probation
+// end date are taken from e when set; a hire on probation starts with the
+// PROBATION status. Custom fields are checked against the complete schema
+// of the tenant, required fields included. The employee and both
+// histories are created together or not at all.
 func (uc *CreateEmployeeUsecase) Execute(ctx context.Context, e *domain.Employee) (*domain.Employee, error) {
 	newEmp, err := domain.NewEmployee(e.Code, e.FirstName, e.LastName, e.Email, e.Phone, e.Position, e.BaseSalary)
 	if err != nil {
 		return nil, err
 	}
-	newEmpID, err := uc.repo.Create(newEmp)
+	newEmp.TenantID = e.TenantID
+	newEmp.DateOfBirth = e.DateOfBirth
+	newEmp.DepartmentID = e.DepartmentID
+	newEmp.ManagerID = e.ManagerID
+	if newEmp.ManagerID != nil {
+		manager, err := uc.repo.FindByID(*newEmp.ManagerID)
+		if err != nil {
+			return nil, fmt.Errorf("load manager %s: %w", *newEmp.ManagerID, err)
+		}
+		if manager.TenantID != newEmp.TenantID {
+			return nil, employmentdomain.ErrManagerOtherTenant
+		}
+	}
+	if !e.JoinDate.IsZero() {
+		newEmp.JoinDate = e.JoinDate
+	}
+	if e.EmploymentType != "" {
+		newEmp.EmploymentType = e.EmploymentType
+	}
+	if e.ProbationEndDate != nil {
+		if err := newEmp.SetProbation(*e.ProbationEndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	if newEmp.TenantID != "" {
+		schema, err := uc.fieldRepo.ListByTenantID(ctx, newEmp.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		values, err := schema.Validate(e.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		newEmp.CustomFields = values
+	} else if len(e.CustomFields) > 0 {
+		return nil, domain.ErrCustomFieldsWithoutTenant
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		newEmpID, err := uc.repo.Create(txCtx, newEmp)
+		if err != nil {
+			return err
+		}
+		newEmp.ID = newEmpID
+
+		hire, err := compensationdomain.NewSalaryChange(
+			newEmp.ID,
+			newEmp.JoinDate,
+			newEmp.BaseSalary,
+			compensationdomain.ReasonHire,
+			nil,
+			"",
+		)
+		if err != nil {
+			return err
+		}
+		if err := uc.salaryRepo.Create(txCtx, hire); err != nil {
+			return err
+		}
+
+		job, err := employmentdomain.HireRecord(newEmp)
+		if err != nil {
+			return err
+		}
+		return uc.jobRepo.Create(txCtx, job)
+	})
 	if err != nil {
 		return nil, err
 	}
-	newEmp.ID = newEmpID
+
 	return newEmp, nil
 }
@@ -14,6 +14,9 @@ func NewDeleteEmployeeUsecase(repo employeerepository.EmployeeRepository) *Delet
 	return &DeleteEmployeeUsecase{repo: repo}
 }
 
+// Execute hard-deletes the employee with their payroll and leave history.
+// It only rolls back an onboarding that failed half way; employees who
+// leave are offboarded instead, which keeps their records.
 func (uc *DeleteEmployeeUsecase) Execute(ctx context.Context, id string) error {
 	return uc.repo.Delete(id)
 }
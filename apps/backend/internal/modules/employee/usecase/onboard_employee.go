@@ -5,9 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
 	empDomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	onboardingdomain "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/usecase"
+	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
 	emailstemplates "github.com/smart-hmm/smart-hmm/internal/templates/emails"
 	"github.com/smart-hmm/smart-hmm/internal/worker"
 
@@ -17,22 +25,34 @@ import (
 
 type OnboardEmployeeUsecase struct {
 	createEmployeeUC *CreateEmployeeUsecase
-	deleteEmployeeUC *DeleteEmployeeUsecase
 	createUserUC     *userusecase.RegisterUserUsecase
+	assignTasksUC    *onboardingusecase.AssignTasksUsecase
+	repo             employeerepository.EmployeeRepository
+	departmentRepo   departmentrepository.DepartmentRepository
+	tenantRepo       tenantrepository.TenantRepository
 	queueSvc         queueports.QueueService
+	txManager        txpkg.Manager
 }
 
 func NewOnboardEmployeeUsecase(
 	createEmployeeUC *CreateEmployeeUsecase,
-	deleteEmployeeUC *DeleteEmployeeUsecase,
 	createUserUC *userusecase.RegisterUserUsecase,
+	assignTasksUC *onboardingusecase.AssignTasksUsecase,
+	repo employeerepository.EmployeeRepository,
+	departmentRepo departmentrepository.DepartmentRepository,
+	tenantRepo tenantrepository.TenantRepository,
 	queueSvc queueports.QueueService,
+	txManager txpkg.Manager,
 ) *OnboardEmployeeUsecase {
 	return &OnboardEmployeeUsecase{
 		createEmployeeUC: createEmployeeUC,
-		deleteEmployeeUC: deleteEmployeeUC,
 		createUserUC:     createUserUC,
+		assignTasksUC:    assignTasksUC,
+		repo:             repo,
+		departmentRepo:   departmentRepo,
+		tenantRepo:       tenantRepo,
 		queueSvc:         queueSvc,
+		txManager:        txManager,
 	}
 }
 
@@ -43,7 +63,21 @@ type OnboardEmployeeInput struct {
 	Email      string
 	Phone      string
 	Position   string
-	BaseSalary float64
+	BaseSalary money.Money
+
+	// Optional; left empty they take the defaults of a new employee.
+	TenantID       string
+	DateOfBirth    *time.Time
+	DepartmentID   *string
+	ManagerID      *string
+	JoinDate       time.Time
+	EmploymentType empDomain.EmploymentType
+	CustomFields   map[string]any
+	// ProbationEndDate puts the hire on probation until that day.
+	ProbationEndDate *time.Time
+	// TemplateID picks the onboarding checklist; nil uses the template of
+	// the department or the tenant's default.
+	TemplateID *string
 
 	CreateUser bool
 	UserEmail  string
@@ -55,71 +89,162 @@ func (uc *OnboardEmployeeUsecase) Execute(
 	ctx context.Context,
 	input OnboardEmployeeInput,
 	isSendMail bool,
-) error {
+) (*empDomain.Employee, error) {
 	newEmp, err := empDomain.NewEmployee(
 		input.Code, input.FirstName,
 		input.LastName, input.Email, input.Phone,
 		input.Position, input.BaseSalary)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	newEmp.TenantID = input.TenantID
+	newEmp.DateOfBirth = input.DateOfBirth
+	newEmp.DepartmentID = input.DepartmentID
+	newEmp.ManagerID = input.ManagerID
+	newEmp.JoinDate = input.JoinDate
+	newEmp.EmploymentType = input.EmploymentType
+	newEmp.CustomFields = input.CustomFields
+	newEmp.ProbationEndDate = input.ProbationEndDate
+
+	var (
+		employee *empDomain.Employee
+		tasks    []*onboardingdomain.Task
+	)
+
+	// The employee, their checklist and user are created together; a
+	// failing step leaves nothing behind.
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		employee, err = uc.createEmployeeUC.Execute(txCtx, newEmp)
+		if err != nil {
+			return err
+		}
 
-	employee, err := uc.createEmployeeUC.Execute(ctx, newEmp)
-	if err != nil {
-		return err
-	}
+		tasks, err = uc.assignTasksUC.Execute(txCtx, employee, input.TemplateID)
+		if err != nil {
+			return err
+		}
 
-	if input.CreateUser {
-		err = uc.createUserUC.Execute(
-			ctx,
+		if !input.CreateUser {
+			return nil
+		}
+		return uc.createUserUC.Execute(
+			txCtx,
 			input.UserEmail,
 			input.Password,
 			input.Role,
 			&employee.ID,
 		)
-		if err != nil {
-			uc.deleteEmployeeUC.Execute(ctx, employee.ID)
-			return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The welcome e-mail only goes out once the hire is committed; the
+	// hire stands when it cannot be sent.
+	if isSendMail {
+		if err := uc.sendWelcomeEmail(ctx, employee, tasks, input); err != nil {
+			log.Printf("welcome e-mail for employee %s: %v", employee.ID, err)
 		}
 	}
 
-	htmlBody, err := emailstemplates.RenderOnboardingEmail(emailstemplates.OnboardingEmailData{
-		CompanyName:       "SmartHMM",
-		CompanyAddress:    "Ho Chi Minh City, Vietnam",
-		EmployeeName:      fmt.Sprintf("%s %s", input.FirstName, input.LastName),
-		JobTitle:          input.Position,
-		Department:        "Engineering",
-		StartDate:         "2025-12-10",
-		WorkspaceInfo:     "Remote",
-		ManagerName:       "Nguyen Van A",
-		ManagerEmail:      "manager@smarthrm.io",
-		WorkEmail:         input.Email,
-		TemporaryPassword: input.Password,
-		CheckinTime:       "09:00 AM",
-		PortalURL:         "https://hr.smarthrm.io/login",
-		HrEmail:           "hr@smarthrm.io",
-	})
+	return employee, nil
+}
+
+func (uc *OnboardEmployeeUsecase) sendWelcomeEmail(
+	ctx context.Context,
+	employee *empDomain.Employee,
+	tasks []*onboardingdomain.Task,
+	input OnboardEmployeeInput,
+) error {
+
+	data, err := uc.welcomeEmailData(ctx, employee, tasks)
 	if err != nil {
 		return err
 	}
+	data.WorkEmail = input.Email
+	data.TemporaryPassword = input.Password
 
-	if isSendMail {
-		payload := worker.SendEmailPayload{
-			To:      input.UserEmail,
-			Subject: "Welcome to SmartHRM 🎉",
-			Body:    htmlBody,
+	htmlBody, err := emailstemplates.RenderOnboardingEmail(data)
+	if err != nil {
+		return err
+	}
+
+	payload := worker.SendEmailPayload{
+		To:      input.UserEmail,
+		Subject: "Welcome to SmartHRM 🎉",
+		Body:    htmlBody,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return uc.queueSvc.Publish(ctx, worker.SendEmailTopic, queueports.Message{
+		Body: body,
+	})
+}
+
+// welcomeEmailData fills the welcome email with the hire's department,
+// manager, start date and their own onboarding tasks. Without a manager
+// of their own, the department's manager is named.
+func (uc *OnboardEmployeeUsecase) welcomeEmailData(
+	ctx context.Context,
+	employee *empDomain.Employee,
+	tasks []*onboardingdomain.Task,
+) (emailstemplates.OnboardingEmailData, error) {
+
+	data := emailstemplates.OnboardingEmailData{
+		CompanyName:    "SmartHMM",
+		CompanyAddress: "Ho Chi Minh City, Vietnam",
+		EmployeeName:   fmt.Sprintf("%s %s", employee.FirstName, employee.LastName),
+		JobTitle:       employee.Position,
+		StartDate:      employee.JoinDate.Format(time.DateOnly),
+		WorkspaceInfo:  "Remote",
+		CheckinTime:    "09:00 AM",
+		PortalURL:      "https://hr.smarthrm.io/login",
+		HrEmail:        "hr@smarthrm.io",
+	}
+
+	if employee.TenantID != "" {
+		tenant, err := uc.tenantRepo.GetByID(ctx, employee.TenantID)
+		if err != nil {
+			return data, fmt.Errorf("load tenant %s: %w", employee.TenantID, err)
 		}
+		data.CompanyName = tenant.Name
+	}
 
-		data, _ := json.Marshal(payload)
+	managerID := employee.ManagerID
+	if employee.DepartmentID != nil {
+		department, err := uc.departmentRepo.FindByID(*employee.DepartmentID)
+		if err != nil {
+			return data, fmt.Errorf("load department %s: %w", *employee.DepartmentID, err)
+		}
+		data.Department = department.Name
+		if managerID == nil {
+			managerID = department.ManagerID
+		}
+	}
 
-		err = uc.queueSvc.Publish(ctx, worker.SendEmailTopic, queueports.Message{
-			Body: data,
-		})
+	if managerID != nil && *managerID != employee.ID {
+		manager, err := uc.repo.FindByID(*managerID)
+		if err != nil {
+			return data, fmt.Errorf("load manager %s: %w", *managerID, err)
+		}
+		data.ManagerName = fmt.Sprintf("%s %s", manager.FirstName, manager.LastName)
+		data.ManagerEmail = manager.Email
 	}
 
-	if err != nil {
-		log.Default().Print(err)
+	for _, t := range tasks {
+		if t.Assignee != onboardingdomain.AssigneeNewHire {
+			continue
+		}
+		data.Tasks = append(data.Tasks, emailstemplates.OnboardingEmailTask{
+			Title:   t.Title,
+			DueDate: t.DueDate.Format(time.DateOnly),
+		})
 	}
 
-	return nil
+	return data, nil
 }
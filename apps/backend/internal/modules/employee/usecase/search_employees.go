@@ -0,0 +1,93 @@
+package employeeusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+)
+
+type SearchEmployeesUsecase struct {
+	repo employeerepository.EmployeeRepository
+}
+
+func NewSearchEmployeesUsecase(repo employeerepository.EmployeeRepository) *SearchEmployeesUsecase {
+	return &SearchEmployeesUsecase{repo: repo}
+}
+
+// Execute returns a page of the tenant's employees matching text, ranked
+// and highlighted, and how many match in all. The total is 0 for pages
+// past the last.
+func (uc *SearchEmployeesUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	text string,
+	page int,
+	limit int,
+) ([]*domain.SearchHit, int, error) {
+
+	text, err := domain.NormalizeSearch(text)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	hits, total, err := uc.repo.Search(ctx, domain.SearchQuery{
+		TenantID: tenantID,
+		Text:     text,
+		Offset:   (page - 1) * limit,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return highlightHits(hits, text), total, nil
+}
+
+type SuggestEmployeesUsecase struct {
+	repo employeerepository.EmployeeRepository
+}
+
+func NewSuggestEmployeesUsecase(repo employeerepository.EmployeeRepository) *SuggestEmployeesUsecase {
+	return &SuggestEmployeesUsecase{repo: repo}
+}
+
+// Execute returns the best few matches for a type-ahead box. Queries too
+// short to search suggest nothing rather than fail, as they come with
+// every keystroke.
+func (uc *SuggestEmployeesUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	text string,
+	limit int,
+) ([]*domain.SearchHit, error) {
+
+	text, err := domain.NormalizeSearch(text)
+	if err != nil {
+		return []*domain.SearchHit{}, nil
+	}
+	if limit <= 0 || limit > domain.SuggestLimit {
+		limit = domain.SuggestLimit
+	}
+
+	hits, _, err := uc.repo.Search(ctx, domain.SearchQuery{
+		TenantID: tenantID,
+		Text:     text,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return highlightHits(hits, text), nil
+}
+
+func highlightHits(hits []*domain.SearchHit, text string) []*domain.SearchHit {
+	if hits == nil {
+		return []*domain.SearchHit{}
+	}
+	for _, h := range hits {
+		h.Highlight(text)
+	}
+	return hits
+}
@@ -4,19 +4,72 @@ import (
 	"context"
 	"time"
 
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
 	"github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
 	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
 )
 
 type UpdateEmployeeUsecase struct {
-	repo employeerepository.EmployeeRepository
+	repo      employeerepository.EmployeeRepository
+	fieldRepo customfieldrepository.DefinitionRepository
+	userRepo  userrepository.UserRepository
 }
 
-func NewUpdateEmployeeUsecase(repo employeerepository.EmployeeRepository) *UpdateEmployeeUsecase {
-	return &UpdateEmployeeUsecase{repo: repo}
+func NewUpdateEmployeeUsecase(
+	repo employeerepository.EmployeeRepository,
+	fieldRepo customfieldrepository.DefinitionRepository,
+	userRepo userrepository.UserRepository,
+) *UpdateEmployeeUsecase {
+	return &UpdateEmployeeUsecase{repo: repo, fieldRepo: fieldRepo, userRepo: userRepo}
 }
 
-func (uc *UpdateEmployeeUsecase) Execute(ctx context.Context, e *domain.Employee) error {
+// Execute updates the employee's profile. Salary and job are not touched:
+// record a salary change or a job change in their histories instead.
+//
+// e.CustomFields holds changes only: listed keys are set, null removes a
+// value and other values are kept. The user may only change fields their
+// role sees.
+func (uc *UpdateEmployeeUsecase) Execute(ctx context.Context, e *domain.Employee, userID string) error {
+	if e.CustomFields != nil {
+		values, err := uc.mergeCustomFields(ctx, e.ID, e.CustomFields, userID)
+		if err != nil {
+			return err
+		}
+		e.CustomFields = values
+	}
+
 	e.UpdatedAt = time.Now().UTC()
 	return uc.repo.Update(e)
 }
+
+func (uc *UpdateEmployeeUsecase) mergeCustomFields(
+	ctx context.Context,
+	employeeID string,
+	changes map[string]any,
+	userID string,
+) (map[string]any, error) {
+
+	current, err := uc.repo.FindByID(employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if current.TenantID == "" {
+		if len(changes) > 0 {
+			return nil, domain.ErrCustomFieldsWithoutTenant
+		}
+		return nil, nil
+	}
+
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := uc.fieldRepo.ListByTenantID(ctx, current.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.For(user.Role).Merge(current.CustomFields, changes, false)
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+)
+
+// Column is an employee attribute that can be exported. The names match
+// the headers the employee import recognises, so an export can be
+// edited and imported again.
+type Column string
+
+const (
+	ColumnCode             Column = "code"
+	ColumnFirstName        Column = "first_name"
+	ColumnLastName         Column = "last_name"
+	ColumnEmail            Column = "email"
+	ColumnPhone            Column = "phone"
+	ColumnDateOfBirth      Column = "date_of_birth"
+	ColumnDepartment       Column = "department"
+	ColumnDepartmentID     Column = "department_id"
+	ColumnManagerID        Column = "manager_id"
+	ColumnPosition         Column = "position"
+	ColumnEmploymentType   Column = "employment_type"
+	ColumnEmploymentStatus Column = "employment_status"
+	ColumnJoinDate         Column = "join_date"
+	ColumnBaseSalary       Column = "base_salary"
+	ColumnCurrency         Column = "currency"
+)
+
+// Columns lists every built-in column in the default export order.
+// Custom fields follow as CustomColumn.
+var Columns = []Column{
+	ColumnCode, ColumnFirstName, ColumnLastName, ColumnEmail, ColumnPhone,
+	ColumnDateOfBirth, ColumnDepartment, ColumnDepartmentID, ColumnManagerID,
+	ColumnPosition, ColumnEmploymentType, ColumnEmploymentStatus,
+	ColumnJoinDate, ColumnBaseSalary, ColumnCurrency,
+}
+
+// CustomColumn is the column of a custom field.
+func CustomColumn(key string) Column {
+	return Column(customfielddomain.Prefix + key)
+}
+
+func (c Column) customKey() (string, bool) {
+	return strings.CutPrefix(string(c), customfielddomain.Prefix)
+}
+
+type UnknownColumnError Column
+
+func (e UnknownColumnError) Error() string {
+	return fmt.Sprintf("unknown column %q", string(e))
+}
+
+func (c Column) valid(schema customfielddomain.Schema) bool {
+	if key, ok := c.customKey(); ok {
+		_, defined := schema.Get(key)
+		return defined
+	}
+	for _, known := range Columns {
+		if c == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Value formats the column of e as cell text. Dates are ISO dates and
+// salaries exact decimals without the currency.
+func (c Column) Value(e *employeedomain.Employee) string {
+	if key, ok := c.customKey(); ok {
+		return customfielddomain.FormatValue(e.CustomFields[key])
+	}
+
+	switch c {
+	case ColumnCode:
+		return e.Code
+	case ColumnFirstName:
+		return e.FirstName
+	case ColumnLastName:
+		return e.LastName
+	case ColumnEmail:
+		return e.Email
+	case ColumnPhone:
+		return e.Phone
+	case ColumnDateOfBirth:
+		if e.DateOfBirth == nil {
+			return ""
+		}
+		return formatDate(*e.DateOfBirth)
+	case ColumnDepartment:
+		return deref(e.DepartmentName)
+	case ColumnDepartmentID:
+		return deref(e.DepartmentID)
+	case ColumnManagerID:
+		return deref(e.ManagerID)
+	case ColumnPosition:
+		return e.Position
+	case ColumnEmploymentType:
+		return string(e.EmploymentType)
+	case ColumnEmploymentStatus:
+		return string(e.EmploymentStatus)
+	case ColumnJoinDate:
+		return formatDate(e.JoinDate)
+	case ColumnBaseSalary:
+		return e.BaseSalary.String()
+	case ColumnCurrency:
+		return string(e.BaseSalary.Currency())
+	default:
+		return ""
+	}
+}
+
+// Row formats e with the given columns.
+func Row(columns []Column, e *employeedomain.Employee) []string {
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		row[i] = c.Value(e)
+	}
+	return row
+}
+
+// Header is the header row of columns.
+func Header(columns []Column) []string {
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = string(c)
+	}
+	return header
+}
+
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/spreadsheet"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusRunning   Status = "RUNNING"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED"
+)
+
+// InlineCellLimit is the largest export, in cells, returned in the
+// response. Larger exports are written by the worker and downloaded
+// from storage.
+const InlineCellLimit = 200_000
+
+var ErrDuplicateColumn = errors.New("column is selected more than once")
+
+// Export is an employee export written in the background.
+type Export struct {
+	ID       string                `json:"id"`
+	TenantID string                `json:"tenant_id"`
+	Format   spreadsheet.Format    `json:"format"`
+	Columns  []Column              `json:"columns"`
+	Filter   employeedomain.Filter `json:"filter"`
+	Status   Status                `json:"status"`
+
+	RowCount int `json:"row_count"`
+	// FilePath is the private storage object once the export completed.
+	FilePath *string `json:"-"`
+	// Error explains why a FAILED export could not be written.
+	Error *string `json:"error,omitempty"`
+
+	RequestedBy string     `json:"requested_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+
+	// DownloadURL is presigned when a completed export is read.
+	DownloadURL       *string    `json:"download_url,omitempty"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+}
+
+// NewExport checks the selection against the custom fields the
+// requester sees. No columns selects all of them.
+func NewExport(
+	format spreadsheet.Format,
+	columns []Column,
+	filter employeedomain.Filter,
+	schema customfielddomain.Schema,
+	requestedBy string,
+) (*Export, error) {
+	if filter.TenantID == "" {
+		return nil, errors.New("tenantID is required")
+	}
+	if _, err := spreadsheet.ParseFormat(string(format)); err != nil {
+		return nil, err
+	}
+
+	customFilter, err := schema.ParseFilter(filter.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	filter.CustomFields = customFilter
+
+	if len(columns) == 0 {
+		columns = append([]Column{}, Columns...)
+		for _, d := range schema {
+			columns = append(columns, CustomColumn(d.Key))
+		}
+	}
+	seen := map[Column]bool{}
+	for _, c := range columns {
+		if !c.valid(schema) {
+			return nil, UnknownColumnError(c)
+		}
+		if seen[c] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateColumn, c)
+		}
+		seen[c] = true
+	}
+
+	return &Export{
+		TenantID:    filter.TenantID,
+		Format:      format,
+		Columns:     columns,
+		Filter:      filter,
+		Status:      StatusPending,
+		RequestedBy: requestedBy,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Inline reports whether rows employees are small enough to return in
+// the response.
+func (e *Export) Inline(rows int) bool {
+	return rows*len(e.Columns) <= InlineCellLimit
+}
+
+// FileName is the name offered to the browser.
+func (e *Export) FileName(now time.Time) string {
+	return fmt.Sprintf("employees-%s.%s", now.Format("20060102-150405"), e.Format)
+}
+
+func (e *Export) Complete(path string, rows int) {
+	now := time.Now().UTC()
+	e.Status = StatusCompleted
+	e.FilePath = &path
+	e.RowCount = rows
+	e.FinishedAt = &now
+}
+
+func (e *Export) Fail(err error) {
+	now := time.Now().UTC()
+	msg := err.Error()
+	e.Status = StatusFailed
+	e.Error = &msg
+	e.FinishedAt = &now
+}
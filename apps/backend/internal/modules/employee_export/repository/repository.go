@@ -0,0 +1,26 @@
+package employeeexportrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+)
+
+var (
+	ErrExportNotFound   = errors.New("employee export not found")
+	ErrExportNotPending = errors.New("employee export is not pending")
+)
+
+type ExportRepository interface {
+	Create(ctx context.Context, e *domain.Export) error
+	// Save writes the status, row count, file and error of the export.
+	Save(ctx context.Context, e *domain.Export) error
+
+	GetByID(ctx context.Context, id string) (*domain.Export, error)
+	ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Export, error)
+
+	// Claim moves a pending export to RUNNING so that only one worker
+	// writes it.
+	Claim(ctx context.Context, id string) (*domain.Export, error)
+}
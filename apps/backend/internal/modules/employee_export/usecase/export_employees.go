@@ -0,0 +1,89 @@
+package employeeexportusecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	customfieldusecase "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/usecase"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/spreadsheet"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type ExportEmployeesUsecase struct {
+	repo         employeeexportrepository.ExportRepository
+	employeeRepo employeerepository.EmployeeRepository
+	fieldsUC     *customfieldusecase.VisibleFieldsUsecase
+	queueSvc     queueports.QueueService
+}
+
+func NewExportEmployeesUsecase(
+	repo employeeexportrepository.ExportRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	fieldsUC *customfieldusecase.VisibleFieldsUsecase,
+	queueSvc queueports.QueueService,
+) *ExportEmployeesUsecase {
+	return &ExportEmployeesUsecase{
+		repo:         repo,
+		employeeRepo: employeeRepo,
+		fieldsUC:     fieldsUC,
+		queueSvc:     queueSvc,
+	}
+}
+
+// Execute streams small exports to the writer returned by open and
+// returns nil. Exports above domain.InlineCellLimit are queued for the
+// worker instead and the pending export is returned; open is not called.
+// Custom field columns and filters are limited to the fields the
+// requester's role sees.
+func (uc *ExportEmployeesUsecase) Execute(
+	ctx context.Context,
+	format spreadsheet.Format,
+	columns []domain.Column,
+	filter employeedomain.Filter,
+	requestedBy string,
+	open func(exp *domain.Export) io.Writer,
+) (*domain.Export, error) {
+
+	schema, err := uc.fieldsUC.Execute(ctx, filter.TenantID, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := domain.NewExport(format, columns, filter, schema, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := uc.employeeRepo.Count(ctx, exp.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp.Inline(count) {
+		_, err := writeEmployees(ctx, uc.employeeRepo, exp, open(exp))
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, exp); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(worker.ExportEmployeesPayload{ExportID: exp.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.queueSvc.Publish(ctx, worker.ExportEmployeesTopic, queueports.Message{
+		Body: data,
+	}); err != nil {
+		return nil, err
+	}
+
+	return exp, nil
+}
@@ -0,0 +1,65 @@
+package employeeexportusecase
+
+import (
+	"context"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+)
+
+// downloadURLTTL is how long the link to a completed export works.
+const downloadURLTTL = 15 * time.Minute
+
+type GetExportUsecase struct {
+	repo    employeeexportrepository.ExportRepository
+	storage storageports.StorageService
+}
+
+func NewGetExportUsecase(
+	repo employeeexportrepository.ExportRepository,
+	storage storageports.StorageService,
+) *GetExportUsecase {
+	return &GetExportUsecase{repo: repo, storage: storage}
+}
+
+// Execute returns the export with a presigned download link once it has
+// completed.
+func (uc *GetExportUsecase) Execute(ctx context.Context, id string) (*domain.Export, error) {
+	exp, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if exp.Status != domain.StatusCompleted || exp.FilePath == nil {
+		return exp, nil
+	}
+
+	url, err := uc.storage.PresignURL(ctx, storageports.PresignInput{
+		Path:      *exp.FilePath,
+		ExpiresIn: downloadURLTTL,
+		Method:    "GET",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().UTC().Add(downloadURLTTL)
+	exp.DownloadURL = &url
+	exp.DownloadExpiresAt = &expiresAt
+
+	return exp, nil
+}
+
+type ListExportsUsecase struct {
+	repo employeeexportrepository.ExportRepository
+}
+
+func NewListExportsUsecase(repo employeeexportrepository.ExportRepository) *ListExportsUsecase {
+	return &ListExportsUsecase{repo: repo}
+}
+
+func (uc *ListExportsUsecase) Execute(ctx context.Context, tenantID string) ([]*domain.Export, error) {
+	return uc.repo.ListByTenantID(ctx, tenantID)
+}
@@ -0,0 +1,94 @@
+package employeeexportusecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	employeeexportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_export/repository"
+)
+
+type RunExportUsecase struct {
+	repo         employeeexportrepository.ExportRepository
+	employeeRepo employeerepository.EmployeeRepository
+	storage      storageports.StorageService
+}
+
+func NewRunExportUsecase(
+	repo employeeexportrepository.ExportRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	storage storageports.StorageService,
+) *RunExportUsecase {
+	return &RunExportUsecase{
+		repo:         repo,
+		employeeRepo: employeeRepo,
+		storage:      storage,
+	}
+}
+
+// Execute writes a pending export to a temporary file and uploads it as
+// a private object. A failure is recorded on the export rather than
+// retried, since the export is no longer pending.
+func (uc *RunExportUsecase) Execute(ctx context.Context, exportID string) error {
+	exp, err := uc.repo.Claim(ctx, exportID)
+	if errors.Is(err, employeeexportrepository.ErrExportNotPending) {
+		log.Println("employee export already processed:", exportID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	filePath, rows, err := uc.write(ctx, exp)
+	if err != nil {
+		exp.Fail(err)
+		return uc.repo.Save(ctx, exp)
+	}
+
+	exp.Complete(filePath, rows)
+	return uc.repo.Save(ctx, exp)
+}
+
+func (uc *RunExportUsecase) write(ctx context.Context, exp *domain.Export) (string, int, error) {
+	tmp, err := os.CreateTemp("", "employee-export-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	rows, err := writeEmployees(ctx, uc.employeeRepo, exp, tmp)
+	if err != nil {
+		return "", 0, err
+	}
+
+	info, err := tmp.Stat()
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := tmp.Seek(0, 0); err != nil {
+		return "", 0, err
+	}
+
+	dir := path.Join("exports", "employees", exp.TenantID)
+	filename := exp.ID + "-" + exp.FileName(time.Now().UTC())
+
+	if _, err := uc.storage.Upload(ctx, storageports.UploadInput{
+		Path:        dir,
+		Filename:    filename,
+		Reader:      tmp,
+		Size:        info.Size(),
+		ContentType: exp.Format.ContentType(),
+		Private:     true,
+	}); err != nil {
+		return "", 0, err
+	}
+
+	return path.Join(dir, filename), rows, nil
+}
@@ -0,0 +1,38 @@
+package employeeexportusecase
+
+import (
+	"context"
+	"io"
+
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_export/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/spreadsheet"
+)
+
+// writeEmployees streams the employees selected by exp to w and returns
+// how many rows were written.
+func writeEmployees(
+	ctx context.Context,
+	employeeRepo employeerepository.EmployeeRepository,
+	exp *domain.Export,
+	w io.Writer,
+) (int, error) {
+
+	sw, err := spreadsheet.NewWriter(exp.Format, w, domain.Header(exp.Columns))
+	if err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	err = employeeRepo.Each(ctx, exp.Filter, func(e *employeedomain.Employee) error {
+		rows++
+		return sw.Write(domain.Row(exp.Columns, e))
+	})
+	if err != nil {
+		sw.Close()
+		return rows, err
+	}
+
+	return rows, sw.Close()
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusRunning   Status = "RUNNING"
+	StatusCompleted Status = "COMPLETED"
+	StatusFailed    Status = "FAILED"
+)
+
+var (
+	ErrPathRequired = errors.New("file path is required")
+	ErrInvalidRole  = errors.New("role must be ADMIN, HR, MANAGER or EMPLOYEE")
+	ErrInvalidSheet = errors.New("sheet cannot be imported")
+	// ErrUsersWithoutEmails rejects creating users nobody can sign in as:
+	// their temporary password is only ever sent by e-mail.
+	ErrUsersWithoutEmails = errors.New("creating users requires sending their onboarding e-mails")
+)
+
+// Options tune how an uploaded sheet becomes employees.
+type Options struct {
+	// Columns maps header cells to fields where the header is not one of
+	// the recognised names.
+	Columns map[string]Field `json:"columns,omitempty"`
+	// Currency applies to salaries without a currency column.
+	Currency money.Currency `json:"currency"`
+
+	CreateUsers bool `json:"create_users"`
+	// DefaultRole is the user role for rows without a role column.
+	DefaultRole userdomain.UserRole `json:"default_role,omitempty"`
+	// SendEmails queues the onboarding e-mail with the temporary password
+	// of every user created. It is required with CreateUsers.
+	SendEmails bool `json:"send_emails"`
+}
+
+func (o *Options) normalize() error {
+	if o.Currency == "" {
+		o.Currency = money.DefaultCurrency
+	}
+	if o.DefaultRole == "" {
+		o.DefaultRole = userdomain.Employee
+	}
+	if !validRole(o.DefaultRole) {
+		return ErrInvalidRole
+	}
+	if o.CreateUsers && !o.SendEmails {
+		return ErrUsersWithoutEmails
+	}
+	for _, field := range o.Columns {
+		if !field.valid() {
+			return UnknownFieldError(field)
+		}
+	}
+	return nil
+}
+
+func validRole(r userdomain.UserRole) bool {
+	switch r {
+	case userdomain.Admin, userdomain.HR, userdomain.Manager, userdomain.Employee:
+		return true
+	default:
+		return false
+	}
+}
+
+// RowError is a problem with one row of the sheet. Row counts from 1,
+// the header row.
+type RowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+// Import is an asynchronous import of an uploaded sheet.
+type Import struct {
+	ID       string  `json:"id"`
+	TenantID string  `json:"tenant_id"`
+	FilePath string  `json:"file_path"`
+	Options  Options `json:"options"`
+	Status   Status  `json:"status"`
+
+	TotalRows     int        `json:"total_rows"`
+	ProcessedRows int        `json:"processed_rows"`
+	ImportedRows  int        `json:"imported_rows"`
+	FailedRows    int        `json:"failed_rows"`
+	Errors        []RowError `json:"errors"`
+	// Error explains why a FAILED import could not process the sheet.
+	Error *string `json:"error,omitempty"`
+
+	RequestedBy string     `json:"requested_by"`
+	CreatedAt   time.Time  `json:"created_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+}
+
+func NewImport(tenantID, filePath string, opts Options, requestedBy string) (*Import, error) {
+	if tenantID == "" {
+		return nil, errors.New("tenantID is required")
+	}
+	if filePath == "" {
+		return nil, ErrPathRequired
+	}
+	if err := opts.normalize(); err != nil {
+		return nil, err
+	}
+
+	return &Import{
+		TenantID:    tenantID,
+		FilePath:    filePath,
+		Options:     opts,
+		Status:      StatusPending,
+		Errors:      []RowError{},
+		RequestedBy: requestedBy,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Start records the rows to import and the ones rejected up front.
+func (i *Import) Start(total int, rejected []RowError) {
+	if i.StartedAt == nil {
+		now := time.Now().UTC()
+		i.StartedAt = &now
+	}
+
+	i.Status = StatusRunning
+	i.TotalRows = total
+	i.Errors = append(i.Errors, rejected...)
+	i.FailedRows = countRows(rejected)
+	i.ProcessedRows = i.FailedRows
+}
+
+func (i *Import) RowImported() {
+	i.ProcessedRows++
+	i.ImportedRows++
+}
+
+func (i *Import) RowFailed(row int, err error) {
+	i.ProcessedRows++
+	i.FailedRows++
+	i.Errors = append(i.Errors, RowError{Row: row, Message: err.Error()})
+}
+
+func (i *Import) Complete() {
+	now := time.Now().UTC()
+	i.Status = StatusCompleted
+	i.FinishedAt = &now
+}
+
+func (i *Import) Fail(err error) {
+	now := time.Now().UTC()
+	msg := err.Error()
+	i.Status = StatusFailed
+	i.Error = &msg
+	i.FinishedAt = &now
+}
+
+func countRows(errs []RowError) int {
+	rows := map[int]bool{}
+	for _, e := range errs {
+		rows[e.Row] = true
+	}
+	return len(rows)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+func TestNewImportOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr error
+	}{
+		{name: "employees only", opts: Options{}},
+		{name: "users with e-mails", opts: Options{CreateUsers: true, SendEmails: true}},
+		{name: "users without e-mails", opts: Options{CreateUsers: true}, wantErr: ErrUsersWithoutEmails},
+		{name: "e-mails without users", opts: Options{SendEmails: true}},
+		{name: "unknown role", opts: Options{DefaultRole: "OWNER"}, wantErr: ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imp, err := NewImport("t1", "imports/sheet.csv", tt.opts, "u1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewImport() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if imp.Options.Currency != money.DefaultCurrency || imp.Options.DefaultRole != userdomain.Employee {
+				t.Fatalf("defaults not applied: %+v", imp.Options)
+			}
+		})
+	}
+}
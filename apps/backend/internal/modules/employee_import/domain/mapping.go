@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+)
+
+// Field is an employee attribute a column can be mapped to.
+type Field string
+
+const (
+	FieldCode           Field = "code"
+	FieldFirstName      Field = "first_name"
+	FieldLastName       Field = "last_name"
+	FieldEmail          Field = "email"
+	FieldPhone          Field = "phone"
+	FieldDateOfBirth    Field = "date_of_birth"
+	FieldPosition       Field = "position"
+	FieldDepartment     Field = "department"
+	FieldManager        Field = "manager"
+	FieldEmploymentType Field = "employment_type"
+	FieldJoinDate       Field = "join_date"
+	FieldBaseSalary     Field = "base_salary"
+	FieldCurrency       Field = "currency"
+	FieldRole           Field = "role"
+)
+
+// CustomField is the field of a tenant's custom field.
+func CustomField(key string) Field {
+	return Field(customfielddomain.Prefix + key)
+}
+
+func (f Field) customKey() (string, bool) {
+	return strings.CutPrefix(string(f), customfielddomain.Prefix)
+}
+
+// Fields lists every built-in field; the first ones are required.
+var Fields = []Field{
+	FieldCode, FieldFirstName, FieldLastName, FieldEmail, FieldPosition, FieldBaseSalary,
+	FieldPhone, FieldDateOfBirth, FieldDepartment, FieldManager,
+	FieldEmploymentType, FieldJoinDate, FieldCurrency, FieldRole,
+}
+
+var requiredFields = Fields[:6]
+
+// headerNames are the headers recognised without an explicit mapping,
+// compared after normalizeHeader.
+var headerNames = map[Field][]string{
+	FieldCode:           {"code", "employeecode", "employeeid", "staffcode", "manv"},
+	FieldFirstName:      {"firstname", "givenname", "ten"},
+	FieldLastName:       {"lastname", "surname", "familyname", "ho"},
+	FieldEmail:          {"email", "emailaddress", "workemail"},
+	FieldPhone:          {"phone", "phonenumber", "mobile", "sodienthoai"},
+	FieldDateOfBirth:    {"dateofbirth", "dob", "birthday", "ngaysinh"},
+	FieldPosition:       {"position", "jobtitle", "title", "chucvu"},
+	FieldDepartment:     {"department", "departmentname", "departmentid", "phongban"},
+	FieldManager:        {"manager", "managercode", "manageremail", "reportsto"},
+	FieldEmploymentType: {"employmenttype", "type"},
+	FieldJoinDate:       {"joindate", "startdate", "hiredate", "ngayvaolam"},
+	FieldBaseSalary:     {"basesalary", "salary", "luongcoban"},
+	FieldCurrency:       {"currency"},
+	FieldRole:           {"role", "userrole"},
+}
+
+var aliases = map[string]Field{}
+
+func (f Field) valid() bool {
+	if key, ok := f.customKey(); ok {
+		return key != ""
+	}
+	for _, known := range Fields {
+		if f == known {
+			return true
+		}
+	}
+	return false
+}
+
+type UnknownFieldError Field
+
+func (e UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q", string(e))
+}
+
+// normalizeHeader lowercases a header and drops everything but letters
+// and digits, with Vietnamese diacritics folded.
+func normalizeHeader(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		r = foldVietnamese(r)
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+var vietnameseFold = map[rune]rune{}
+
+func init() {
+	groups := map[rune]string{
+		'a': "àáảãạăằắẳẵặâầấẩẫậ",
+		'e': "èéẻẽẹêềếểễệ",
+		'i': "ìíỉĩị",
+		'o': "òóỏõọôồốổỗộơờớởỡợ",
+		'u': "ùúủũụưừứửữự",
+		'y': "ỳýỷỹỵ",
+		'd': "đ",
+	}
+	for base, variants := range groups {
+		for _, r := range variants {
+			vietnameseFold[r] = base
+		}
+	}
+
+	for field, names := range headerNames {
+		for _, name := range names {
+			aliases[name] = field
+		}
+	}
+}
+
+func foldVietnamese(r rune) rune {
+	if base, ok := vietnameseFold[r]; ok {
+		return base
+	}
+	return r
+}
+
+// Mapping is the column index of each mapped field.
+type Mapping map[Field]int
+
+// NewMapping reads the header row. Explicit columns win over recognised
+// header names, which win over the keys and labels of the tenant's
+// custom fields; unrecognised columns are ignored.
+func NewMapping(header []string, columns map[string]Field, schema customfielddomain.Schema) (Mapping, []RowError) {
+	var errs []RowError
+
+	explicit := map[string]Field{}
+	for name, field := range columns {
+		if key, ok := field.customKey(); ok {
+			if _, defined := schema.Get(key); !defined {
+				errs = append(errs, RowError{Row: 1, Column: name, Message: UnknownFieldError(field).Error()})
+				continue
+			}
+		}
+		explicit[normalizeHeader(name)] = field
+	}
+
+	custom := map[string]Field{}
+	for _, d := range schema {
+		for _, name := range []string{customfielddomain.Prefix + d.Key, d.Key, d.Label} {
+			if key := normalizeHeader(name); key != "" {
+				if _, taken := custom[key]; !taken {
+					custom[key] = CustomField(d.Key)
+				}
+			}
+		}
+	}
+
+	m := Mapping{}
+
+	for i, cell := range header {
+		key := normalizeHeader(cell)
+		if key == "" {
+			continue
+		}
+
+		field, ok := explicit[key]
+		if !ok {
+			field, ok = aliases[key]
+		}
+		if !ok {
+			field, ok = custom[key]
+		}
+		if !ok {
+			continue
+		}
+
+		if prev, dup := m[field]; dup {
+			errs = append(errs, RowError{
+				Row:     1,
+				Column:  cell,
+				Message: fmt.Sprintf("%s is already mapped from column %q", field, header[prev]),
+			})
+			continue
+		}
+		m[field] = i
+	}
+
+	required := append([]Field{}, requiredFields...)
+	for _, d := range schema {
+		if d.Required {
+			required = append(required, CustomField(d.Key))
+		}
+	}
+	for _, field := range required {
+		if _, ok := m[field]; !ok {
+			errs = append(errs, RowError{Row: 1, Message: fmt.Sprintf("no column for required field %s", field)})
+		}
+	}
+
+	return m, errs
+}
+
+// value returns the cell of field in row, empty when the field is not
+// mapped or the row is short.
+func (m Mapping) value(row []string, field Field) string {
+	i, ok := m[field]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
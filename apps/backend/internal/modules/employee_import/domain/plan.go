@@ -0,0 +1,485 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// DepartmentRef and EmployeeRef are the existing records rows may point
+// at.
+type DepartmentRef struct {
+	ID   string
+	Name string
+}
+
+type EmployeeRef struct {
+	ID    string
+	Code  string
+	Email string
+}
+
+// Directory resolves department and manager cells to existing records
+// and checks custom field cells against the tenant's schema. employees
+// holds every code and e-mail in use, which are unique across tenants;
+// managers only the tenant's active employees.
+type Directory struct {
+	departmentIDs   map[string]bool
+	departmentNames map[string][]string
+	employees       map[string]string
+	managers        map[string]string
+	schema          customfielddomain.Schema
+}
+
+func NewDirectory(
+	departments []DepartmentRef,
+	employees, managers []EmployeeRef,
+	schema customfielddomain.Schema,
+) *Directory {
+	d := &Directory{
+		departmentIDs:   map[string]bool{},
+		departmentNames: map[string][]string{},
+		employees:       map[string]string{},
+		managers:        map[string]string{},
+		schema:          schema,
+	}
+
+	for _, dep := range departments {
+		d.departmentIDs[dep.ID] = true
+		name := strings.ToLower(strings.TrimSpace(dep.Name))
+		d.departmentNames[name] = append(d.departmentNames[name], dep.ID)
+	}
+	for _, e := range employees {
+		d.employees[strings.ToLower(e.Code)] = e.ID
+		d.employees[strings.ToLower(e.Email)] = e.ID
+	}
+	for _, e := range managers {
+		d.managers[strings.ToLower(e.Code)] = e.ID
+		d.managers[strings.ToLower(e.Email)] = e.ID
+	}
+
+	return d
+}
+
+func (d *Directory) department(ref string) (string, error) {
+	if d.departmentIDs[ref] {
+		return ref, nil
+	}
+
+	ids := d.departmentNames[strings.ToLower(ref)]
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("department %q not found", ref)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("department name %q is ambiguous; use the department id", ref)
+	}
+}
+
+// Candidate is a valid row ready to become an employee. A manager found
+// in the same sheet is referenced by ManagerRow until it is imported.
+type Candidate struct {
+	Row int `json:"row"`
+
+	Code           string                        `json:"code"`
+	FirstName      string                        `json:"first_name"`
+	LastName       string                        `json:"last_name"`
+	Email          string                        `json:"email"`
+	Phone          string                        `json:"phone,omitempty"`
+	DateOfBirth    *time.Time                    `json:"date_of_birth,omitempty"`
+	Position       string                        `json:"position"`
+	EmploymentType employeedomain.EmploymentType `json:"employment_type"`
+	JoinDate       time.Time                     `json:"join_date"`
+	BaseSalary     money.Money                   `json:"base_salary"`
+	Role           userdomain.UserRole           `json:"role,omitempty"`
+
+	DepartmentID *string `json:"department_id,omitempty"`
+	ManagerID    *string `json:"manager_id,omitempty"`
+	ManagerRow   int     `json:"manager_row,omitempty"`
+
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+// Report is the outcome of validating a sheet. Candidates are ordered so
+// that managers from the sheet come before the people reporting to them.
+type Report struct {
+	TotalRows  int          `json:"total_rows"`
+	ValidRows  int          `json:"valid_rows"`
+	Errors     []RowError   `json:"errors"`
+	Candidates []*Candidate `json:"candidates"`
+}
+
+// SheetError reports the header problems that keep every row from
+// importing, nil when there are none.
+func (r *Report) SheetError() error {
+	var msgs []string
+	for _, e := range r.Errors {
+		if e.Row == 1 {
+			msgs = append(msgs, e.Message)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrInvalidSheet, strings.Join(msgs, "; "))
+}
+
+// entry is a parsed row with the manager cell still to resolve.
+type entry struct {
+	candidate *Candidate
+	manager   string
+}
+
+// Plan validates every data row of rows against the mapping and the
+// directory. today is the join date of rows without one.
+func Plan(rows [][]string, mapping Mapping, dir *Directory, opts Options, today time.Time) (*Report, error) {
+	if err := opts.normalize(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{Errors: []RowError{}, Candidates: []*Candidate{}}
+
+	var valid []*entry
+	byKey := map[string]*entry{}
+	seen := map[string]int{}
+	failed := map[int]bool{}
+
+	for i, row := range rows[1:] {
+		rowNo := i + 2
+		if blank(row) {
+			continue
+		}
+		report.TotalRows++
+
+		p := &rowParser{row: row, rowNo: rowNo, mapping: mapping, opts: opts}
+		c := p.candidate(today)
+		c.CustomFields = p.customFields(dir.schema)
+
+		// Codes and e-mails are unique across all tenants.
+		for _, key := range []struct {
+			field Field
+			value string
+		}{
+			{FieldCode, c.Code}, {FieldEmail, c.Email},
+		} {
+			if key.value == "" {
+				continue
+			}
+			k := strings.ToLower(key.value)
+			if first, dup := seen[k]; dup {
+				p.fail(key.field, fmt.Sprintf("duplicates row %d", first))
+			} else {
+				seen[k] = rowNo
+			}
+			if _, exists := dir.employees[k]; exists {
+				p.fail(key.field, fmt.Sprintf("an employee with %s %q already exists", key.field, key.value))
+			}
+		}
+
+		if ref := mapping.value(row, FieldDepartment); ref != "" {
+			id, err := dir.department(ref)
+			if err != nil {
+				p.fail(FieldDepartment, err.Error())
+			} else {
+				c.DepartmentID = &id
+			}
+		}
+
+		e := &entry{candidate: c, manager: mapping.value(row, FieldManager)}
+		for _, k := range []string{c.Code, c.Email} {
+			if k = strings.ToLower(k); k != "" {
+				if _, taken := byKey[k]; !taken {
+					byKey[k] = e
+				}
+			}
+		}
+
+		if len(p.errs) > 0 {
+			report.Errors = append(report.Errors, p.errs...)
+			failed[rowNo] = true
+			continue
+		}
+		valid = append(valid, e)
+	}
+
+	// Existing employees win over rows of the sheet.
+	for _, e := range valid {
+		if e.manager == "" {
+			continue
+		}
+		c := e.candidate
+		key := strings.ToLower(e.manager)
+
+		if id, ok := dir.managers[key]; ok {
+			c.ManagerID = &id
+			continue
+		}
+
+		in, ok := byKey[key]
+		switch {
+		case !ok:
+			report.Errors = append(report.Errors, RowError{Row: c.Row, Column: string(FieldManager), Message: fmt.Sprintf("manager %q not found", e.manager)})
+			failed[c.Row] = true
+		case in == e:
+			report.Errors = append(report.Errors, RowError{Row: c.Row, Column: string(FieldManager), Message: "an employee cannot manage themselves"})
+			failed[c.Row] = true
+		default:
+			c.ManagerRow = in.candidate.Row
+		}
+	}
+
+	report.Candidates = orderByManager(valid, failed, report)
+	report.ValidRows = len(report.Candidates)
+
+	sort.SliceStable(report.Errors, func(i, j int) bool {
+		return report.Errors[i].Row < report.Errors[j].Row
+	})
+	return report, nil
+}
+
+// orderByManager places every candidate after its manager from the sheet
+// and rejects rows whose manager cannot be imported or that report to
+// each other in a cycle.
+func orderByManager(valid []*entry, failed map[int]bool, report *Report) []*Candidate {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	byRow := map[int]*entry{}
+	for _, e := range valid {
+		byRow[e.candidate.Row] = e
+	}
+
+	state := map[int]int{}
+	ordered := []*Candidate{}
+
+	reject := func(row int, msg string) {
+		report.Errors = append(report.Errors, RowError{Row: row, Column: string(FieldManager), Message: msg})
+		failed[row] = true
+	}
+
+	var visit func(e *entry) bool
+	visit = func(e *entry) bool {
+		row := e.candidate.Row
+		switch state[row] {
+		case done:
+			return !failed[row]
+		case visiting:
+			return false
+		}
+		state[row] = visiting
+
+		if m := e.candidate.ManagerRow; m != 0 {
+			manager, ok := byRow[m]
+			switch {
+			case !ok || failed[m]:
+				reject(row, fmt.Sprintf("manager on row %d cannot be imported", m))
+			case state[m] == visiting:
+				reject(row, "reporting line forms a cycle")
+			case !visit(manager):
+				reject(row, fmt.Sprintf("manager on row %d cannot be imported", m))
+			}
+		}
+
+		state[row] = done
+		if failed[row] {
+			return false
+		}
+		ordered = append(ordered, e.candidate)
+		return true
+	}
+
+	for _, e := range valid {
+		if !failed[e.candidate.Row] {
+			visit(e)
+		}
+	}
+
+	return ordered
+}
+
+func blank(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// rowParser collects the errors of one row while reading its cells.
+type rowParser struct {
+	row     []string
+	rowNo   int
+	mapping Mapping
+	opts    Options
+	errs    []RowError
+}
+
+func (p *rowParser) fail(field Field, msg string) {
+	p.errs = append(p.errs, RowError{Row: p.rowNo, Column: string(field), Message: msg})
+}
+
+func (p *rowParser) text(field Field, required bool) string {
+	v := p.mapping.value(p.row, field)
+	if v == "" && required {
+		p.fail(field, "is required")
+	}
+	return v
+}
+
+func (p *rowParser) candidate(today time.Time) *Candidate {
+	c := &Candidate{
+		Row:            p.rowNo,
+		Code:           p.text(FieldCode, true),
+		FirstName:      p.text(FieldFirstName, true),
+		LastName:       p.text(FieldLastName, true),
+		Email:          p.text(FieldEmail, true),
+		Phone:          p.text(FieldPhone, false),
+		Position:       p.text(FieldPosition, true),
+		EmploymentType: employeedomain.FullTime,
+		JoinDate:       today,
+	}
+
+	if c.Email != "" {
+		if addr, err := mail.ParseAddress(c.Email); err != nil || addr.Address != c.Email {
+			p.fail(FieldEmail, "is not a valid e-mail address")
+		}
+	}
+
+	if v := p.text(FieldDateOfBirth, false); v != "" {
+		if d, err := parseDate(v); err != nil {
+			p.fail(FieldDateOfBirth, err.Error())
+		} else {
+			c.DateOfBirth = &d
+		}
+	}
+
+	if v := p.text(FieldJoinDate, false); v != "" {
+		if d, err := parseDate(v); err != nil {
+			p.fail(FieldJoinDate, err.Error())
+		} else {
+			c.JoinDate = d
+		}
+	}
+
+	if v := p.text(FieldEmploymentType, false); v != "" {
+		t := employeedomain.EmploymentType(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(v), " ", "_")))
+		switch t {
+		case employeedomain.FullTime, employeedomain.PartTime, employeedomain.Intern, employeedomain.Contract:
+			c.EmploymentType = t
+		default:
+			p.fail(FieldEmploymentType, "must be FULL_TIME, PART_TIME, INTERN or CONTRACT")
+		}
+	}
+
+	currency := p.opts.Currency
+	if v := p.text(FieldCurrency, false); v != "" {
+		parsed, err := money.ParseCurrency(v)
+		if err != nil {
+			p.fail(FieldCurrency, err.Error())
+		} else {
+			currency = parsed
+		}
+	}
+
+	if v := p.text(FieldBaseSalary, true); v != "" {
+		salary, err := money.Parse(v, currency)
+		switch {
+		case err != nil:
+			p.fail(FieldBaseSalary, "is not a valid amount")
+		case salary.IsNegative():
+			p.fail(FieldBaseSalary, "cannot be negative")
+		default:
+			c.BaseSalary = salary
+		}
+	}
+
+	if p.opts.CreateUsers {
+		c.Role = p.opts.DefaultRole
+		if v := p.text(FieldRole, false); v != "" {
+			c.Role = userdomain.UserRole(strings.ToUpper(v))
+			if !validRole(c.Role) {
+				p.fail(FieldRole, ErrInvalidRole.Error())
+			}
+		}
+	}
+
+	return c
+}
+
+// customFields reads the custom field cells. Dates may be written like
+// the other dates of the sheet.
+func (p *rowParser) customFields(schema customfielddomain.Schema) map[string]any {
+	values := map[string]any{}
+	invalid := map[string]bool{}
+
+	for _, d := range schema {
+		key, field := d.Key, CustomField(d.Key)
+		if _, mapped := p.mapping[field]; !mapped {
+			continue
+		}
+
+		cell := p.mapping.value(p.row, field)
+		if d.Type == customfielddomain.TypeDate && cell != "" {
+			date, err := parseDate(cell)
+			if err != nil {
+				p.fail(field, err.Error())
+				invalid[key] = true
+				continue
+			}
+			cell = date.Format(time.DateOnly)
+		}
+
+		v, err := d.Parse(cell)
+		if err != nil {
+			p.fail(field, err.Error())
+			invalid[key] = true
+			continue
+		}
+		if v != nil {
+			values[key] = v
+		}
+	}
+
+	for _, d := range schema {
+		if _, ok := values[d.Key]; d.Required && !ok && !invalid[d.Key] {
+			p.fail(CustomField(d.Key), "is required")
+		}
+	}
+
+	return values
+}
+
+var dateLayouts = []string{time.DateOnly, "02/01/2006", "2/1/2006", "02-01-2006", "2006/01/02"}
+
+// excelEpoch is day zero of Excel serial dates; the 1900 leap year bug
+// makes it 30 December 1899.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// parseDate reads ISO dates, day-first dates as written in Vietnam, and
+// Excel serial numbers.
+func parseDate(v string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if d, err := time.Parse(layout, v); err == nil {
+			return d, nil
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(v, 64); err == nil && serial > 0 && serial < 2958466 {
+		return excelEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("date %q must be YYYY-MM-DD or DD/MM/YYYY", v)
+}
@@ -0,0 +1,26 @@
+package employeeimportrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+)
+
+var (
+	ErrImportNotFound   = errors.New("employee import not found")
+	ErrImportNotPending = errors.New("employee import is not pending")
+)
+
+type ImportRepository interface {
+	Create(ctx context.Context, i *domain.Import) error
+	// Save writes the status, progress and errors of the import.
+	Save(ctx context.Context, i *domain.Import) error
+
+	GetByID(ctx context.Context, id string) (*domain.Import, error)
+	ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Import, error)
+
+	// Claim moves a pending import to RUNNING so that only one worker
+	// processes it.
+	Claim(ctx context.Context, id string) (*domain.Import, error)
+}
@@ -0,0 +1,46 @@
+package employeeimportusecase
+
+import (
+	"context"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+)
+
+type DryRunImportUsecase struct {
+	planner sheetPlanner
+}
+
+func NewDryRunImportUsecase(
+	storage storageports.StorageService,
+	departmentRepo departmentrepository.DepartmentRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	fieldRepo customfieldrepository.DefinitionRepository,
+) *DryRunImportUsecase {
+	return &DryRunImportUsecase{planner: sheetPlanner{
+		storage:        storage,
+		departmentRepo: departmentRepo,
+		employeeRepo:   employeeRepo,
+		fieldRepo:      fieldRepo,
+	}}
+}
+
+// Execute validates the uploaded sheet at path without importing it and
+// reports every row error.
+func (uc *DryRunImportUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	path string,
+	opts domain.Options,
+) (*domain.Report, error) {
+
+	if path == "" {
+		return nil, domain.ErrPathRequired
+	}
+
+	return uc.planner.plan(ctx, tenantID, path, opts, time.Now().UTC())
+}
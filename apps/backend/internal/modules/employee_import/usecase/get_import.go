@@ -0,0 +1,32 @@
+package employeeimportusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+)
+
+type GetImportUsecase struct {
+	repo employeeimportrepository.ImportRepository
+}
+
+func NewGetImportUsecase(repo employeeimportrepository.ImportRepository) *GetImportUsecase {
+	return &GetImportUsecase{repo: repo}
+}
+
+func (uc *GetImportUsecase) Execute(ctx context.Context, id string) (*domain.Import, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+type ListImportsUsecase struct {
+	repo employeeimportrepository.ImportRepository
+}
+
+func NewListImportsUsecase(repo employeeimportrepository.ImportRepository) *ListImportsUsecase {
+	return &ListImportsUsecase{repo: repo}
+}
+
+func (uc *ListImportsUsecase) Execute(ctx context.Context, tenantID string) ([]*domain.Import, error) {
+	return uc.repo.ListByTenantID(ctx, tenantID)
+}
@@ -0,0 +1,106 @@
+package employeeimportusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	customfielddomain "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/domain"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/spreadsheet"
+)
+
+// sheetPlanner downloads an uploaded sheet and validates it against the
+// tenant's current departments, employees and custom fields.
+type sheetPlanner struct {
+	storage        storageports.StorageService
+	departmentRepo departmentrepository.DepartmentRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	fieldRepo      customfieldrepository.DefinitionRepository
+}
+
+func (p sheetPlanner) plan(
+	ctx context.Context,
+	tenantID string,
+	path string,
+	opts domain.Options,
+	today time.Time,
+) (*domain.Report, error) {
+
+	format, err := spreadsheet.FormatOf(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSheet, err)
+	}
+
+	file, err := p.storage.Download(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("download %s: %w", path, err)
+	}
+	defer file.Close()
+
+	rows, err := spreadsheet.Read(format, file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSheet, err)
+	}
+
+	schema, err := p.fieldRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, headerErrs := domain.NewMapping(rows[0], opts.Columns, schema)
+	if len(headerErrs) > 0 {
+		return &domain.Report{
+			TotalRows:  len(rows) - 1,
+			Errors:     headerErrs,
+			Candidates: []*domain.Candidate{},
+		}, nil
+	}
+
+	dir, err := p.directory(ctx, tenantID, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	y, m, d := today.Date()
+	return domain.Plan(rows, mapping, dir, opts, time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+func (p sheetPlanner) directory(
+	ctx context.Context,
+	tenantID string,
+	schema customfielddomain.Schema,
+) (*domain.Directory, error) {
+	departments, err := p.departmentRepo.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	employees, err := p.employeeRepo.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	active, err := p.employeeRepo.ListActiveByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	departmentRefs := make([]domain.DepartmentRef, 0, len(departments))
+	for _, dep := range departments {
+		departmentRefs = append(departmentRefs, domain.DepartmentRef{ID: dep.ID, Name: dep.Name})
+	}
+
+	return domain.NewDirectory(departmentRefs, employeeRefs(employees), employeeRefs(active), schema), nil
+}
+
+func employeeRefs(employees []*employeedomain.Employee) []domain.EmployeeRef {
+	refs := make([]domain.EmployeeRef, 0, len(employees))
+	for _, e := range employees {
+		refs = append(refs, domain.EmployeeRef{ID: e.ID, Code: e.Code, Email: e.Email})
+	}
+	return refs
+}
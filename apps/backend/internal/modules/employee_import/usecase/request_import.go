@@ -0,0 +1,84 @@
+package employeeimportusecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type RequestImportUsecase struct {
+	repo     employeeimportrepository.ImportRepository
+	planner  sheetPlanner
+	queueSvc queueports.QueueService
+}
+
+func NewRequestImportUsecase(
+	repo employeeimportrepository.ImportRepository,
+	storage storageports.StorageService,
+	departmentRepo departmentrepository.DepartmentRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	fieldRepo customfieldrepository.DefinitionRepository,
+	queueSvc queueports.QueueService,
+) *RequestImportUsecase {
+	return &RequestImportUsecase{
+		repo: repo,
+		planner: sheetPlanner{
+			storage:        storage,
+			departmentRepo: departmentRepo,
+			employeeRepo:   employeeRepo,
+			fieldRepo:      fieldRepo,
+		},
+		queueSvc: queueSvc,
+	}
+}
+
+// Execute checks that the sheet at path can be read and mapped, then
+// queues the import for the worker. Row errors do not stop the import;
+// the rows are skipped and reported on the import.
+func (uc *RequestImportUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	path string,
+	opts domain.Options,
+	requestedBy string,
+) (*domain.Import, error) {
+
+	imp, err := domain.NewImport(tenantID, path, opts, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.planner.plan(ctx, tenantID, path, imp.Options, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := report.SheetError(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, imp); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(worker.ImportEmployeesPayload{ImportID: imp.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.queueSvc.Publish(ctx, worker.ImportEmployeesTopic, queueports.Message{
+		Body: data,
+	}); err != nil {
+		return nil, err
+	}
+
+	return imp, nil
+}
@@ -0,0 +1,167 @@
+package employeeimportusecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	customfieldrepository "github.com/smart-hmm/smart-hmm/internal/modules/custom_field/repository"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	employeeusecase "github.com/smart-hmm/smart-hmm/internal/modules/employee/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employee_import/domain"
+	employeeimportrepository "github.com/smart-hmm/smart-hmm/internal/modules/employee_import/repository"
+)
+
+// progressEvery is how many rows are imported between progress saves.
+const progressEvery = 10
+
+type RunImportUsecase struct {
+	repo      employeeimportrepository.ImportRepository
+	planner   sheetPlanner
+	onboardUC *employeeusecase.OnboardEmployeeUsecase
+}
+
+func NewRunImportUsecase(
+	repo employeeimportrepository.ImportRepository,
+	storage storageports.StorageService,
+	departmentRepo departmentrepository.DepartmentRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	fieldRepo customfieldrepository.DefinitionRepository,
+	onboardUC *employeeusecase.OnboardEmployeeUsecase,
+) *RunImportUsecase {
+	return &RunImportUsecase{
+		repo: repo,
+		planner: sheetPlanner{
+			storage:        storage,
+			departmentRepo: departmentRepo,
+			employeeRepo:   employeeRepo,
+			fieldRepo:      fieldRepo,
+		},
+		onboardUC: onboardUC,
+	}
+}
+
+// Execute imports the sheet of a pending import. The sheet is validated
+// again since employees may have changed since it was requested; every
+// valid row is onboarded on its own, so one failing row does not undo
+// the others.
+func (uc *RunImportUsecase) Execute(ctx context.Context, importID string) error {
+	imp, err := uc.repo.Claim(ctx, importID)
+	if errors.Is(err, employeeimportrepository.ErrImportNotPending) {
+		log.Println("employee import already processed:", importID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	report, err := uc.planner.plan(ctx, imp.TenantID, imp.FilePath, imp.Options, time.Now().UTC())
+	if err == nil {
+		err = report.SheetError()
+	}
+	if err != nil {
+		// The import is no longer pending; a retry would not pick it up.
+		imp.Fail(err)
+		return uc.repo.Save(ctx, imp)
+	}
+
+	imp.Start(report.TotalRows, report.Errors)
+	if err := uc.repo.Save(ctx, imp); err != nil {
+		return err
+	}
+
+	opts := imp.Options
+	imported := map[int]string{}
+
+	for n, c := range report.Candidates {
+		if err := uc.importRow(ctx, imp.TenantID, opts, c, imported); err != nil {
+			imp.RowFailed(c.Row, err)
+		} else {
+			imp.RowImported()
+		}
+
+		if (n+1)%progressEvery == 0 {
+			if err := uc.repo.Save(ctx, imp); err != nil {
+				return err
+			}
+		}
+	}
+
+	imp.Complete()
+	return uc.repo.Save(ctx, imp)
+}
+
+func (uc *RunImportUsecase) importRow(
+	ctx context.Context,
+	tenantID string,
+	opts domain.Options,
+	c *domain.Candidate,
+	imported map[int]string,
+) error {
+
+	managerID := c.ManagerID
+	if c.ManagerRow != 0 {
+		id, ok := imported[c.ManagerRow]
+		if !ok {
+			return fmt.Errorf("manager on row %d was not imported", c.ManagerRow)
+		}
+		managerID = &id
+	}
+
+	input := employeeusecase.OnboardEmployeeInput{
+		Code:           c.Code,
+		FirstName:      c.FirstName,
+		LastName:       c.LastName,
+		Email:          c.Email,
+		Phone:          c.Phone,
+		Position:       c.Position,
+		BaseSalary:     c.BaseSalary,
+		TenantID:       tenantID,
+		DateOfBirth:    c.DateOfBirth,
+		DepartmentID:   c.DepartmentID,
+		ManagerID:      managerID,
+		JoinDate:       c.JoinDate,
+		EmploymentType: c.EmploymentType,
+		CustomFields:   c.CustomFields,
+	}
+
+	if opts.CreateUsers {
+		password, err := temporaryPassword()
+		if err != nil {
+			return err
+		}
+
+		input.CreateUser = true
+		input.UserEmail = c.Email
+		input.Password = password
+		input.Role = c.Role
+	}
+
+	employee, err := uc.onboardUC.Execute(ctx, input, opts.CreateUsers)
+	if err != nil {
+		return err
+	}
+
+	imported[c.Row] = employee.ID
+	return nil
+}
+
+// temporaryPassword returns a random password for an imported user. It
+// is only ever sent in the onboarding e-mail.
+func temporaryPassword() (string, error) {
+	const alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnpqrstuvwxyz23456789"
+
+	buf := make([]byte, 14)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = alphabet[int(b)%len(alphabet)]
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+)
+
+type ChangeReason string
+
+const (
+	ReasonHire           ChangeReason = "HIRE"
+	ReasonPromotion      ChangeReason = "PROMOTION"
+	ReasonTransfer       ChangeReason = "TRANSFER"
+	ReasonDemotion       ChangeReason = "DEMOTION"
+	ReasonReorganization ChangeReason = "REORGANIZATION"
+	ReasonStatusChange   ChangeReason = "STATUS_CHANGE"
+	ReasonCorrection     ChangeReason = "CORRECTION"
+)
+
+var (
+	ErrInvalidReason         = errors.New("reason must be HIRE, PROMOTION, TRANSFER, DEMOTION, REORGANIZATION, STATUS_CHANGE or CORRECTION")
+	ErrEffectiveDate         = errors.New("effective date is required")
+	ErrPositionRequired      = errors.New("position is required")
+	ErrInvalidEmploymentType = errors.New("employment type must be FULL_TIME, PART_TIME, INTERN or CONTRACT")
+	ErrInvalidStatus         = errors.New("employment status must be ACTIVE, PROBATION, INACTIVE or RESIGNED")
+	ErrSelfManager           = errors.New("an employee cannot be their own manager")
+	ErrReportingCycle        = errors.New("the manager reports to the employee, directly or through others")
+	ErrManagerOtherTenant    = errors.New("the manager belongs to another tenant")
+	ErrChangedByRequired     = errors.New("the user making the change is required")
+	ErrNoJobChange           = errors.New("the job record is identical to the one in effect")
+)
+
+// JobRecord is one entry of an employee's employment history. The job
+// applies from EffectiveFrom until the next record.
+type JobRecord struct {
+	ID         string `json:"id"`
+	EmployeeID string `json:"employee_id"`
+
+	EffectiveFrom    time.Time                       `json:"effective_from"` // date, UTC midnight
+	Position         string                          `json:"position"`
+	DepartmentID     *string                         `json:"department_id,omitempty"`
+	ManagerID        *string                         `json:"manager_id,omitempty"`
+	EmploymentType   employeedomain.EmploymentType   `json:"employment_type"`
+	EmploymentStatus employeedomain.EmploymentStatus `json:"employment_status"`
+
+	Reason ChangeReason `json:"reason"`
+	Note   *string      `json:"note,omitempty"`
+
+	// ChangedBy is empty for the hire record written with the employee.
+	ChangedBy string    `json:"changed_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Read-only fields filled by report queries.
+	EmployeeCode     string  `json:"employee_code,omitempty"`
+	EmployeeName     string  `json:"employee_name,omitempty"`
+	DepartmentName   *string `json:"department_name,omitempty"`
+	PreviousPosition *string `json:"previous_position,omitempty"`
+}
+
+type NewJobRecordInput struct {
+	EmployeeID       string
+	EffectiveFrom    time.Time
+	Position         string
+	DepartmentID     *string
+	ManagerID        *string
+	EmploymentType   employeedomain.EmploymentType
+	EmploymentStatus employeedomain.EmploymentStatus
+	Reason           ChangeReason
+	Note             *string
+	ChangedBy        string
+}
+
+func NewJobRecord(in NewJobRecordInput) (*JobRecord, error) {
+	if in.EmployeeID == "" {
+		return nil, errors.New("employeeID is required")
+	}
+	if in.EffectiveFrom.IsZero() {
+		return nil, ErrEffectiveDate
+	}
+	if in.Position == "" {
+		return nil, ErrPositionRequired
+	}
+	if in.ManagerID != nil && *in.ManagerID == in.EmployeeID {
+		return nil, ErrSelfManager
+	}
+
+	switch in.EmploymentType {
+	case employeedomain.FullTime, employeedomain.PartTime, employeedomain.Intern, employeedomain.Contract:
+	default:
+		return nil, ErrInvalidEmploymentType
+	}
+
+	switch in.EmploymentStatus {
+	case employeedomain.Active, employeedomain.Probation, employeedomain.Inactive, employeedomain.Resigned:
+	default:
+		return nil, ErrInvalidStatus
+	}
+
+	switch in.Reason {
+	case ReasonHire:
+	case ReasonPromotion, ReasonTransfer, ReasonDemotion, ReasonReorganization,
+		ReasonStatusChange, ReasonCorrection:
+		if in.ChangedBy == "" {
+			return nil, ErrChangedByRequired
+		}
+	default:
+		return nil, ErrInvalidReason
+	}
+
+	y, m, d := in.EffectiveFrom.Date()
+
+	return &JobRecord{
+		EmployeeID:       in.EmployeeID,
+		EffectiveFrom:    time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		Position:         in.Position,
+		DepartmentID:     in.DepartmentID,
+		ManagerID:        in.ManagerID,
+		EmploymentType:   in.EmploymentType,
+		EmploymentStatus: in.EmploymentStatus,
+		Reason:           in.Reason,
+		Note:             in.Note,
+		ChangedBy:        in.ChangedBy,
+		CreatedAt:        time.Now().UTC(),
+	}, nil
+}
+
+// HireRecord opens the history of a new employee with their job at hire.
+func HireRecord(e *employeedomain.Employee) (*JobRecord, error) {
+	return NewJobRecord(NewJobRecordInput{
+		EmployeeID:       e.ID,
+		EffectiveFrom:    e.JoinDate,
+		Position:         e.Position,
+		DepartmentID:     e.DepartmentID,
+		ManagerID:        e.ManagerID,
+		EmploymentType:   e.EmploymentType,
+		EmploymentStatus: e.EmploymentStatus,
+		Reason:           ReasonHire,
+	})
+}
+
+// SameJob reports whether two records describe the same job.
+func (r *JobRecord) SameJob(other *JobRecord) bool {
+	return r.Position == other.Position &&
+		equalID(r.DepartmentID, other.DepartmentID) &&
+		equalID(r.ManagerID, other.ManagerID) &&
+		r.EmploymentType == other.EmploymentType &&
+		r.EmploymentStatus == other.EmploymentStatus
+}
+
+func equalID(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// History is an employee's job records, in any order.
+type History []*JobRecord
+
+// On returns the record in effect on day. Later entries win over earlier
+// ones with the same effective date. ok is false before the first record.
+func (h History) On(day time.Time) (*JobRecord, bool) {
+	var current *JobRecord
+	for _, r := range h {
+		if r.EffectiveFrom.After(day) {
+			continue
+		}
+		if current == nil ||
+			r.EffectiveFrom.After(current.EffectiveFrom) ||
+			(r.EffectiveFrom.Equal(current.EffectiveFrom) && r.CreatedAt.After(current.CreatedAt)) {
+			current = r
+		}
+	}
+
+	return current, current != nil
+}
+
+// NextAfter returns the effective date of the first record after day.
+// ok is false when no record follows it.
+func (h History) NextAfter(day time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, r := range h {
+		if r.EffectiveFrom.After(day) && (next.IsZero() || r.EffectiveFrom.Before(next)) {
+			next = r.EffectiveFrom
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// ReportingLines maps employees to their managers on one day.
+type ReportingLines map[string]string
+
+// CheckManager rejects managerID as the manager of employeeID when it is
+// the employee or reports to them, directly or through others.
+func (l ReportingLines) CheckManager(employeeID, managerID string) error {
+	if managerID == employeeID {
+		return ErrSelfManager
+	}
+
+	seen := map[string]bool{}
+	for id := l[managerID]; id != ""; id = l[id] {
+		if id == employeeID {
+			return ErrReportingCycle
+		}
+		// A loop above the manager that the employee is not part of.
+		if seen[id] {
+			break
+		}
+		seen[id] = true
+	}
+
+	return nil
+}
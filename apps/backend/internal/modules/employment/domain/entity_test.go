@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func record(from, position string, created int) *JobRecord {
+	return &JobRecord{
+		EffectiveFrom: day(from),
+		Position:      position,
+		CreatedAt:     time.Date(2025, 1, 1, created, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHistoryOn(t *testing.T) {
+	history := History{
+		record("2025-01-01", "Engineer", 0),
+		record("2025-06-01", "Senior Engineer", 0),
+		record("2025-06-01", "Lead Engineer", 1),
+	}
+
+	tests := []struct {
+		day    string
+		want   string
+		wantOK bool
+	}{
+		{day: "2024-12-31"},
+		{day: "2025-01-01", want: "Engineer", wantOK: true},
+		{day: "2025-05-31", want: "Engineer", wantOK: true},
+		{day: "2025-06-01", want: "Lead Engineer", wantOK: true},
+		{day: "2026-01-01", want: "Lead Engineer", wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			got, ok := history.On(day(tt.day))
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && got.Position != tt.want {
+				t.Fatalf("position = %s, want %s", got.Position, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryNextAfter(t *testing.T) {
+	history := History{
+		record("2025-06-01", "Senior Engineer", 0),
+		record("2025-01-01", "Engineer", 0),
+		record("2025-09-01", "Lead Engineer", 0),
+	}
+
+	tests := []struct {
+		day    string
+		want   string
+		wantOK bool
+	}{
+		{day: "2024-12-31", want: "2025-01-01", wantOK: true},
+		{day: "2025-01-01", want: "2025-06-01", wantOK: true},
+		{day: "2025-07-15", want: "2025-09-01", wantOK: true},
+		{day: "2025-09-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.day, func(t *testing.T) {
+			got, ok := history.NextAfter(day(tt.day))
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(day(tt.want)) {
+				t.Fatalf("next = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportingLinesCheckManager(t *testing.T) {
+	// ceo <- cto <- lead <- dev; ops and audit manage each other.
+	lines := ReportingLines{
+		"cto":   "ceo",
+		"lead":  "cto",
+		"dev":   "lead",
+		"ops":   "audit",
+		"audit": "ops",
+	}
+
+	tests := []struct {
+		name     string
+		employee string
+		manager  string
+		wantErr  error
+	}{
+		{name: "own manager", employee: "dev", manager: "dev", wantErr: ErrSelfManager},
+		{name: "direct report", employee: "lead", manager: "dev", wantErr: ErrReportingCycle},
+		{name: "indirect report", employee: "ceo", manager: "dev", wantErr: ErrReportingCycle},
+		{name: "manager above", employee: "dev", manager: "ceo"},
+		{name: "other branch", employee: "lead", manager: "ops"},
+		{name: "manager without a line", employee: "dev", manager: "new"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := lines.CheckManager(tt.employee, tt.manager)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckManager() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package employmentrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+)
+
+type JobRecordRepository interface {
+	Create(ctx context.Context, r *domain.JobRecord) error
+	ListByEmployeeID(ctx context.Context, employeeID string) (domain.History, error)
+
+	// ListInDepartmentOn returns the records in effect on day that place
+	// the tenant's employees in the department.
+	ListInDepartmentOn(ctx context.Context, tenantID, departmentID string, day time.Time) ([]*domain.JobRecord, error)
+	// ListOn returns the records in effect on day for the tenant's
+	// employees.
+	ListOn(ctx context.Context, tenantID string, day time.Time) ([]*domain.JobRecord, error)
+	// LockReportingLines serialises manager changes of the tenant until
+	// the surrounding transaction ends; it must be called inside one.
+	LockReportingLines(ctx context.Context, tenantID string) error
+	// EffectiveDatesAfter returns the distinct dates after day on which
+	// records of the tenant's employees take effect, earliest first.
+	EffectiveDatesAfter(ctx context.Context, tenantID string, day time.Time) ([]time.Time, error)
+	// ReportingLinesOn returns the managers of the tenant's employees
+	// from the records in effect on day.
+	ReportingLinesOn(ctx context.Context, tenantID string, day time.Time) (domain.ReportingLines, error)
+	// ListByReason returns the tenant's records with the reason effective
+	// in [from, to], each with the position it replaced.
+	ListByReason(ctx context.Context, tenantID string, reason domain.ChangeReason, from, to time.Time) ([]*domain.JobRecord, error)
+}
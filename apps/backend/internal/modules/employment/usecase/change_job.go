@@ -0,0 +1,145 @@
+package employmentusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type ChangeJobUsecase struct {
+	repo         employmentrepository.JobRecordRepository
+	employeeRepo employeerepository.EmployeeRepository
+	txManager    txpkg.Manager
+}
+
+func NewChangeJobUsecase(
+	repo employmentrepository.JobRecordRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	txManager txpkg.Manager,
+) *ChangeJobUsecase {
+	return &ChangeJobUsecase{repo: repo, employeeRepo: employeeRepo, txManager: txManager}
+}
+
+// ChangeJobInput is the employee's complete job from EffectiveFrom on.
+type ChangeJobInput struct {
+	EmployeeID       string
+	EffectiveFrom    time.Time
+	Position         string
+	DepartmentID     *string
+	ManagerID        *string
+	EmploymentType   employeedomain.EmploymentType
+	EmploymentStatus employeedomain.EmploymentStatus
+	Reason           domain.ChangeReason
+	Note             *string
+	ChangedBy        string
+}
+
+// Execute appends a job record to the employee's history. It is the only
+// way position, department, manager, employment type and status change;
+// the employee reads whichever record is in effect today. The manager must
+// be of the same tenant and must not report to the employee on any day
+// the record is in effect.
+func (uc *ChangeJobUsecase) Execute(ctx context.Context, in ChangeJobInput) (*domain.JobRecord, error) {
+	emp, err := uc.employeeRepo.FindByID(in.EmployeeID)
+	if err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", in.EmployeeID, err)
+	}
+
+	if in.ManagerID != nil {
+		manager, err := uc.employeeRepo.FindByID(*in.ManagerID)
+		if err != nil {
+			return nil, fmt.Errorf("load manager %s: %w", *in.ManagerID, err)
+		}
+		if manager.TenantID != emp.TenantID {
+			return nil, domain.ErrManagerOtherTenant
+		}
+	}
+
+	record, err := domain.NewJobRecord(domain.NewJobRecordInput{
+		EmployeeID:       in.EmployeeID,
+		EffectiveFrom:    in.EffectiveFrom,
+		Position:         in.Position,
+		DepartmentID:     in.DepartmentID,
+		ManagerID:        in.ManagerID,
+		EmploymentType:   in.EmploymentType,
+		EmploymentStatus: in.EmploymentStatus,
+		Reason:           in.Reason,
+		Note:             in.Note,
+		ChangedBy:        in.ChangedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// Two changes checked at once could each close half of a cycle.
+		if record.ManagerID != nil {
+			if err := uc.repo.LockReportingLines(txCtx, emp.TenantID); err != nil {
+				return err
+			}
+		}
+
+		history, err := uc.repo.ListByEmployeeID(txCtx, in.EmployeeID)
+		if err != nil {
+			return err
+		}
+
+		if current, ok := history.On(record.EffectiveFrom); ok && current.SameJob(record) {
+			return domain.ErrNoJobChange
+		}
+
+		if record.ManagerID != nil {
+			if err := uc.checkManager(txCtx, emp.TenantID, record, history); err != nil {
+				return err
+			}
+		}
+
+		return uc.repo.Create(txCtx, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// checkManager checks the record's manager against the reporting lines
+// of every day it is in effect: its effective date and each later date
+// another record of the tenant takes effect, until the employee's next
+// record.
+func (uc *ChangeJobUsecase) checkManager(
+	ctx context.Context,
+	tenantID string,
+	record *domain.JobRecord,
+	history domain.History,
+) error {
+
+	later, err := uc.repo.EffectiveDatesAfter(ctx, tenantID, record.EffectiveFrom)
+	if err != nil {
+		return err
+	}
+
+	next, replaced := history.NextAfter(record.EffectiveFrom)
+
+	for _, day := range append([]time.Time{record.EffectiveFrom}, later...) {
+		if replaced && !day.Before(next) {
+			break
+		}
+
+		lines, err := uc.repo.ReportingLinesOn(ctx, tenantID, day)
+		if err != nil {
+			return err
+		}
+		if err := lines.CheckManager(record.EmployeeID, *record.ManagerID); err != nil {
+			return fmt.Errorf("%w on %s", err, day.Format(time.DateOnly))
+		}
+	}
+
+	return nil
+}
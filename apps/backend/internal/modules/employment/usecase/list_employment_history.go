@@ -0,0 +1,86 @@
+package employmentusecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+)
+
+type ListEmploymentHistoryUsecase struct {
+	repo employmentrepository.JobRecordRepository
+}
+
+func NewListEmploymentHistoryUsecase(repo employmentrepository.JobRecordRepository) *ListEmploymentHistoryUsecase {
+	return &ListEmploymentHistoryUsecase{repo: repo}
+}
+
+func (uc *ListEmploymentHistoryUsecase) Execute(ctx context.Context, employeeID string) (domain.History, error) {
+	history, err := uc.repo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	if history == nil {
+		history = domain.History{}
+	}
+	return history, nil
+}
+
+type ListDepartmentMembersOnUsecase struct {
+	repo employmentrepository.JobRecordRepository
+}
+
+func NewListDepartmentMembersOnUsecase(repo employmentrepository.JobRecordRepository) *ListDepartmentMembersOnUsecase {
+	return &ListDepartmentMembersOnUsecase{repo: repo}
+}
+
+// Execute answers who was in the department on day, with the job each
+// member held then.
+func (uc *ListDepartmentMembersOnUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	departmentID string,
+	day time.Time,
+) ([]*domain.JobRecord, error) {
+
+	records, err := uc.repo.ListInDepartmentOn(ctx, tenantID, departmentID, day)
+	if err != nil {
+		return nil, err
+	}
+	if records == nil {
+		records = []*domain.JobRecord{}
+	}
+	return records, nil
+}
+
+type ListJobChangesUsecase struct {
+	repo employmentrepository.JobRecordRepository
+}
+
+func NewListJobChangesUsecase(repo employmentrepository.JobRecordRepository) *ListJobChangesUsecase {
+	return &ListJobChangesUsecase{repo: repo}
+}
+
+// Execute lists the changes with the reason effective in [from, to], such
+// as the promotions of a year.
+func (uc *ListJobChangesUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	reason domain.ChangeReason,
+	from, to time.Time,
+) ([]*domain.JobRecord, error) {
+
+	if to.Before(from) {
+		from, to = to, from
+	}
+
+	records, err := uc.repo.ListByReason(ctx, tenantID, reason, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if records == nil {
+		records = []*domain.JobRecord{}
+	}
+	return records, nil
+}
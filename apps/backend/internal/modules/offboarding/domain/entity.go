@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type Reason string
+
+const (
+	ReasonResignation     Reason = "RESIGNATION"
+	ReasonDismissal       Reason = "DISMISSAL"
+	ReasonEndOfContract   Reason = "END_OF_CONTRACT"
+	ReasonRetirement      Reason = "RETIREMENT"
+	ReasonMutualAgreement Reason = "MUTUAL_AGREEMENT"
+)
+
+type Status string
+
+const (
+	StatusInProgress Status = "IN_PROGRESS"
+	StatusCompleted  Status = "COMPLETED"
+)
+
+type TaskKind string
+
+const (
+	TaskAssetReturn      TaskKind = "ASSET_RETURN"
+	TaskAccessRevocation TaskKind = "ACCESS_REVOCATION"
+	TaskFinalSettlement  TaskKind = "FINAL_SETTLEMENT"
+)
+
+var (
+	ErrInvalidReason       = errors.New("reason must be RESIGNATION, DISMISSAL, END_OF_CONTRACT, RETIREMENT or MUTUAL_AGREEMENT")
+	ErrLastWorkingDay      = errors.New("last working day is required")
+	ErrBeforeJoinDate      = errors.New("last working day cannot be before the join date")
+	ErrAlreadyResigned     = errors.New("the employee has already left on the last working day")
+	ErrLaterJobChange      = errors.New("the employee has job changes after the last working day")
+	ErrInitiatorRequired   = errors.New("the user starting the offboarding is required")
+	ErrTaskNotFound        = errors.New("checklist item not found")
+	ErrTaskAlreadyDone     = errors.New("checklist item is already done")
+	ErrOffboardingComplete = errors.New("offboarding is already completed")
+)
+
+// Task is one item of the offboarding checklist.
+type Task struct {
+	ID            string     `json:"id"`
+	OffboardingID string     `json:"offboarding_id"`
+	Kind          TaskKind   `json:"kind"`
+	Title         string     `json:"title"`
+	Position      int        `json:"position"`
+	DoneAt        *time.Time `json:"done_at,omitempty"`
+	DoneBy        *string    `json:"done_by,omitempty"`
+	Note          *string    `json:"note,omitempty"`
+}
+
+func (t *Task) Done() bool {
+	return t.DoneAt != nil
+}
+
+// defaultTasks is the checklist every offboarding starts with.
+var defaultTasks = []struct {
+	kind  TaskKind
+	title string
+}{
+	{TaskAssetReturn, "Collect company assets (laptop, badge, keys)"},
+	{TaskAccessRevocation, "Revoke access to company systems and accounts"},
+	{TaskFinalSettlement, "Settle final pay, leave payout and social insurance book"},
+}
+
+// Offboarding is the departure of an employee. The employee stays on
+// record: the offboarding ends their employment with a RESIGNED job
+// change effective the day after LastWorkingDay, and keeps the checklist
+// and leave payout for the final settlement.
+type Offboarding struct {
+	ID         string `json:"id"`
+	TenantID   string `json:"tenant_id"`
+	EmployeeID string `json:"employee_id"`
+
+	LastWorkingDay time.Time `json:"last_working_day"` // date, UTC midnight
+	Reason         Reason    `json:"reason"`
+	Note           *string   `json:"note,omitempty"`
+	Status         Status    `json:"status"`
+
+	Tasks []*Task `json:"tasks"`
+
+	// LeaveBalances and LeavePayout are computed when the offboarding
+	// starts, as of LastWorkingDay. A termination run pays LeavePayout as
+	// a taxable LEAVE_PAYOUT line.
+	LeaveBalances []LeaveBalance `json:"leave_balances"`
+	LeavePayout   money.Money    `json:"leave_payout"`
+
+	// SessionsRevokedAt is set once the employee's sessions have been
+	// revoked on or after LastWorkingDay.
+	SessionsRevokedAt *time.Time `json:"sessions_revoked_at,omitempty"`
+
+	InitiatedBy string     `json:"initiated_by"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+
+	// Read-only, filled by queries.
+	EmployeeCode string  `json:"employee_code,omitempty"`
+	EmployeeName string  `json:"employee_name,omitempty"`
+	UserID       *string `json:"-"`
+}
+
+type OffboardingInput struct {
+	LastWorkingDay time.Time
+	Reason         Reason
+	Note           *string
+}
+
+func NewOffboarding(tenantID, employeeID string, in OffboardingInput, initiatedBy string) (*Offboarding, error) {
+	if tenantID == "" || employeeID == "" {
+		return nil, errors.New("tenantID and employeeID are required")
+	}
+	if initiatedBy == "" {
+		return nil, ErrInitiatorRequired
+	}
+	if in.LastWorkingDay.IsZero() {
+		return nil, ErrLastWorkingDay
+	}
+
+	switch in.Reason {
+	case ReasonResignation, ReasonDismissal, ReasonEndOfContract, ReasonRetirement, ReasonMutualAgreement:
+	default:
+		return nil, ErrInvalidReason
+	}
+
+	y, m, d := in.LastWorkingDay.Date()
+	now := time.Now().UTC()
+
+	o := &Offboarding{
+		TenantID:       tenantID,
+		EmployeeID:     employeeID,
+		LastWorkingDay: time.Date(y, m, d, 0, 0, 0, 0, time.UTC),
+		Reason:         in.Reason,
+		Note:           in.Note,
+		Status:         StatusInProgress,
+		InitiatedBy:    initiatedBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	for i, t := range defaultTasks {
+		o.Tasks = append(o.Tasks, &Task{
+			Kind:     t.kind,
+			Title:    t.title,
+			Position: i,
+		})
+	}
+
+	return o, nil
+}
+
+// EndDate is the first day the employee no longer works, when their
+// RESIGNED job record takes effect.
+func (o *Offboarding) EndDate() time.Time {
+	return o.LastWorkingDay.AddDate(0, 0, 1)
+}
+
+// Task returns the checklist item with id.
+func (o *Offboarding) Task(id string) (*Task, error) {
+	for _, t := range o.Tasks {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, ErrTaskNotFound
+}
+
+// CompleteTask ticks off a checklist item.
+func (o *Offboarding) CompleteTask(id, userID string, note *string) (*Task, error) {
+	if o.Status == StatusCompleted {
+		return nil, ErrOffboardingComplete
+	}
+
+	t, err := o.Task(id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Done() {
+		return nil, ErrTaskAlreadyDone
+	}
+
+	now := time.Now().UTC()
+	t.DoneAt = &now
+	t.DoneBy = &userID
+	t.Note = note
+
+	o.UpdatedAt = now
+	o.completeIfDone(now)
+	return t, nil
+}
+
+// SessionsRevoked records that the employee has been logged out
+// everywhere.
+func (o *Offboarding) SessionsRevoked(at time.Time) {
+	o.SessionsRevokedAt = &at
+	o.UpdatedAt = at
+	o.completeIfDone(at)
+}
+
+// completeIfDone closes the offboarding once every checklist item is done
+// and the sessions are revoked.
+func (o *Offboarding) completeIfDone(now time.Time) {
+	if o.SessionsRevokedAt == nil {
+		return
+	}
+	for _, t := range o.Tasks {
+		if !t.Done() {
+			return
+		}
+	}
+
+	o.Status = StatusCompleted
+	o.CompletedAt = &now
+}
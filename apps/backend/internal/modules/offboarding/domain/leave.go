@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// LeaveBalance is the paid leave an employee has left in the year they
+// leave, as of the last working day.
+type LeaveBalance struct {
+	LeaveTypeID string  `json:"leave_type_id"`
+	LeaveType   string  `json:"leave_type"`
+	Entitled    float64 `json:"entitled"`
+	Taken       int     `json:"taken"`
+	Remaining   float64 `json:"remaining"`
+}
+
+// NewLeaveBalance accrues a yearly entitlement of defaultDays per started
+// month from accrualStart to lastDay, both in the same year, and deducts
+// the days taken. Days taken beyond the accrual are not clawed back.
+func NewLeaveBalance(
+	leaveTypeID, leaveType string,
+	defaultDays, taken int,
+	accrualStart, lastDay time.Time,
+) LeaveBalance {
+	months := 0
+	if !accrualStart.After(lastDay) {
+		months = int(lastDay.Month()-accrualStart.Month()) + 1
+	}
+
+	entitled := round2(float64(defaultDays) * float64(months) / 12)
+
+	return LeaveBalance{
+		LeaveTypeID: leaveTypeID,
+		LeaveType:   leaveType,
+		Entitled:    entitled,
+		Taken:       taken,
+		Remaining:   math.Max(0, round2(entitled-float64(taken))),
+	}
+}
+
+// Weekdays counts the days of [from, to) from Monday to Friday, the days
+// leave is taken on and payroll prorates by.
+func Weekdays(from, to time.Time) int {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	days := 0
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	return days
+}
+
+// monthWeekdays counts the weekdays of the month containing day.
+func monthWeekdays(day time.Time) int {
+	start := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return Weekdays(start, start.AddDate(0, 1, 0))
+}
+
+// LeavePayout pays the remaining days of every balance at the daily rate
+// of monthly: the salary over the weekdays of the month containing day,
+// the same standard days payroll prorates by. The remaining days are kept
+// to hundredths, so the payout is exact and rounded once.
+func LeavePayout(balances []LeaveBalance, monthly money.Money, day time.Time) (money.Money, error) {
+	var hundredths int64
+	for _, b := range balances {
+		hundredths += int64(math.Round(b.Remaining * 100))
+	}
+
+	return monthly.MulRat(hundredths, 100*int64(monthWeekdays(day)))
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func vnd(s string) money.Money {
+	m, err := money.Parse(s, money.Currency("VND"))
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestWeekdays(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		want     int
+	}{
+		{name: "monday to sunday", from: "2025-03-03", to: "2025-03-10", want: 5},
+		{name: "weekend only", from: "2025-03-08", to: "2025-03-10", want: 0},
+		{name: "march 2025", from: "2025-03-01", to: "2025-04-01", want: 21},
+		{name: "empty", from: "2025-03-03", to: "2025-03-03", want: 0},
+		{name: "reversed", from: "2025-03-10", to: "2025-03-03", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Weekdays(day(tt.from), day(tt.to)); got != tt.want {
+				t.Fatalf("Weekdays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewLeaveBalance(t *testing.T) {
+	tests := []struct {
+		name          string
+		taken         int
+		accrualStart  string
+		lastDay       string
+		wantEntitled  float64
+		wantRemaining float64
+	}{
+		{name: "whole year", accrualStart: "2025-01-01", lastDay: "2025-12-31", wantEntitled: 12, wantRemaining: 12},
+		{name: "leaves in march", taken: 1, accrualStart: "2025-01-01", lastDay: "2025-03-14", wantEntitled: 3, wantRemaining: 2},
+		{name: "joined in june", accrualStart: "2025-06-16", lastDay: "2025-07-31", wantEntitled: 2, wantRemaining: 2},
+		{name: "took more than accrued", taken: 5, accrualStart: "2025-01-01", lastDay: "2025-02-28", wantEntitled: 2, wantRemaining: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewLeaveBalance("lt-1", "Annual", 12, tt.taken, day(tt.accrualStart), day(tt.lastDay))
+			if b.Entitled != tt.wantEntitled || b.Remaining != tt.wantRemaining {
+				t.Fatalf("entitled, remaining = %v, %v, want %v, %v", b.Entitled, b.Remaining, tt.wantEntitled, tt.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestLeavePayout(t *testing.T) {
+	tests := []struct {
+		name     string
+		balances []LeaveBalance
+		monthly  string
+		day      string
+		want     string
+	}{
+		{name: "no balances", monthly: "21000000", day: "2025-03-14", want: "0"},
+		{
+			// March 2025 has 21 weekdays.
+			name:     "whole days",
+			balances: []LeaveBalance{{Remaining: 2}, {Remaining: 1}},
+			monthly:  "21000000",
+			day:      "2025-03-14",
+			want:     "3000000",
+		},
+		{
+			// 10,000,000 x 1.5 / 21 = 714,285.71..., rounded once.
+			name:     "fractional days",
+			balances: []LeaveBalance{{Remaining: 1.5}},
+			monthly:  "10000000",
+			day:      "2025-03-14",
+			want:     "714286",
+		},
+		{
+			// A daily rate rounded to 476,190 would pay 1,428,570; the
+			// exact payout is 1,428,571.43.
+			name:     "rounded once",
+			balances: []LeaveBalance{{Remaining: 1}, {Remaining: 1}, {Remaining: 1}},
+			monthly:  "10000000",
+			day:      "2025-03-14",
+			want:     "1428571",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LeavePayout(tt.balances, vnd(tt.monthly), day(tt.day))
+			if err != nil {
+				t.Fatalf("LeavePayout() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("payout = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package offboardingrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+)
+
+var (
+	ErrOffboardingNotFound = errors.New("offboarding not found")
+	ErrOffboardingExists   = errors.New("the employee is already being offboarded")
+)
+
+type OffboardingRepository interface {
+	// Create stores the offboarding with its checklist.
+	Create(ctx context.Context, o *domain.Offboarding) error
+	// Update saves the status, session revocation and checklist progress.
+	Update(ctx context.Context, o *domain.Offboarding) error
+
+	GetByID(ctx context.Context, id string) (*domain.Offboarding, error)
+	GetByEmployeeID(ctx context.Context, employeeID string) (*domain.Offboarding, error)
+	// ListByTenantID returns the tenant's offboardings, latest last
+	// working day first; an empty status lists all.
+	ListByTenantID(ctx context.Context, tenantID string, status domain.Status) ([]*domain.Offboarding, error)
+
+	// ClaimDueRevocations locks the offboardings whose last working day is
+	// on or before day and whose sessions are not revoked yet, with the
+	// employee's user. Claimed rows stay locked until the surrounding
+	// transaction ends.
+	ClaimDueRevocations(ctx context.Context, day time.Time) ([]*domain.Offboarding, error)
+}
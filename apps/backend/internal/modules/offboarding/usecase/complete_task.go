@@ -0,0 +1,42 @@
+package offboardingusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+)
+
+type CompleteTaskUsecase struct {
+	repo offboardingrepository.OffboardingRepository
+}
+
+func NewCompleteTaskUsecase(repo offboardingrepository.OffboardingRepository) *CompleteTaskUsecase {
+	return &CompleteTaskUsecase{repo: repo}
+}
+
+// Execute ticks off a checklist item. The offboarding completes once the
+// whole checklist is done and the sessions are revoked.
+func (uc *CompleteTaskUsecase) Execute(
+	ctx context.Context,
+	offboardingID string,
+	taskID string,
+	userID string,
+	note *string,
+) (*domain.Offboarding, error) {
+
+	o, err := uc.repo.GetByID(ctx, offboardingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := o.CompleteTask(taskID, userID, note); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
@@ -0,0 +1,49 @@
+package offboardingusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+)
+
+type GetOffboardingUsecase struct {
+	repo offboardingrepository.OffboardingRepository
+}
+
+func NewGetOffboardingUsecase(repo offboardingrepository.OffboardingRepository) *GetOffboardingUsecase {
+	return &GetOffboardingUsecase{repo: repo}
+}
+
+func (uc *GetOffboardingUsecase) Execute(ctx context.Context, id string) (*domain.Offboarding, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+type GetEmployeeOffboardingUsecase struct {
+	repo offboardingrepository.OffboardingRepository
+}
+
+func NewGetEmployeeOffboardingUsecase(repo offboardingrepository.OffboardingRepository) *GetEmployeeOffboardingUsecase {
+	return &GetEmployeeOffboardingUsecase{repo: repo}
+}
+
+// Execute returns the employee's latest offboarding.
+func (uc *GetEmployeeOffboardingUsecase) Execute(ctx context.Context, employeeID string) (*domain.Offboarding, error) {
+	return uc.repo.GetByEmployeeID(ctx, employeeID)
+}
+
+type ListOffboardingsUsecase struct {
+	repo offboardingrepository.OffboardingRepository
+}
+
+func NewListOffboardingsUsecase(repo offboardingrepository.OffboardingRepository) *ListOffboardingsUsecase {
+	return &ListOffboardingsUsecase{repo: repo}
+}
+
+func (uc *ListOffboardingsUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	status domain.Status,
+) ([]*domain.Offboarding, error) {
+	return uc.repo.ListByTenantID(ctx, tenantID, status)
+}
@@ -0,0 +1,62 @@
+package offboardingusecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	refreshtokenusecase "github.com/smart-hmm/smart-hmm/internal/modules/refresh_token/usecase"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type RevokeDueSessionsUsecase struct {
+	repo        offboardingrepository.OffboardingRepository
+	forceLogout *refreshtokenusecase.ForceLogoutAllUsecase
+	txManager   txpkg.Manager
+}
+
+func NewRevokeDueSessionsUsecase(
+	repo offboardingrepository.OffboardingRepository,
+	forceLogout *refreshtokenusecase.ForceLogoutAllUsecase,
+	txManager txpkg.Manager,
+) *RevokeDueSessionsUsecase {
+	return &RevokeDueSessionsUsecase{
+		repo:        repo,
+		forceLogout: forceLogout,
+		txManager:   txManager,
+	}
+}
+
+// Execute revokes the sessions of every employee whose last working day
+// is today or earlier. A failed revocation rolls the run back and leaves
+// it to the next one.
+func (uc *RevokeDueSessionsUsecase) Execute(ctx context.Context, today time.Time) error {
+	y, m, d := today.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	return uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		due, err := uc.repo.ClaimDueRevocations(txCtx, day)
+		if err != nil {
+			return err
+		}
+
+		for _, o := range due {
+			if o.UserID != nil {
+				if err := uc.forceLogout.Execute(txCtx, *o.UserID); err != nil {
+					return fmt.Errorf("revoke sessions of employee %s: %w", o.EmployeeID, err)
+				}
+			} else {
+				log.Println("no user account to revoke for offboarded employee:", o.EmployeeID)
+			}
+
+			o.SessionsRevoked(time.Now().UTC())
+			if err := uc.repo.Update(txCtx, o); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
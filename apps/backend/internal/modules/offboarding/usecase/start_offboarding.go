@@ -0,0 +1,200 @@
+package offboardingusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	employmentdomain "github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	leavedomain "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/domain"
+	leaverepository "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/repository"
+	leavetyperepository "github.com/smart-hmm/smart-hmm/internal/modules/leave_type/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type StartOffboardingUsecase struct {
+	repo          offboardingrepository.OffboardingRepository
+	employeeRepo  employeerepository.EmployeeRepository
+	jobRepo       employmentrepository.JobRecordRepository
+	salaryRepo    compensationrepository.SalaryChangeRepository
+	leaveRepo     leaverepository.LeaveRequestRepository
+	leaveTypeRepo leavetyperepository.LeaveTypeRepository
+	txManager     txpkg.Manager
+}
+
+func NewStartOffboardingUsecase(
+	repo offboardingrepository.OffboardingRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	jobRepo employmentrepository.JobRecordRepository,
+	salaryRepo compensationrepository.SalaryChangeRepository,
+	leaveRepo leaverepository.LeaveRequestRepository,
+	leaveTypeRepo leavetyperepository.LeaveTypeRepository,
+	txManager txpkg.Manager,
+) *StartOffboardingUsecase {
+	return &StartOffboardingUsecase{
+		repo:          repo,
+		employeeRepo:  employeeRepo,
+		jobRepo:       jobRepo,
+		salaryRepo:    salaryRepo,
+		leaveRepo:     leaveRepo,
+		leaveTypeRepo: leaveTypeRepo,
+		txManager:     txManager,
+	}
+}
+
+// Execute starts offboarding an employee. It records a RESIGNED job change
+// effective the day after the last working day, opens the checklist and
+// computes the payout of unused paid leave. Nothing about the employee is
+// deleted; their sessions are revoked on the last working day.
+func (uc *StartOffboardingUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	employeeID string,
+	in domain.OffboardingInput,
+	initiatedBy string,
+) (*domain.Offboarding, error) {
+
+	emp, err := uc.employeeRepo.FindByID(employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", employeeID, err)
+	}
+
+	o, err := domain.NewOffboarding(tenantID, employeeID, in, initiatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	joined := time.Date(emp.JoinDate.Year(), emp.JoinDate.Month(), emp.JoinDate.Day(), 0, 0, 0, 0, time.UTC)
+	if o.LastWorkingDay.Before(joined) {
+		return nil, domain.ErrBeforeJoinDate
+	}
+
+	history, err := uc.jobRepo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := history.On(o.LastWorkingDay)
+	if !ok {
+		return nil, domain.ErrBeforeJoinDate
+	}
+	if current.EmploymentStatus == employeedomain.Resigned {
+		return nil, domain.ErrAlreadyResigned
+	}
+	// A later record would take over from the RESIGNED one.
+	for _, r := range history {
+		if r.EffectiveFrom.After(o.LastWorkingDay) {
+			return nil, domain.ErrLaterJobChange
+		}
+	}
+
+	reason := string(o.Reason)
+	record, err := employmentdomain.NewJobRecord(employmentdomain.NewJobRecordInput{
+		EmployeeID:       employeeID,
+		EffectiveFrom:    o.EndDate(),
+		Position:         current.Position,
+		DepartmentID:     current.DepartmentID,
+		ManagerID:        current.ManagerID,
+		EmploymentType:   current.EmploymentType,
+		EmploymentStatus: employeedomain.Resigned,
+		Reason:           employmentdomain.ReasonStatusChange,
+		Note:             &reason,
+		ChangedBy:        initiatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.settleLeave(ctx, emp, o); err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		if err := uc.repo.Create(txCtx, o); err != nil {
+			return err
+		}
+		return uc.jobRepo.Create(txCtx, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	o.EmployeeCode = emp.Code
+	o.EmployeeName = emp.FirstName + " " + emp.LastName
+	return o, nil
+}
+
+// settleLeave fills the paid leave balances of the leaving year and their
+// payout at the salary in effect on the last working day.
+func (uc *StartOffboardingUsecase) settleLeave(ctx context.Context, emp *employeedomain.Employee, o *domain.Offboarding) error {
+	lastDay := o.LastWorkingDay
+	accrualStart := time.Date(lastDay.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	if emp.JoinDate.After(accrualStart) {
+		accrualStart = time.Date(emp.JoinDate.Year(), emp.JoinDate.Month(), emp.JoinDate.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	types, err := uc.leaveTypeRepo.ListAll()
+	if err != nil {
+		return err
+	}
+
+	requests, err := uc.leaveRepo.ListByEmployee(emp.ID)
+	if err != nil {
+		return err
+	}
+
+	taken := map[string]int{}
+	for _, req := range requests {
+		if req.Status != leavedomain.Approved {
+			continue
+		}
+		taken[req.LeaveTypeID] += daysWithin(req.StartDate, req.EndDate, accrualStart, o.EndDate())
+	}
+
+	o.LeaveBalances = []domain.LeaveBalance{}
+	for _, t := range types {
+		if !t.IsPaid {
+			continue
+		}
+		o.LeaveBalances = append(o.LeaveBalances,
+			domain.NewLeaveBalance(t.ID, t.Name, t.DefaultDays, taken[t.ID], accrualStart, lastDay),
+		)
+	}
+
+	salaries, err := uc.salaryRepo.ListByEmployeeID(ctx, emp.ID)
+	if err != nil {
+		return err
+	}
+	salary, ok := salaries.SalaryOn(lastDay)
+	if !ok {
+		salary = emp.BaseSalary
+	}
+
+	o.LeavePayout, err = domain.LeavePayout(o.LeaveBalances, salary, lastDay)
+	return err
+}
+
+// daysWithin counts the weekdays of the inclusive leave [start, end] that
+// fall in [from, to).
+func daysWithin(start, end, from, to time.Time) int {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+
+	return domain.Weekdays(start, end)
+}
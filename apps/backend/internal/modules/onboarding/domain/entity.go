@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Assignee is who carries out an onboarding task.
+type Assignee string
+
+const (
+	AssigneeHR      Assignee = "HR"
+	AssigneeIT      Assignee = "IT"
+	AssigneeManager Assignee = "MANAGER"
+	AssigneeNewHire Assignee = "NEW_HIRE"
+)
+
+type TaskStatus string
+
+const (
+	TaskPending TaskStatus = "PENDING"
+	TaskDone    TaskStatus = "DONE"
+)
+
+const (
+	// MaxTemplateTasks bounds the checklist of one template.
+	MaxTemplateTasks = 100
+	// MaxDueOffsetDays bounds how far before or after the start date a
+	// task may fall due.
+	MaxDueOffsetDays = 365
+)
+
+var (
+	ErrNameRequired      = errors.New("template name is required")
+	ErrTasksRequired     = errors.New("a template needs at least one task")
+	ErrTooManyTasks      = errors.New("a template can have at most 100 tasks")
+	ErrTaskTitleRequired = errors.New("task title is required")
+	ErrInvalidAssignee   = errors.New("assignee must be HR, IT, MANAGER or NEW_HIRE")
+	ErrDueOffset         = errors.New("tasks must fall due within 365 days of the start date")
+	ErrTaskAlreadyDone   = errors.New("task is already done")
+	ErrNotAssignee       = errors.New("the task is assigned to someone else")
+)
+
+// TemplateTask is a task of a template. It falls due DueOffsetDays after
+// the start date, or before it when negative.
+type TemplateTask struct {
+	Title         string   `json:"title"`
+	Description   *string  `json:"description,omitempty"`
+	Assignee      Assignee `json:"assignee"`
+	DueOffsetDays int      `json:"due_offset_days"`
+}
+
+// Template is a tenant's onboarding checklist. A template with a
+// department applies to hires into it; the one without is the tenant's
+// default.
+type Template struct {
+	ID           string         `json:"id"`
+	TenantID     string         `json:"tenant_id"`
+	Name         string         `json:"name"`
+	DepartmentID *string        `json:"department_id,omitempty"`
+	Tasks        []TemplateTask `json:"tasks"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TemplateInput struct {
+	Name         string
+	DepartmentID *string
+	Tasks        []TemplateTask
+}
+
+func NewTemplate(tenantID string, in TemplateInput) (*Template, error) {
+	if tenantID == "" {
+		return nil, errors.New("tenantID is required")
+	}
+
+	t := &Template{
+		TenantID:  tenantID,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err := t.Update(in); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Update replaces the template. Tasks already created from it are left
+// as they are.
+func (t *Template) Update(in TemplateInput) error {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return ErrNameRequired
+	}
+
+	switch {
+	case len(in.Tasks) == 0:
+		return ErrTasksRequired
+	case len(in.Tasks) > MaxTemplateTasks:
+		return ErrTooManyTasks
+	}
+
+	tasks := make([]TemplateTask, 0, len(in.Tasks))
+	for _, task := range in.Tasks {
+		task.Title = strings.TrimSpace(task.Title)
+		if task.Title == "" {
+			return ErrTaskTitleRequired
+		}
+
+		switch task.Assignee {
+		case AssigneeHR, AssigneeIT, AssigneeManager, AssigneeNewHire:
+		default:
+			return ErrInvalidAssignee
+		}
+
+		if task.DueOffsetDays < -MaxDueOffsetDays || task.DueOffsetDays > MaxDueOffsetDays {
+			return ErrDueOffset
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	t.Name = name
+	t.DepartmentID = in.DepartmentID
+	t.Tasks = tasks
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Instantiate creates the template's tasks for a hire starting on
+// startDate. Manager tasks go to managerID; without a manager they are
+// left unassigned for HR to pick up.
+func (t *Template) Instantiate(employeeID string, managerID *string, startDate time.Time) []*Task {
+	y, m, d := startDate.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	now := time.Now().UTC()
+
+	tasks := make([]*Task, 0, len(t.Tasks))
+	for i, tt := range t.Tasks {
+		task := &Task{
+			TenantID:    t.TenantID,
+			EmployeeID:  employeeID,
+			TemplateID:  &t.ID,
+			Title:       tt.Title,
+			Description: tt.Description,
+			Assignee:    tt.Assignee,
+			DueDate:     start.AddDate(0, 0, tt.DueOffsetDays),
+			Status:      TaskPending,
+			Position:    i,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+
+		switch tt.Assignee {
+		case AssigneeManager:
+			task.AssigneeEmployeeID = managerID
+		case AssigneeNewHire:
+			id := employeeID
+			task.AssigneeEmployeeID = &id
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+// Task is an onboarding task of one new hire.
+type Task struct {
+	ID         string  `json:"id"`
+	TenantID   string  `json:"tenant_id"`
+	EmployeeID string  `json:"employee_id"`
+	TemplateID *string `json:"template_id,omitempty"`
+
+	Title       string   `json:"title"`
+	Description *string  `json:"description,omitempty"`
+	Assignee    Assignee `json:"assignee"`
+	// AssigneeEmployeeID is the manager or the new hire for their tasks;
+	// HR and IT tasks go to the team.
+	AssigneeEmployeeID *string `json:"assignee_employee_id,omitempty"`
+
+	DueDate     time.Time  `json:"due_date"` // date, UTC midnight
+	Status      TaskStatus `json:"status"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CompletedBy *string    `json:"completed_by,omitempty"`
+	Note        *string    `json:"note,omitempty"`
+	Position    int        `json:"position"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Read-only, filled by listings.
+	EmployeeCode string `json:"employee_code,omitempty"`
+	EmployeeName string `json:"employee_name,omitempty"`
+}
+
+func (t *Task) Complete(userID string, note *string) error {
+	if t.Status == TaskDone {
+		return ErrTaskAlreadyDone
+	}
+
+	now := time.Now().UTC()
+	t.Status = TaskDone
+	t.CompletedAt = &now
+	t.CompletedBy = &userID
+	t.Note = note
+	t.UpdatedAt = now
+	return nil
+}
+
+// Overdue reports whether the task is still pending after its due date.
+func (t *Task) Overdue(today time.Time) bool {
+	y, m, d := today.Date()
+	return t.Status == TaskPending && t.DueDate.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// Progress summarises the onboarding tasks of a new hire.
+type Progress struct {
+	Total   int `json:"total"`
+	Done    int `json:"done"`
+	Overdue int `json:"overdue"`
+}
+
+func Summarize(tasks []*Task, today time.Time) Progress {
+	p := Progress{Total: len(tasks)}
+	for _, t := range tasks {
+		if t.Status == TaskDone {
+			p.Done++
+		} else if t.Overdue(today) {
+			p.Overdue++
+		}
+	}
+	return p
+}
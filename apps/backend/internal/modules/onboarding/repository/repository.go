@@ -0,0 +1,43 @@
+package onboardingrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+)
+
+var (
+	ErrTemplateNotFound = errors.New("onboarding template not found")
+	ErrTemplateExists   = errors.New("an onboarding template already exists for this department")
+	ErrTaskNotFound     = errors.New("onboarding task not found")
+)
+
+type TemplateRepository interface {
+	Create(ctx context.Context, t *domain.Template) error
+	Update(ctx context.Context, t *domain.Template) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.Template, error)
+	ListByTenantID(ctx context.Context, tenantID string) ([]*domain.Template, error)
+	// FindForDepartment returns the tenant's template for the department,
+	// falling back to its default template.
+	FindForDepartment(ctx context.Context, tenantID string, departmentID *string) (*domain.Template, error)
+}
+
+// TaskFilter narrows a task listing; empty fields match everything.
+type TaskFilter struct {
+	EmployeeID         string
+	Assignee           domain.Assignee
+	AssigneeEmployeeID string
+	Status             domain.TaskStatus
+}
+
+type TaskRepository interface {
+	CreateMany(ctx context.Context, tasks []*domain.Task) error
+	Update(ctx context.Context, t *domain.Task) error
+
+	GetByID(ctx context.Context, id string) (*domain.Task, error)
+	// List returns the tenant's tasks matching f, soonest due first.
+	List(ctx context.Context, tenantID string, f TaskFilter) ([]*domain.Task, error)
+}
@@ -0,0 +1,68 @@
+package onboardingusecase
+
+import (
+	"context"
+	"errors"
+
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
+)
+
+var ErrTemplateOfOtherTenant = errors.New("the onboarding template belongs to another tenant")
+
+type AssignTasksUsecase struct {
+	templateRepo onboardingrepository.TemplateRepository
+	taskRepo     onboardingrepository.TaskRepository
+}
+
+func NewAssignTasksUsecase(
+	templateRepo onboardingrepository.TemplateRepository,
+	taskRepo onboardingrepository.TaskRepository,
+) *AssignTasksUsecase {
+	return &AssignTasksUsecase{templateRepo: templateRepo, taskRepo: taskRepo}
+}
+
+// Execute creates the onboarding tasks of a new hire from templateID, or
+// when nil from the template of their department or the tenant's
+// default. Hires without a tenant, or whose tenant has no template, get
+// no tasks.
+func (uc *AssignTasksUsecase) Execute(
+	ctx context.Context,
+	emp *employeedomain.Employee,
+	templateID *string,
+) ([]*domain.Task, error) {
+
+	if emp.TenantID == "" {
+		return nil, nil
+	}
+
+	var (
+		template *domain.Template
+		err      error
+	)
+	if templateID != nil {
+		template, err = uc.templateRepo.GetByID(ctx, *templateID)
+		if err != nil {
+			return nil, err
+		}
+		if template.TenantID != emp.TenantID {
+			return nil, ErrTemplateOfOtherTenant
+		}
+	} else {
+		template, err = uc.templateRepo.FindForDepartment(ctx, emp.TenantID, emp.DepartmentID)
+		if errors.Is(err, onboardingrepository.ErrTemplateNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := template.Instantiate(emp.ID, emp.ManagerID, emp.JoinDate)
+	if err := uc.taskRepo.CreateMany(ctx, tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
@@ -0,0 +1,143 @@
+package onboardingusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+type ListTasksUsecase struct {
+	repo onboardingrepository.TaskRepository
+}
+
+func NewListTasksUsecase(repo onboardingrepository.TaskRepository) *ListTasksUsecase {
+	return &ListTasksUsecase{repo: repo}
+}
+
+func (uc *ListTasksUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	f onboardingrepository.TaskFilter,
+) ([]*domain.Task, error) {
+	return uc.repo.List(ctx, tenantID, f)
+}
+
+// EmployeeOnboarding is a new hire's checklist and how far along it is.
+type EmployeeOnboarding struct {
+	Tasks    []*domain.Task  `json:"tasks"`
+	Progress domain.Progress `json:"progress"`
+}
+
+type GetEmployeeOnboardingUsecase struct {
+	repo onboardingrepository.TaskRepository
+}
+
+func NewGetEmployeeOnboardingUsecase(repo onboardingrepository.TaskRepository) *GetEmployeeOnboardingUsecase {
+	return &GetEmployeeOnboardingUsecase{repo: repo}
+}
+
+func (uc *GetEmployeeOnboardingUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	employeeID string,
+	today time.Time,
+) (*EmployeeOnboarding, error) {
+
+	tasks, err := uc.repo.List(ctx, tenantID, onboardingrepository.TaskFilter{EmployeeID: employeeID})
+	if err != nil {
+		return nil, err
+	}
+	if tasks == nil {
+		tasks = []*domain.Task{}
+	}
+
+	return &EmployeeOnboarding{
+		Tasks:    tasks,
+		Progress: domain.Summarize(tasks, today),
+	}, nil
+}
+
+type ListMyTasksUsecase struct {
+	repo     onboardingrepository.TaskRepository
+	userRepo userrepository.UserRepository
+}
+
+func NewListMyTasksUsecase(
+	repo onboardingrepository.TaskRepository,
+	userRepo userrepository.UserRepository,
+) *ListMyTasksUsecase {
+	return &ListMyTasksUsecase{repo: repo, userRepo: userRepo}
+}
+
+// Execute lists the pending tasks assigned to the user's employee, as the
+// new hire or as their manager.
+func (uc *ListMyTasksUsecase) Execute(ctx context.Context, tenantID, userID string) ([]*domain.Task, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.EmployeeID == nil {
+		return []*domain.Task{}, nil
+	}
+
+	return uc.repo.List(ctx, tenantID, onboardingrepository.TaskFilter{
+		AssigneeEmployeeID: *user.EmployeeID,
+		Status:             domain.TaskPending,
+	})
+}
+
+type CompleteTaskUsecase struct {
+	repo     onboardingrepository.TaskRepository
+	userRepo userrepository.UserRepository
+}
+
+func NewCompleteTaskUsecase(
+	repo onboardingrepository.TaskRepository,
+	userRepo userrepository.UserRepository,
+) *CompleteTaskUsecase {
+	return &CompleteTaskUsecase{repo: repo, userRepo: userRepo}
+}
+
+// Execute marks a task done. Admins and HR complete any task, including
+// those of IT; managers and new hires only the tasks assigned to them.
+func (uc *CompleteTaskUsecase) Execute(
+	ctx context.Context,
+	taskID string,
+	userID string,
+	note *string,
+) (*domain.Task, error) {
+
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+
+	switch user.Role {
+	case userdomain.Admin, userdomain.HR:
+	default:
+		if task.AssigneeEmployeeID == nil || user.EmployeeID == nil ||
+			*task.AssigneeEmployeeID != *user.EmployeeID {
+			return nil, domain.ErrNotAssignee
+		}
+	}
+
+	if err := task.Complete(userID, note); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
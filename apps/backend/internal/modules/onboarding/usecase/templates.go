@@ -0,0 +1,79 @@
+package onboardingusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/onboarding/domain"
+	onboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/onboarding/repository"
+)
+
+type CreateTemplateUsecase struct {
+	repo onboardingrepository.TemplateRepository
+}
+
+func NewCreateTemplateUsecase(repo onboardingrepository.TemplateRepository) *CreateTemplateUsecase {
+	return &CreateTemplateUsecase{repo: repo}
+}
+
+func (uc *CreateTemplateUsecase) Execute(ctx context.Context, tenantID string, in domain.TemplateInput) (*domain.Template, error) {
+	t, err := domain.NewTemplate(tenantID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type UpdateTemplateUsecase struct {
+	repo onboardingrepository.TemplateRepository
+}
+
+func NewUpdateTemplateUsecase(repo onboardingrepository.TemplateRepository) *UpdateTemplateUsecase {
+	return &UpdateTemplateUsecase{repo: repo}
+}
+
+func (uc *UpdateTemplateUsecase) Execute(ctx context.Context, id string, in domain.TemplateInput) (*domain.Template, error) {
+	t, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.Update(in); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+type DeleteTemplateUsecase struct {
+	repo onboardingrepository.TemplateRepository
+}
+
+func NewDeleteTemplateUsecase(repo onboardingrepository.TemplateRepository) *DeleteTemplateUsecase {
+	return &DeleteTemplateUsecase{repo: repo}
+}
+
+// Execute deletes the template; tasks created from it are kept.
+func (uc *DeleteTemplateUsecase) Execute(ctx context.Context, id string) error {
+	return uc.repo.Delete(ctx, id)
+}
+
+type ListTemplatesUsecase struct {
+	repo onboardingrepository.TemplateRepository
+}
+
+func NewListTemplatesUsecase(repo onboardingrepository.TemplateRepository) *ListTemplatesUsecase {
+	return &ListTemplatesUsecase{repo: repo}
+}
+
+func (uc *ListTemplatesUsecase) Execute(ctx context.Context, tenantID string) ([]*domain.Template, error) {
+	return uc.repo.ListByTenantID(ctx, tenantID)
+}
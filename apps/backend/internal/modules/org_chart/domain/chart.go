@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+)
+
+const (
+	// DefaultDepth is how many levels below the roots a chart holds
+	// unless asked for more; deeper subtrees are loaded one root at a time.
+	DefaultDepth = 2
+	MaxDepth     = 50
+)
+
+var (
+	ErrEmployeeNotInChart = errors.New("employee is not in the org chart")
+	ErrDepth              = errors.New("depth must be between 1 and 50")
+)
+
+// Member is an employee as placed in the chart.
+type Member struct {
+	EmployeeID     string
+	Code           string
+	Name           string
+	Position       string
+	DepartmentID   *string
+	DepartmentName *string
+	ManagerID      *string
+}
+
+// Node is an employee with the people reporting to them.
+type Node struct {
+	EmployeeID     string  `json:"employee_id"`
+	Code           string  `json:"code"`
+	Name           string  `json:"name"`
+	Position       string  `json:"position"`
+	DepartmentID   *string `json:"department_id,omitempty"`
+	DepartmentName *string `json:"department_name,omitempty"`
+	ManagerID      *string `json:"manager_id,omitempty"`
+
+	DirectReports int `json:"direct_reports"`
+	// Headcount is everyone below the employee, directly or through others.
+	Headcount int `json:"headcount"`
+
+	Children []*Node `json:"children,omitempty"`
+	// Collapsed is set when the reports were left out at the requested
+	// depth; asking for the employee as root loads them.
+	Collapsed bool `json:"collapsed,omitempty"`
+}
+
+// DepartmentGroup is the chart of one department; employees whose
+// manager works elsewhere are its roots.
+type DepartmentGroup struct {
+	DepartmentID   *string `json:"department_id"`
+	DepartmentName *string `json:"department_name"`
+	Headcount      int     `json:"headcount"`
+	Roots          []*Node `json:"roots"`
+}
+
+// Chart is the management hierarchy of a set of employees. Employees
+// whose manager is not among them are roots, and so is one employee of
+// each reporting loop left by older data.
+type Chart struct {
+	members   map[string]Member
+	reports   map[string][]string
+	headcount map[string]int
+	roots     []string
+}
+
+// NewChart builds the chart of members, keeping their order among
+// siblings.
+func NewChart(members []Member) *Chart {
+	c := &Chart{
+		members:   make(map[string]Member, len(members)),
+		reports:   map[string][]string{},
+		headcount: make(map[string]int, len(members)),
+	}
+	for _, m := range members {
+		c.members[m.EmployeeID] = m
+	}
+
+	for _, m := range members {
+		if m.ManagerID != nil && *m.ManagerID != m.EmployeeID {
+			if _, ok := c.members[*m.ManagerID]; ok {
+				c.reports[*m.ManagerID] = append(c.reports[*m.ManagerID], m.EmployeeID)
+				continue
+			}
+		}
+		c.roots = append(c.roots, m.EmployeeID)
+	}
+
+	visited := make(map[string]bool, len(members))
+	for _, id := range c.roots {
+		c.count(id, visited)
+	}
+
+	// Members still unvisited sit in a reporting loop.
+	for _, m := range members {
+		if !visited[m.EmployeeID] {
+			c.roots = append(c.roots, m.EmployeeID)
+			c.count(m.EmployeeID, visited)
+		}
+	}
+
+	return c
+}
+
+// count fills the headcount below id and returns it plus one for id.
+func (c *Chart) count(id string, visited map[string]bool) int {
+	visited[id] = true
+
+	n := 0
+	for _, child := range c.reports[id] {
+		if !visited[child] {
+			n += c.count(child, visited)
+		}
+	}
+
+	c.headcount[id] = n
+	return n + 1
+}
+
+// Headcount is the number of employees in the chart.
+func (c *Chart) Headcount() int {
+	return len(c.members)
+}
+
+// Roots returns the trees of the chart down to depth levels below the
+// roots.
+func (c *Chart) Roots(depth int) []*Node {
+	nodes := make([]*Node, 0, len(c.roots))
+	for _, id := range c.roots {
+		nodes = append(nodes, c.node(id, depth, map[string]bool{}))
+	}
+	return nodes
+}
+
+// Subtree returns the tree below the employee down to depth levels.
+func (c *Chart) Subtree(employeeID string, depth int) (*Node, error) {
+	if _, ok := c.members[employeeID]; !ok {
+		return nil, ErrEmployeeNotInChart
+	}
+	return c.node(employeeID, depth, map[string]bool{}), nil
+}
+
+func (c *Chart) node(id string, depth int, path map[string]bool) *Node {
+	m := c.members[id]
+	n := &Node{
+		EmployeeID:     m.EmployeeID,
+		Code:           m.Code,
+		Name:           m.Name,
+		Position:       m.Position,
+		DepartmentID:   m.DepartmentID,
+		DepartmentName: m.DepartmentName,
+		ManagerID:      m.ManagerID,
+		DirectReports:  len(c.reports[id]),
+		Headcount:      c.headcount[id],
+	}
+
+	if n.DirectReports == 0 {
+		return n
+	}
+	if depth <= 0 {
+		n.Collapsed = true
+		return n
+	}
+
+	path[id] = true
+	defer delete(path, id)
+
+	for _, child := range c.reports[id] {
+		if !path[child] {
+			n.Children = append(n.Children, c.node(child, depth-1, path))
+		}
+	}
+	return n
+}
+
+// ByDepartment splits members into one chart per department, named
+// departments first by name, then employees without one.
+func ByDepartment(members []Member, depth int) []*DepartmentGroup {
+	var keys []string
+	byKey := map[string][]Member{}
+	for _, m := range members {
+		key := ""
+		if m.DepartmentID != nil {
+			key = *m.DepartmentID
+		}
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], m)
+	}
+
+	groups := make([]*DepartmentGroup, 0, len(keys))
+	for _, key := range keys {
+		group := byKey[key]
+		chart := NewChart(group)
+		groups = append(groups, &DepartmentGroup{
+			DepartmentID:   group[0].DepartmentID,
+			DepartmentName: group[0].DepartmentName,
+			Headcount:      chart.Headcount(),
+			Roots:          chart.Roots(depth),
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].DepartmentName, groups[j].DepartmentName
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a < *b
+	})
+
+	return groups
+}
@@ -0,0 +1,87 @@
+package orgchartusecase
+
+import (
+	"context"
+
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/org_chart/domain"
+)
+
+type GetOrgChartUsecase struct {
+	employeeRepo employeerepository.EmployeeRepository
+}
+
+func NewGetOrgChartUsecase(employeeRepo employeerepository.EmployeeRepository) *GetOrgChartUsecase {
+	return &GetOrgChartUsecase{employeeRepo: employeeRepo}
+}
+
+type OrgChartQuery struct {
+	// RootID limits the chart to the employee and those below them.
+	RootID string
+	// Depth is how many levels below the roots are included.
+	Depth int
+	// ByDepartment splits the chart into one per department.
+	ByDepartment bool
+}
+
+// OrgChart holds Roots, or Departments when grouped by department.
+type OrgChart struct {
+	Headcount   int                       `json:"headcount"`
+	Roots       []*domain.Node            `json:"roots,omitempty"`
+	Departments []*domain.DepartmentGroup `json:"departments,omitempty"`
+}
+
+// Execute charts the tenant's employees in their jobs of today; those who
+// left are not on it.
+func (uc *GetOrgChartUsecase) Execute(ctx context.Context, tenantID string, q OrgChartQuery) (*OrgChart, error) {
+	if q.Depth == 0 {
+		q.Depth = domain.DefaultDepth
+	}
+	if q.Depth < 1 || q.Depth > domain.MaxDepth {
+		return nil, domain.ErrDepth
+	}
+
+	employees, err := uc.employeeRepo.ListActiveByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]domain.Member, 0, len(employees))
+	for _, e := range employees {
+		members = append(members, domain.Member{
+			EmployeeID:     e.ID,
+			Code:           e.Code,
+			Name:           e.FirstName + " " + e.LastName,
+			Position:       e.Position,
+			DepartmentID:   e.DepartmentID,
+			DepartmentName: e.DepartmentName,
+			ManagerID:      e.ManagerID,
+		})
+	}
+
+	if q.ByDepartment {
+		return &OrgChart{
+			Headcount:   len(members),
+			Departments: domain.ByDepartment(members, q.Depth),
+		}, nil
+	}
+
+	chart := domain.NewChart(members)
+
+	if q.RootID == "" {
+		return &OrgChart{
+			Headcount: chart.Headcount(),
+			Roots:     chart.Roots(q.Depth),
+		}, nil
+	}
+
+	root, err := chart.Subtree(q.RootID, q.Depth)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrgChart{
+		Headcount: root.Headcount + 1,
+		Roots:     []*domain.Node{root},
+	}, nil
+}
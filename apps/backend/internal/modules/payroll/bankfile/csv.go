@@ -0,0 +1,54 @@
+package bankfile
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+)
+
+// CSV is a bank-neutral transfer list for portals that accept uploads
+// with column mapping.
+type CSV struct{}
+
+func (CSV) Name() string        { return "csv" }
+func (CSV) ContentType() string { return "text/csv" }
+func (CSV) Extension() string   { return "csv" }
+
+func (CSV) Render(batch *domain.TransferBatch) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	header := []string{
+		"employee_code", "employee_name", "account_holder", "bank_name",
+		"bank_code", "branch_code", "account_number", "amount", "currency", "reference",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	for _, t := range batch.Transfers {
+		err := w.Write([]string{
+			t.EmployeeCode,
+			t.EmployeeName,
+			t.AccountHolder,
+			t.BankName,
+			t.BankCode,
+			t.BranchCode,
+			t.AccountNumber,
+			t.Amount.Round().String(),
+			string(batch.Currency),
+			t.Reference,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,215 @@
+package bankfile
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strconv"
+
+	bankaccountdomain "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+const pain001Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+
+var ErrDebtorAccountRequired = errors.New("pain.001 requires the debtor account")
+
+// Pain001 renders an ISO 20022 pain.001.001.03 customer credit transfer
+// with one payment information block categorised as salary (SALA).
+type Pain001 struct{}
+
+func (Pain001) Name() string        { return "pain001" }
+func (Pain001) ContentType() string { return "application/xml" }
+func (Pain001) Extension() string   { return "xml" }
+
+func (Pain001) Render(batch *domain.TransferBatch) ([]byte, error) {
+	if batch.DebtorAccount == "" {
+		return nil, ErrDebtorAccountRequired
+	}
+
+	count := strconv.Itoa(len(batch.Transfers))
+	total := batch.Total.Round().String()
+
+	pmt := painPaymentInfo{
+		PmtInfID:    batch.MessageID,
+		PmtMtd:      "TRF",
+		NbOfTxs:     count,
+		CtrlSum:     total,
+		PmtTpInf:    painPaymentType{CtgyPurp: painCode{Cd: "SALA"}},
+		ReqdExctnDt: batch.ExecutionDate.Format("2006-01-02"),
+		Dbtr:        painParty{Nm: truncate(batch.DebtorName, 70)},
+		DbtrAcct:    painAccount(batch.DebtorAccount),
+		DbtrAgt:     painAgent(batch.DebtorBankBIC),
+		ChrgBr:      chargeBearer(batch.Currency),
+	}
+
+	for _, t := range batch.Transfers {
+		pmt.Txs = append(pmt.Txs, painTransaction{
+			PmtID: painPaymentID{EndToEndID: truncate(t.Reference, 35)},
+			Amt: painAmount{InstdAmt: painInstructedAmount{
+				Ccy:   string(batch.Currency),
+				Value: t.Amount.Round().String(),
+			}},
+			CdtrAgt:  painAgent(t.BankCode),
+			Cdtr:     painParty{Nm: truncate(t.AccountHolder, 70)},
+			CdtrAcct: painAccount(t.AccountNumber),
+			RmtInf:   &painRemittance{Ustrd: truncate("Salary "+batch.Period, 140)},
+		})
+	}
+
+	doc := painDocument{
+		Xmlns: pain001Namespace,
+		Initn: painInitiation{
+			GrpHdr: painGroupHeader{
+				MsgID:    batch.MessageID,
+				CreDtTm:  batch.CreatedAt.Format("2006-01-02T15:04:05"),
+				NbOfTxs:  count,
+				CtrlSum:  total,
+				InitgPty: painParty{Nm: truncate(batch.DebtorName, 70)},
+			},
+			PmtInf: pmt,
+		},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// painAccount uses the IBAN element when the number is one and the
+// proprietary identifier otherwise.
+func painAccount(number string) painCashAccount {
+	if bankaccountdomain.IsIBAN(number) {
+		return painCashAccount{ID: painAccountID{IBAN: number}}
+	}
+	return painCashAccount{ID: painAccountID{Othr: &painOther{ID: number}}}
+}
+
+func painAgent(code string) painFinancialInstitution {
+	switch {
+	case code == "":
+		return painFinancialInstitution{ID: painInstitutionID{Othr: &painOther{ID: "NOTPROVIDED"}}}
+	case bankaccountdomain.IsBIC(code):
+		return painFinancialInstitution{ID: painInstitutionID{BIC: code}}
+	default:
+		return painFinancialInstitution{ID: painInstitutionID{Othr: &painOther{ID: code}}}
+	}
+}
+
+// chargeBearer follows the service level for SEPA euro credits and
+// shares charges for everything else.
+func chargeBearer(c money.Currency) string {
+	if c == "EUR" {
+		return "SLEV"
+	}
+	return "SHAR"
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+type painDocument struct {
+	XMLName xml.Name       `xml:"Document"`
+	Xmlns   string         `xml:"xmlns,attr"`
+	Initn   painInitiation `xml:"CstmrCdtTrfInitn"`
+}
+
+type painInitiation struct {
+	GrpHdr painGroupHeader `xml:"GrpHdr"`
+	PmtInf painPaymentInfo `xml:"PmtInf"`
+}
+
+type painGroupHeader struct {
+	MsgID    string    `xml:"MsgId"`
+	CreDtTm  string    `xml:"CreDtTm"`
+	NbOfTxs  string    `xml:"NbOfTxs"`
+	CtrlSum  string    `xml:"CtrlSum"`
+	InitgPty painParty `xml:"InitgPty"`
+}
+
+type painPaymentInfo struct {
+	PmtInfID    string                   `xml:"PmtInfId"`
+	PmtMtd      string                   `xml:"PmtMtd"`
+	NbOfTxs     string                   `xml:"NbOfTxs"`
+	CtrlSum     string                   `xml:"CtrlSum"`
+	PmtTpInf    painPaymentType          `xml:"PmtTpInf"`
+	ReqdExctnDt string                   `xml:"ReqdExctnDt"`
+	Dbtr        painParty                `xml:"Dbtr"`
+	DbtrAcct    painCashAccount          `xml:"DbtrAcct"`
+	DbtrAgt     painFinancialInstitution `xml:"DbtrAgt"`
+	ChrgBr      string                   `xml:"ChrgBr"`
+	Txs         []painTransaction        `xml:"CdtTrfTxInf"`
+}
+
+type painPaymentType struct {
+	CtgyPurp painCode `xml:"CtgyPurp"`
+}
+
+type painCode struct {
+	Cd string `xml:"Cd"`
+}
+
+type painParty struct {
+	Nm string `xml:"Nm"`
+}
+
+type painCashAccount struct {
+	ID painAccountID `xml:"Id"`
+}
+
+type painAccountID struct {
+	IBAN string     `xml:"IBAN,omitempty"`
+	Othr *painOther `xml:"Othr,omitempty"`
+}
+
+type painOther struct {
+	ID string `xml:"Id"`
+}
+
+type painFinancialInstitution struct {
+	ID painInstitutionID `xml:"FinInstnId"`
+}
+
+type painInstitutionID struct {
+	BIC  string     `xml:"BIC,omitempty"`
+	Othr *painOther `xml:"Othr,omitempty"`
+}
+
+type painTransaction struct {
+	PmtID    painPaymentID            `xml:"PmtId"`
+	Amt      painAmount               `xml:"Amt"`
+	CdtrAgt  painFinancialInstitution `xml:"CdtrAgt"`
+	Cdtr     painParty                `xml:"Cdtr"`
+	CdtrAcct painCashAccount          `xml:"CdtrAcct"`
+	RmtInf   *painRemittance          `xml:"RmtInf,omitempty"`
+}
+
+type painPaymentID struct {
+	EndToEndID string `xml:"EndToEndId"`
+}
+
+type painAmount struct {
+	InstdAmt painInstructedAmount `xml:"InstdAmt"`
+}
+
+type painInstructedAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type painRemittance struct {
+	Ustrd string `xml:"Ustrd"`
+}
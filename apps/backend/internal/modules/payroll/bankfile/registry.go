@@ -0,0 +1,51 @@
+// Package bankfile renders salary transfer batches into files banks
+// accept. Generic formats live here; a local bank format is added by
+// implementing Formatter and registering it at wiring time.
+package bankfile
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+)
+
+type Formatter interface {
+	// Name is the format key clients request, e.g. "csv".
+	Name() string
+	ContentType() string
+	Extension() string
+	Render(batch *domain.TransferBatch) ([]byte, error)
+}
+
+type Registry struct {
+	formatters map[string]Formatter
+}
+
+func NewRegistry(formatters ...Formatter) *Registry {
+	r := &Registry{formatters: map[string]Formatter{}}
+	for _, f := range formatters {
+		r.Register(f)
+	}
+	return r
+}
+
+// Register adds a formatter, replacing any formatter with the same name.
+func (r *Registry) Register(f Formatter) {
+	r.formatters[strings.ToLower(f.Name())] = f
+}
+
+func (r *Registry) Lookup(name string) (Formatter, bool) {
+	f, ok := r.formatters[strings.ToLower(name)]
+	return f, ok
+}
+
+// Names lists the registered format keys in order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.formatters))
+	for name := range r.formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
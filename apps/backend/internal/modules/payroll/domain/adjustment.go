@@ -0,0 +1,215 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// BaseSalaryLineCode is the line code used for base salary differences,
+// which records hold outside their lines.
+const BaseSalaryLineCode = "BASE_SALARY"
+
+// RetroLinePrefix marks lines carried into a run from an adjustment.
+const RetroLinePrefix = "RETRO_"
+
+var (
+	ErrRetroRunNotLocked   = errors.New("retroactive adjustments apply to approved or paid runs; recalculate open runs instead")
+	ErrAdjustmentSettled   = errors.New("adjustment is part of an approved payroll run")
+	ErrAdjustmentReason    = errors.New("a reason is required for retroactive adjustments")
+	ErrAdjustmentEmployees = errors.New("employees have no record in the payroll run")
+	ErrAdjustmentsChanged  = errors.New("retroactive adjustments changed while the run was calculated; recalculate it")
+)
+
+// PayrollAdjustment is the difference between an approved record and a
+// recalculation of the same period. It is paid through the next open run
+// of the tenant, which adds its lines prefixed with RetroLinePrefix.
+type PayrollAdjustment struct {
+	ID         string `json:"id"`
+	TenantID   string `json:"tenant_id"`
+	EmployeeID string `json:"employee_id"`
+
+	SourceRecordID string `json:"source_record_id"`
+	SourceRunID    string `json:"source_run_id"`
+	SourcePeriod   string `json:"source_period"`
+
+	// TargetRunID is the run that pays the adjustment, set when that run
+	// is calculated.
+	TargetRunID *string `json:"target_run_id,omitempty"`
+
+	Reason   string         `json:"reason"`
+	Currency money.Currency `json:"currency"`
+	Lines    []PayrollLine  `json:"lines"`
+	NetDelta money.Money    `json:"net_delta"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type lineKey struct {
+	kind LineKind
+	code string
+}
+
+// NewPayrollAdjustment compares a recalculated record with the approved
+// original, less the adjustments already settled against it. It returns
+// nil when nothing changed. Lines the original carried from adjustments of
+// earlier periods are left out: they were added after its own pay was
+// calculated and stay paid.
+func NewPayrollAdjustment(
+	tenantID string,
+	original *PayrollRecord,
+	recalculated *PayrollRecord,
+	settled []*PayrollAdjustment,
+	reason string,
+	createdBy string,
+) (*PayrollAdjustment, error) {
+
+	if original.RunID == nil {
+		return nil, errors.New("original record has no payroll run")
+	}
+	if recalculated.Currency != original.Currency {
+		return nil, fmt.Errorf("record %s: %w", original.ID, money.ErrCurrencyMismatch)
+	}
+
+	deltas := map[lineKey]money.Money{}
+	names := map[lineKey]PayrollLine{}
+	var order []lineKey
+
+	add := func(l PayrollLine, sign int) error {
+		k := lineKey{kind: l.Kind, code: l.Code}
+		if _, ok := names[k]; !ok {
+			names[k] = l
+			order = append(order, k)
+		}
+
+		amount := l.Amount
+		if sign < 0 {
+			amount = amount.Neg()
+		}
+
+		var err error
+		deltas[k], err = deltas[k].Add(amount)
+		return err
+	}
+
+	base := func(r *PayrollRecord) PayrollLine {
+		return PayrollLine{
+			Code:    BaseSalaryLineCode,
+			Name:    "Base salary",
+			Kind:    LineEarning,
+			Taxable: true,
+			Amount:  r.BaseSalary,
+		}
+	}
+
+	if err := add(base(recalculated), 1); err != nil {
+		return nil, err
+	}
+	for _, l := range recalculated.Lines {
+		if l.AdjustmentID != "" {
+			continue
+		}
+		if err := add(l, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := add(base(original), -1); err != nil {
+		return nil, err
+	}
+	for _, l := range original.Lines {
+		if l.AdjustmentID != "" {
+			continue
+		}
+		if err := add(l, -1); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settled {
+		for _, l := range s.Lines {
+			if err := add(l, -1); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	adj := &PayrollAdjustment{
+		TenantID:       tenantID,
+		EmployeeID:     original.EmployeeID,
+		SourceRecordID: original.ID,
+		SourceRunID:    *original.RunID,
+		SourcePeriod:   original.Period,
+		Reason:         reason,
+		Currency:       original.Currency,
+		Lines:          []PayrollLine{},
+		NetDelta:       money.Zero(original.Currency),
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	for _, k := range order {
+		amount := deltas[k].Round()
+		if amount.IsZero() {
+			continue
+		}
+
+		line := names[k]
+		line.Amount = amount
+		adj.Lines = append(adj.Lines, line)
+
+		var err error
+		switch line.Kind {
+		case LineEarning:
+			adj.NetDelta, err = adj.NetDelta.Add(amount)
+		case LineDeduction:
+			adj.NetDelta, err = adj.NetDelta.Sub(amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(adj.Lines) == 0 {
+		return nil, nil
+	}
+
+	return adj, nil
+}
+
+// ApplyTo adds the adjustment lines to a record of the paying run. Each
+// line links back to the adjustment and the original record.
+func (a *PayrollAdjustment) ApplyTo(record *PayrollRecord) error {
+	if record.EmployeeID != a.EmployeeID {
+		return errors.New("adjustment belongs to another employee")
+	}
+
+	for _, l := range a.Lines {
+		err := record.AddLine(PayrollLine{
+			Code:           RetroLinePrefix + l.Code,
+			Name:           fmt.Sprintf("%s (retro %s)", l.Name, a.SourcePeriod),
+			Kind:           l.Kind,
+			Taxable:        l.Taxable,
+			Amount:         l.Amount,
+			AdjustmentID:   a.ID,
+			SourceRecordID: a.SourceRecordID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetCurrency tags every amount with the adjustment currency after loading.
+func (a *PayrollAdjustment) SetCurrency(c money.Currency) {
+	a.Currency = c
+	a.NetDelta = a.NetDelta.WithCurrency(c)
+	for i := range a.Lines {
+		a.Lines[i].Amount = a.Lines[i].Amount.WithCurrency(c)
+	}
+}
@@ -0,0 +1,234 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+var testTime = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+
+func vnd(t *testing.T, s string) money.Money {
+	t.Helper()
+	m, err := money.Parse(s, money.Currency("VND"))
+	if err != nil {
+		t.Fatalf("parse %q: %v", s, err)
+	}
+	return m
+}
+
+func testRecord(t *testing.T, id, base string, lines ...PayrollLine) *PayrollRecord {
+	t.Helper()
+	runID := "run-1"
+	r, err := NewPayrollRecord(id, "emp-1", "2025-03", vnd(t, base), nil, nil, testTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.RunID = &runID
+	for _, l := range lines {
+		if err := r.AddLine(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return r
+}
+
+func line(t *testing.T, code string, kind LineKind, amount string) PayrollLine {
+	return PayrollLine{Code: code, Name: code, Kind: kind, Amount: vnd(t, amount)}
+}
+
+func carried(t *testing.T, code string, kind LineKind, amount string) PayrollLine {
+	l := line(t, RetroLinePrefix+code, kind, amount)
+	l.AdjustmentID = "adj-0"
+	l.SourceRecordID = "r0"
+	return l
+}
+
+func TestNewPayrollAdjustment(t *testing.T) {
+	tests := []struct {
+		name         string
+		original     func(t *testing.T) *PayrollRecord
+		recalculated func(t *testing.T) *PayrollRecord
+		settled      func(t *testing.T) []*PayrollAdjustment
+		wantLines    map[string]string
+		wantNet      string
+	}{
+		{
+			name:         "unchanged",
+			original:     func(t *testing.T) *PayrollRecord { return testRecord(t, "r1", "10000000") },
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "10000000") },
+		},
+		{
+			name: "backdated raise with tax",
+			original: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r1", "10000000", line(t, "PIT", LineDeduction, "500000"))
+			},
+			recalculated: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r2", "12000000", line(t, "PIT", LineDeduction, "700000"))
+			},
+			wantLines: map[string]string{BaseSalaryLineCode: "2000000", "PIT": "200000"},
+			wantNet:   "1800000",
+		},
+		{
+			name: "line dropped",
+			original: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r1", "10000000", line(t, "BONUS", LineEarning, "1000000"))
+			},
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "10000000") },
+			wantLines:    map[string]string{"BONUS": "-1000000"},
+			wantNet:      "-1000000",
+		},
+		{
+			name:         "partly settled",
+			original:     func(t *testing.T) *PayrollRecord { return testRecord(t, "r1", "10000000") },
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "12000000") },
+			settled: func(t *testing.T) []*PayrollAdjustment {
+				return []*PayrollAdjustment{{Lines: []PayrollLine{line(t, BaseSalaryLineCode, LineEarning, "1500000")}}}
+			},
+			wantLines: map[string]string{BaseSalaryLineCode: "500000"},
+			wantNet:   "500000",
+		},
+		{
+			name:         "fully settled",
+			original:     func(t *testing.T) *PayrollRecord { return testRecord(t, "r1", "10000000") },
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "12000000") },
+			settled: func(t *testing.T) []*PayrollAdjustment {
+				return []*PayrollAdjustment{{Lines: []PayrollLine{line(t, BaseSalaryLineCode, LineEarning, "2000000")}}}
+			},
+		},
+		{
+			name: "paid retro lines stay paid",
+			original: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r1", "10000000", carried(t, BaseSalaryLineCode, LineEarning, "1500000"))
+			},
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "10000000") },
+		},
+		{
+			name: "paid retro lines beside a raise",
+			original: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r1", "10000000",
+					carried(t, BaseSalaryLineCode, LineEarning, "1500000"),
+					carried(t, "PIT", LineDeduction, "150000"),
+				)
+			},
+			recalculated: func(t *testing.T) *PayrollRecord { return testRecord(t, "r2", "11000000") },
+			wantLines:    map[string]string{BaseSalaryLineCode: "1000000"},
+			wantNet:      "1000000",
+		},
+		{
+			name:     "employer contribution leaves net pay",
+			original: func(t *testing.T) *PayrollRecord { return testRecord(t, "r1", "10000000") },
+			recalculated: func(t *testing.T) *PayrollRecord {
+				return testRecord(t, "r2", "10000000", line(t, "SI_EMPLOYER", LineEmployerContribution, "1750000"))
+			},
+			wantLines: map[string]string{"SI_EMPLOYER": "1750000"},
+			wantNet:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var settled []*PayrollAdjustment
+			if tt.settled != nil {
+				settled = tt.settled(t)
+			}
+
+			adj, err := NewPayrollAdjustment("tenant-1", tt.original(t), tt.recalculated(t), settled, "raise", "u1")
+			if err != nil {
+				t.Fatalf("NewPayrollAdjustment() error = %v", err)
+			}
+
+			if tt.wantLines == nil {
+				if adj != nil {
+					t.Fatalf("expected no adjustment, got %+v", adj.Lines)
+				}
+				return
+			}
+			if adj == nil {
+				t.Fatal("expected an adjustment")
+			}
+
+			got := map[string]string{}
+			for _, l := range adj.Lines {
+				got[l.Code] = l.Amount.String()
+			}
+			if len(got) != len(tt.wantLines) {
+				t.Fatalf("lines = %v, want %v", got, tt.wantLines)
+			}
+			for code, want := range tt.wantLines {
+				if got[code] != want {
+					t.Fatalf("line %s = %s, want %s", code, got[code], want)
+				}
+			}
+			if adj.NetDelta.String() != tt.wantNet {
+				t.Fatalf("net delta = %s, want %s", adj.NetDelta, tt.wantNet)
+			}
+			if adj.SourceRecordID != "r1" || adj.SourceRunID != "run-1" || adj.SourcePeriod != "2025-03" {
+				t.Fatalf("source not linked: %+v", adj)
+			}
+		})
+	}
+}
+
+func TestNewPayrollAdjustmentCurrencyMismatch(t *testing.T) {
+	original := testRecord(t, "r1", "10000000")
+	recalculated := testRecord(t, "r2", "10000000")
+	recalculated.Currency = money.Currency("USD")
+
+	_, err := NewPayrollAdjustment("tenant-1", original, recalculated, nil, "raise", "u1")
+	if !errors.Is(err, money.ErrCurrencyMismatch) {
+		t.Fatalf("error = %v, want %v", err, money.ErrCurrencyMismatch)
+	}
+}
+
+func TestPayrollAdjustmentApplyTo(t *testing.T) {
+	adj := &PayrollAdjustment{
+		ID:             "adj-1",
+		EmployeeID:     "emp-1",
+		SourceRecordID: "r1",
+		SourcePeriod:   "2025-03",
+		Lines: []PayrollLine{
+			line(t, BaseSalaryLineCode, LineEarning, "2000000"),
+			line(t, "PIT", LineDeduction, "200000"),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		employeeID string
+		wantErr    bool
+		wantNet    string
+	}{
+		{name: "same employee", employeeID: "emp-1", wantNet: "11800000"},
+		{name: "other employee", employeeID: "emp-2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := testRecord(t, "r9", "10000000")
+			record.EmployeeID = tt.employeeID
+
+			err := adj.ApplyTo(record)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyTo() error = %v", err)
+			}
+			if record.NetSalary.String() != tt.wantNet {
+				t.Fatalf("net = %s, want %s", record.NetSalary, tt.wantNet)
+			}
+			for _, l := range record.Lines {
+				if l.AdjustmentID != "adj-1" || l.SourceRecordID != "r1" || !strings.HasPrefix(l.Code, RetroLinePrefix) {
+					t.Fatalf("line not linked to its adjustment: %+v", l)
+				}
+			}
+		})
+	}
+}
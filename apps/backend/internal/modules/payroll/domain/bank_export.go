@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+var (
+	ErrBankExportRunNotApproved = errors.New("bank files can only be exported for approved or paid runs")
+	ErrNoTransfers              = errors.New("payroll run has no positive net salaries to transfer")
+	ErrUnknownBankFormat        = errors.New("unknown bank file format")
+	ErrMissingBankAccounts      = errors.New("employees without a primary bank account")
+	ErrBankCurrency             = errors.New("bank account currency differs from the payroll currency")
+	ErrMixedRunCurrencies       = errors.New("payroll run pays several currencies; choose one to export")
+)
+
+// Transfer is one salary credit in a bank file.
+type Transfer struct {
+	RecordID     string
+	EmployeeCode string
+	EmployeeName string
+
+	BankName      string
+	BankCode      string
+	BranchCode    string
+	AccountHolder string
+	AccountNumber string
+
+	Amount    money.Money
+	Reference string
+}
+
+// TransferBatch is the input every bank file formatter renders.
+type TransferBatch struct {
+	MessageID     string
+	RunID         string
+	Period        string
+	Currency      money.Currency
+	CreatedAt     time.Time
+	ExecutionDate time.Time
+
+	DebtorName    string
+	DebtorAccount string
+	DebtorBankBIC string
+
+	Transfers []Transfer
+	Total     money.Money
+}
+
+// BankExport is the audit record of one generated bank file. The checksum
+// lets auditors prove which file was uploaded to the bank.
+type BankExport struct {
+	ID       string `json:"id"`
+	RunID    string `json:"run_id"`
+	Format   string `json:"format"`
+	FileName string `json:"file_name"`
+
+	// Checksum is the hex SHA-256 of the file bytes.
+	Checksum      string         `json:"checksum"`
+	TransferCount int            `json:"transfer_count"`
+	TotalAmount   money.Money    `json:"total_amount"`
+	Currency      money.Currency `json:"currency"`
+
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewBankExport(batch *TransferBatch, format, fileName string, data []byte, createdBy string) *BankExport {
+	sum := sha256.Sum256(data)
+
+	return &BankExport{
+		RunID:         batch.RunID,
+		Format:        format,
+		FileName:      fileName,
+		Checksum:      hex.EncodeToString(sum[:]),
+		TransferCount: len(batch.Transfers),
+		TotalAmount:   batch.Total,
+		Currency:      batch.Currency,
+		CreatedBy:     createdBy,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
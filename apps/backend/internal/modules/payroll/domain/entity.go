@@ -3,18 +3,47 @@ package domain
 import (
 	"errors"
 	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
 )
 
+type LineKind string
+
+const (
+	LineEarning   LineKind = "EARNING"
+	LineDeduction LineKind = "DEDUCTION"
+	// LineEmployerContribution is an employer cost that does not affect net pay.
+	LineEmployerContribution LineKind = "EMPLOYER_CONTRIBUTION"
+)
+
+// PayrollLine is one computed earning or deduction on a record.
+type PayrollLine struct {
+	Code    string      `json:"code"`
+	Name    string      `json:"name"`
+	Kind    LineKind    `json:"kind"`
+	Taxable bool        `json:"taxable"`
+	Amount  money.Money `json:"amount"`
+
+	// AdjustmentID and SourceRecordID link a retroactive line to its
+	// adjustment and the approved record it corrects.
+	AdjustmentID   string `json:"adjustment_id,omitempty"`
+	SourceRecordID string `json:"source_record_id,omitempty"`
+}
+
 type PayrollRecord struct {
-	ID         string `json:"id"`
-	EmployeeID string `json:"employee_id"`
+	ID         string  `json:"id"`
+	EmployeeID string  `json:"employee_id"`
+	RunID      *string `json:"run_id,omitempty"`
+
+	Period   string         `json:"period"` // YYYY-MM
+	Currency money.Currency `json:"currency"`
 
-	Period string `json:"period"` // YYYY-MM
+	BaseSalary money.Money            `json:"base_salary"`
+	Allowances map[string]money.Money `json:"allowances"`
+	Deductions map[string]money.Money `json:"deductions"`
+	NetSalary  money.Money            `json:"net_salary"`
 
-	BaseSalary float64            `json:"base_salary"`
-	Allowances map[string]float64 `json:"allowances"`
-	Deductions map[string]float64 `json:"deductions"`
-	NetSalary  float64            `json:"net_salary"`
+	Lines []PayrollLine `json:"lines"`
 
 	GeneratedAt time.Time `json:"generated_at"`
 }
@@ -23,50 +52,145 @@ func NewPayrollRecord(
 	id string,
 	employeeID string,
 	period string,
-	base float64,
-	allowances map[string]float64,
-	deductions map[string]float64,
+	base money.Money,
+	allowances map[string]money.Money,
+	deductions map[string]money.Money,
 	generatedAt time.Time,
 ) (*PayrollRecord, error) {
 
 	if employeeID == "" {
 		return nil, errors.New("employeeID is required")
 	}
-	if base < 0 {
+	if base.IsNegative() {
 		return nil, errors.New("base salary cannot be negative")
 	}
 	if allowances == nil {
-		allowances = map[string]float64{}
+		allowances = map[string]money.Money{}
 	}
 	if deductions == nil {
-		deductions = map[string]float64{}
+		deductions = map[string]money.Money{}
 	}
 
 	p := &PayrollRecord{
 		ID:          id,
 		EmployeeID:  employeeID,
 		Period:      period,
+		Currency:    base.Currency(),
 		BaseSalary:  base,
 		Allowances:  allowances,
 		Deductions:  deductions,
+		Lines:       []PayrollLine{},
 		GeneratedAt: generatedAt,
 	}
 
-	p.UpdateNetSalary()
+	if err := p.UpdateNetSalary(); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
 
-func (p *PayrollRecord) UpdateNetSalary() {
-	totalAllow := 0.0
+func (p *PayrollRecord) UpdateNetSalary() error {
+	net := p.BaseSalary
+
+	var err error
+	for _, v := range p.Allowances {
+		if net, err = net.Add(v); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range p.Deductions {
+		if net, err = net.Sub(v); err != nil {
+			return err
+		}
+	}
+
+	p.NetSalary = net
+	return nil
+}
+
+// AddLine records a computed line and folds earnings and deductions into
+// the allowance or deduction totals keyed by the line code.
+func (p *PayrollRecord) AddLine(line PayrollLine) error {
+	if line.Amount.Currency() != p.Currency {
+		return money.ErrCurrencyMismatch
+	}
+
+	var err error
+
+	switch line.Kind {
+	case LineEarning:
+		if p.Allowances[line.Code], err = p.Allowances[line.Code].Add(line.Amount); err != nil {
+			return err
+		}
+	case LineDeduction:
+		if p.Deductions[line.Code], err = p.Deductions[line.Code].Add(line.Amount); err != nil {
+			return err
+		}
+	}
+
+	p.Lines = append(p.Lines, line)
+
+	return p.UpdateNetSalary()
+}
+
+// TaxableEarnings is the base salary plus every taxable earning line.
+func (p *PayrollRecord) TaxableEarnings() money.Money {
+	total := p.BaseSalary
+	for _, l := range p.Lines {
+		if l.Kind == LineEarning && l.Taxable {
+			// Lines share the record currency, enforced by AddLine.
+			total, _ = total.Add(l.Amount)
+		}
+	}
+	return total
+}
+
+// SetCurrency tags every amount with the record currency. It is used after
+// loading amounts that are stored without one.
+func (p *PayrollRecord) SetCurrency(c money.Currency) {
+	p.Currency = c
+	p.BaseSalary = p.BaseSalary.WithCurrency(c)
+	p.NetSalary = p.NetSalary.WithCurrency(c)
+
+	for k, v := range p.Allowances {
+		p.Allowances[k] = v.WithCurrency(c)
+	}
+	for k, v := range p.Deductions {
+		p.Deductions[k] = v.WithCurrency(c)
+	}
+	for i := range p.Lines {
+		p.Lines[i].Amount = p.Lines[i].Amount.WithCurrency(c)
+	}
+}
+
+// GrossPay is the base salary plus every allowance.
+func (p *PayrollRecord) GrossPay() money.Money {
+	total := p.BaseSalary
 	for _, v := range p.Allowances {
-		totalAllow += v
+		// Allowances share the record currency, enforced by AddLine.
+		total, _ = total.Add(v)
 	}
+	return total
+}
 
-	totalDeduct := 0.0
+// TotalDeductions sums every deduction withheld from the employee.
+func (p *PayrollRecord) TotalDeductions() money.Money {
+	total := money.Zero(p.Currency)
 	for _, v := range p.Deductions {
-		totalDeduct += v
+		total, _ = total.Add(v)
 	}
+	return total
+}
 
-	p.NetSalary = p.BaseSalary + totalAllow - totalDeduct
+// EmployerCost is the gross pay plus the employer contributions on top.
+func (p *PayrollRecord) EmployerCost() money.Money {
+	total := p.GrossPay()
+	for _, l := range p.Lines {
+		if l.Kind == LineEmployerContribution {
+			total, _ = total.Add(l.Amount)
+		}
+	}
+	return total
 }
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+var (
+	ErrPayslipRunNotApproved = errors.New("payslips can only be generated for approved or paid runs")
+	ErrPayslipForbidden      = errors.New("only the employee and HR can access this payslip")
+	ErrPayrollForbidden      = errors.New("only the employee and HR of their tenant can view this pay")
+	ErrPayslipPasswordNoDOB  = errors.New("payslip password protection requires the employee's date of birth")
+)
+
+type PayslipStatus string
+
+const (
+	PayslipGenerated PayslipStatus = "GENERATED"
+	PayslipFailed    PayslipStatus = "FAILED"
+)
+
+// Payslip points at the rendered PDF of one payroll record.
+type Payslip struct {
+	ID         string `json:"id"`
+	RecordID   string `json:"record_id"`
+	RunID      string `json:"run_id"`
+	EmployeeID string `json:"employee_id"`
+	Period     string `json:"period"`
+
+	Status            PayslipStatus `json:"status"`
+	StoragePath       string        `json:"-"`
+	PasswordProtected bool          `json:"password_protected"`
+	Error             *string       `json:"error,omitempty"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+func NewGeneratedPayslip(record *PayrollRecord, path string, protected bool) *Payslip {
+	return &Payslip{
+		RecordID:          record.ID,
+		RunID:             *record.RunID,
+		EmployeeID:        record.EmployeeID,
+		Period:            record.Period,
+		Status:            PayslipGenerated,
+		StoragePath:       path,
+		PasswordProtected: protected,
+		GeneratedAt:       time.Now().UTC(),
+	}
+}
+
+func NewFailedPayslip(record *PayrollRecord, cause error) *Payslip {
+	msg := cause.Error()
+	return &Payslip{
+		RecordID:    record.ID,
+		RunID:       *record.RunID,
+		EmployeeID:  record.EmployeeID,
+		Period:      record.Period,
+		Status:      PayslipFailed,
+		Error:       &msg,
+		GeneratedAt: time.Now().UTC(),
+	}
+}
+
+// PayslipPreference is an employee's opt-in to password-protected payslips.
+// The password is the employee's date of birth as DDMMYYYY.
+type PayslipPreference struct {
+	EmployeeID        string    `json:"employee_id"`
+	PasswordProtected bool      `json:"password_protected"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// PayslipPassword derives the PDF password from a date of birth.
+func PayslipPassword(dateOfBirth *time.Time) (string, error) {
+	if dateOfBirth == nil {
+		return "", ErrPayslipPasswordNoDOB
+	}
+	return dateOfBirth.Format("02012006"), nil
+}
+
+// PayslipDocument is everything a renderer needs to draw one payslip.
+type PayslipDocument struct {
+	CompanyName string
+	LogoURL     *string
+
+	EmployeeName string
+	EmployeeCode string
+	Position     string
+	Department   string
+
+	Period   string
+	Currency money.Currency
+
+	BaseSalary      money.Money
+	Earnings        []PayrollLine
+	Deductions      []PayrollLine
+	Employer        []PayrollLine
+	GrossPay        money.Money
+	TotalDeductions money.Money
+	NetPay          money.Money
+
+	YTD PayslipYTD
+
+	LeaveBalances []LeaveBalance
+
+	// Password, when set, encrypts the PDF.
+	Password string
+}
+
+// PayslipYTD sums the employee's records from January to the payslip period.
+type PayslipYTD struct {
+	GrossPay   money.Money
+	Deductions money.Money
+	NetPay     money.Money
+}
+
+type LeaveBalance struct {
+	LeaveType string
+	Entitled  int
+	Taken     int
+	Remaining int
+}
+
+type PayslipRenderer interface {
+	Render(ctx context.Context, doc *PayslipDocument) ([]byte, error)
+}
@@ -0,0 +1,323 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type PayrollRunStatus string
+
+const (
+	RunDraft      PayrollRunStatus = "DRAFT"
+	RunCalculated PayrollRunStatus = "CALCULATED"
+	RunApproved   PayrollRunStatus = "APPROVED"
+	RunPaid       PayrollRunStatus = "PAID"
+)
+
+// RunType separates the regular monthly payroll from off-cycle runs,
+// which pay a one-off amount outside the monthly salary.
+type RunType string
+
+const (
+	RunRegular         RunType = "REGULAR"
+	RunBonus           RunType = "BONUS"
+	RunThirteenthMonth RunType = "THIRTEENTH_MONTH"
+	// RunTermination settles the final pay of leaving employees: the
+	// period's salary up to their last working day that the regular run
+	// did not pay, plus the multiplier amount as a TERMINATION_PAY line
+	// and the offboarding's leave payout as a LEAVE_PAYOUT line.
+	RunTermination RunType = "TERMINATION"
+)
+
+var RunTypes = []RunType{RunRegular, RunBonus, RunThirteenthMonth, RunTermination}
+
+func (t RunType) IsValid() bool {
+	return slices.Contains(RunTypes, t)
+}
+
+// IsOffCycle reports whether runs of the type pay outside the monthly salary.
+func (t RunType) IsOffCycle() bool {
+	return t != RunRegular
+}
+
+// MaxSalaryMultiplier bounds off-cycle multipliers, which are stored with
+// three decimals below it.
+const MaxSalaryMultiplier = 1000
+
+var (
+	ErrInvalidPeriod         = errors.New("period must be in YYYY-MM format")
+	ErrInvalidTenantID       = errors.New("tenantID is required")
+	ErrPayrollRunLocked      = errors.New("payroll run is approved and can no longer be changed")
+	ErrInvalidRunTransition  = errors.New("invalid payroll run status transition")
+	ErrPayrollRecordIsLocked = errors.New("payroll record belongs to an approved payroll run")
+	ErrInvalidRunType        = errors.New("run type must be REGULAR, BONUS, THIRTEENTH_MONTH or TERMINATION")
+	ErrInvalidMultiplier     = errors.New("salary multiplier must be at least 0 and below 1000")
+	ErrInvalidProrateYear    = errors.New("pro-rating year must be the run's year or the year before")
+	ErrRunEmployeesRequired  = errors.New("termination runs must list the employees they settle")
+	ErrRegularRunOptions     = errors.New("regular runs pay every active employee one month of salary")
+	ErrStatutoryUnsupported  = errors.New("the tenant country has no statutory tax and insurance rules")
+	ErrNotOffboarded         = errors.New("termination runs only settle employees with an offboarding")
+	ErrRunForbidden          = errors.New("only HR and admins of the tenant can approve or pay its payroll runs")
+)
+
+type PayrollRun struct {
+	ID       string           `json:"id"`
+	TenantID string           `json:"tenant_id"`
+	Type     RunType          `json:"type"`
+	Period   string           `json:"period"` // YYYY-MM, the month paid
+	Status   PayrollRunStatus `json:"status"`
+
+	// Off-cycle settings. The base amount of each record is the salary in
+	// effect at the end of the period times SalaryMultiplier, pro-rated by
+	// the months worked in ProrateYear when it is set; termination runs
+	// pay that amount as a line beside the final month's salary.
+	// EmployeeIDs limits the run to those employees; otherwise it pays
+	// every active one.
+	SalaryMultiplier float64  `json:"salary_multiplier"`
+	ProrateYear      *int     `json:"prorate_year,omitempty"`
+	EmployeeIDs      []string `json:"employee_ids,omitempty"`
+
+	TotalEmployees int `json:"total_employees"`
+
+	CalculatedAt *time.Time `json:"calculated_at,omitempty"`
+	ApprovedBy   *string    `json:"approved_by,omitempty"`
+	ApprovedAt   *time.Time `json:"approved_at,omitempty"`
+	PaidAt       *time.Time `json:"paid_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type NewPayrollRunInput struct {
+	TenantID         string
+	Type             RunType
+	Period           string
+	SalaryMultiplier float64
+	ProrateYear      *int
+	EmployeeIDs      []string
+}
+
+// NewPayrollRun creates a draft run. Regular runs take no off-cycle
+// settings; their multiplier is always 1.
+func NewPayrollRun(in NewPayrollRunInput) (*PayrollRun, error) {
+	if in.TenantID == "" {
+		return nil, ErrInvalidTenantID
+	}
+	if !in.Type.IsValid() {
+		return nil, ErrInvalidRunType
+	}
+
+	periodStart, err := ParsePeriod(in.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Type == RunRegular {
+		if in.ProrateYear != nil || len(in.EmployeeIDs) > 0 {
+			return nil, ErrRegularRunOptions
+		}
+		in.SalaryMultiplier = 1
+	}
+	if !(in.SalaryMultiplier >= 0 && in.SalaryMultiplier < MaxSalaryMultiplier) {
+		return nil, ErrInvalidMultiplier
+	}
+	// A 13th month is often paid in January for the year before.
+	if y := in.ProrateYear; y != nil && *y != periodStart.Year() && *y != periodStart.Year()-1 {
+		return nil, ErrInvalidProrateYear
+	}
+	if in.Type == RunTermination && len(in.EmployeeIDs) == 0 {
+		return nil, ErrRunEmployeesRequired
+	}
+
+	now := time.Now().UTC()
+
+	return &PayrollRun{
+		TenantID:         in.TenantID,
+		Type:             in.Type,
+		Period:           in.Period,
+		Status:           RunDraft,
+		SalaryMultiplier: in.SalaryMultiplier,
+		ProrateYear:      in.ProrateYear,
+		EmployeeIDs:      in.EmployeeIDs,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+// ParsePeriod returns the first day of a YYYY-MM period in UTC.
+func ParsePeriod(period string) (time.Time, error) {
+	t, err := time.Parse("2006-01", period)
+	if err != nil {
+		return time.Time{}, ErrInvalidPeriod
+	}
+	return t, nil
+}
+
+// MonthsWorked counts the months of the pro-rating year in which the
+// employee was employed, from the join month up to the run's period or
+// the month they left. A join month counts when the employee joined by
+// its 15th, and a leaving month when they worked until its 15th. A zero
+// lastWorkingDay means the employee has not left. Without a pro-rating
+// year it returns 12.
+func (r *PayrollRun) MonthsWorked(joinDate, lastWorkingDay time.Time) int {
+	if r.ProrateYear == nil {
+		return 12
+	}
+	year := *r.ProrateYear
+
+	periodStart, err := ParsePeriod(r.Period)
+	if err != nil {
+		return 0
+	}
+
+	last := time.December
+	if periodStart.Year() == year {
+		last = periodStart.Month()
+	}
+
+	if !lastWorkingDay.IsZero() {
+		switch {
+		case lastWorkingDay.Year() < year:
+			return 0
+		case lastWorkingDay.Year() == year:
+			left := lastWorkingDay.Month()
+			if lastWorkingDay.Day() < 15 {
+				left--
+			}
+			last = min(last, left)
+		}
+	}
+
+	first := time.January
+	if !joinDate.IsZero() {
+		switch {
+		case joinDate.Year() > year:
+			return 0
+		case joinDate.Year() == year:
+			first = joinDate.Month()
+			if joinDate.Day() > 15 {
+				first++
+			}
+		}
+	}
+
+	return max(int(last-first)+1, 0)
+}
+
+// OffCycleBase is the base of an off-cycle record: salary times the
+// run's multiplier, pro-rated by the share of the year worked when the
+// run has a pro-rating year.
+func (r *PayrollRun) OffCycleBase(salary money.Money, joinDate, lastWorkingDay time.Time) (money.Money, error) {
+	if !(r.SalaryMultiplier >= 0 && r.SalaryMultiplier < MaxSalaryMultiplier) {
+		return money.Money{}, ErrInvalidMultiplier
+	}
+
+	// The multiplier has three decimals, so it is exact in thousandths.
+	num := int64(math.Round(r.SalaryMultiplier * 1000))
+	den := int64(1000)
+	if r.ProrateYear != nil {
+		num *= int64(r.MonthsWorked(joinDate, lastWorkingDay))
+		den *= 12
+	}
+
+	return salary.MulRat(num, den)
+}
+
+// Codes of the lines a termination run adds: its multiplier amount and
+// the offboarding's payout of unused leave.
+const (
+	TerminationPayLine = "TERMINATION_PAY"
+	LeavePayoutLine    = "LEAVE_PAYOUT"
+)
+
+// FinalMonthBase is the base of a termination record: the salary earned
+// in the period up to the last working day, less the base the period's
+// regular record already paid, if any. It is never negative; a leaver the
+// regular run paid in full is not clawed back here.
+func FinalMonthBase(earned money.Money, regular *PayrollRecord) (money.Money, error) {
+	if regular == nil {
+		return earned, nil
+	}
+
+	base, err := earned.Sub(regular.BaseSalary)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if base.IsNegative() {
+		return money.Zero(earned.Currency()), nil
+	}
+	return base, nil
+}
+
+// IsLocked reports whether the run's records are frozen.
+func (r *PayrollRun) IsLocked() bool {
+	return r.Status == RunApproved || r.Status == RunPaid
+}
+
+// CanCalculate reports whether the run's records may be (re)generated.
+func (r *PayrollRun) CanCalculate() error {
+	if r.IsLocked() {
+		return ErrPayrollRunLocked
+	}
+	return nil
+}
+
+func (r *PayrollRun) MarkCalculated(totalEmployees int) error {
+	if err := r.CanCalculate(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	r.Status = RunCalculated
+	r.TotalEmployees = totalEmployees
+	r.CalculatedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// Reopen returns a calculated run to draft when the data it was calculated
+// from changed, so it cannot be approved before it is recalculated.
+func (r *PayrollRun) Reopen() error {
+	if r.IsLocked() {
+		return ErrPayrollRunLocked
+	}
+	if r.Status == RunDraft {
+		return nil
+	}
+
+	r.Status = RunDraft
+	r.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *PayrollRun) Approve(approverID string) error {
+	if r.Status != RunCalculated {
+		return ErrInvalidRunTransition
+	}
+	if approverID == "" {
+		return errors.New("approverID is required")
+	}
+
+	now := time.Now().UTC()
+	r.Status = RunApproved
+	r.ApprovedBy = &approverID
+	r.ApprovedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *PayrollRun) MarkPaid() error {
+	if r.Status != RunApproved {
+		return ErrInvalidRunTransition
+	}
+
+	now := time.Now().UTC()
+	r.Status = RunPaid
+	r.PaidAt = &now
+	r.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPayrollRunApprove(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   PayrollRunStatus
+		approver string
+		wantErr  error
+	}{
+		{name: "calculated", status: RunCalculated, approver: "u1"},
+		{name: "draft", status: RunDraft, approver: "u1", wantErr: ErrInvalidRunTransition},
+		{name: "already approved", status: RunApproved, approver: "u1", wantErr: ErrInvalidRunTransition},
+		{name: "paid", status: RunPaid, approver: "u1", wantErr: ErrInvalidRunTransition},
+		{name: "no approver", status: RunCalculated, approver: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &PayrollRun{Status: tt.status}
+			err := run.Approve(tt.approver)
+
+			if tt.approver == "" {
+				if err == nil {
+					t.Fatal("expected an error without approver")
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Approve() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if run.Status != tt.status {
+					t.Fatalf("status changed to %s on error", run.Status)
+				}
+				return
+			}
+			if run.Status != RunApproved || run.ApprovedBy == nil || *run.ApprovedBy != tt.approver || run.ApprovedAt == nil {
+				t.Fatalf("run not approved: %+v", run)
+			}
+		})
+	}
+}
+
+func TestPayrollRunMarkCalculated(t *testing.T) {
+	tests := []struct {
+		status  PayrollRunStatus
+		wantErr error
+	}{
+		{status: RunDraft},
+		{status: RunCalculated},
+		{status: RunApproved, wantErr: ErrPayrollRunLocked},
+		{status: RunPaid, wantErr: ErrPayrollRunLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			run := &PayrollRun{Status: tt.status}
+			err := run.MarkCalculated(3)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarkCalculated() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (run.Status != RunCalculated || run.TotalEmployees != 3 || run.CalculatedAt == nil) {
+				t.Fatalf("run not calculated: %+v", run)
+			}
+		})
+	}
+}
+
+func TestPayrollRunMarkPaid(t *testing.T) {
+	tests := []struct {
+		status  PayrollRunStatus
+		wantErr error
+	}{
+		{status: RunApproved},
+		{status: RunDraft, wantErr: ErrInvalidRunTransition},
+		{status: RunCalculated, wantErr: ErrInvalidRunTransition},
+		{status: RunPaid, wantErr: ErrInvalidRunTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			run := &PayrollRun{Status: tt.status}
+			if err := run.MarkPaid(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MarkPaid() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPayrollRunReopen(t *testing.T) {
+	tests := []struct {
+		status  PayrollRunStatus
+		wantErr error
+	}{
+		{status: RunDraft},
+		{status: RunCalculated},
+		{status: RunApproved, wantErr: ErrPayrollRunLocked},
+		{status: RunPaid, wantErr: ErrPayrollRunLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			run := &PayrollRun{Status: tt.status}
+			err := run.Reopen()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reopen() error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := RunDraft
+			if err != nil {
+				want = tt.status
+			}
+			if run.Status != want {
+				t.Fatalf("status = %s, want %s", run.Status, want)
+			}
+			if err == nil && run.Approve("u1") == nil {
+				t.Fatal("a reopened run must not be approvable")
+			}
+		})
+	}
+}
+
+func TestNewPayrollRunMultiplier(t *testing.T) {
+	tests := []struct {
+		multiplier float64
+		wantErr    error
+	}{
+		{multiplier: 0},
+		{multiplier: 1.5},
+		{multiplier: 999.999},
+		{multiplier: -0.5, wantErr: ErrInvalidMultiplier},
+		{multiplier: 1000, wantErr: ErrInvalidMultiplier},
+		{multiplier: math.NaN(), wantErr: ErrInvalidMultiplier},
+		{multiplier: math.Inf(1), wantErr: ErrInvalidMultiplier},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.multiplier), func(t *testing.T) {
+			_, err := NewPayrollRun(NewPayrollRunInput{
+				TenantID:         "t1",
+				Type:             RunBonus,
+				Period:           "2025-01",
+				SalaryMultiplier: tt.multiplier,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewPayrollRun() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPayrollRunMonthsWorked(t *testing.T) {
+	year := 2024
+
+	tests := []struct {
+		name    string
+		period  string
+		prorate *int
+		join    string
+		lastDay string
+		want    int
+	}{
+		{name: "no pro-rating", period: "2025-01", join: "2024-06-20", want: 12},
+		{name: "full year", period: "2025-01", prorate: &year, join: "2020-01-01", want: 12},
+		{name: "joined by the 15th", period: "2025-01", prorate: &year, join: "2024-03-15", want: 10},
+		{name: "joined after the 15th", period: "2025-01", prorate: &year, join: "2024-03-16", want: 9},
+		{name: "joined after the year", period: "2025-01", prorate: &year, join: "2025-01-02", want: 0},
+		{name: "current year stops at period", period: "2024-09", prorate: &year, join: "2020-01-01", want: 9},
+		{name: "left after the 15th", period: "2024-09", prorate: &year, join: "2020-01-01", lastDay: "2024-06-15", want: 6},
+		{name: "left before the 15th", period: "2024-09", prorate: &year, join: "2020-01-01", lastDay: "2024-06-14", want: 5},
+		{name: "left after the period", period: "2024-09", prorate: &year, join: "2020-01-01", lastDay: "2024-11-30", want: 9},
+		{name: "left the year before", period: "2025-01", prorate: &year, join: "2020-01-01", lastDay: "2023-12-31", want: 0},
+		{name: "left the year after", period: "2025-01", prorate: &year, join: "2024-04-01", lastDay: "2025-01-10", want: 9},
+		{name: "joined and left in the year", period: "2025-01", prorate: &year, join: "2024-03-01", lastDay: "2024-08-31", want: 6},
+		{name: "left before joining counted", period: "2025-01", prorate: &year, join: "2024-05-20", lastDay: "2024-05-31", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &PayrollRun{Period: tt.period, ProrateYear: tt.prorate}
+			if got := run.MonthsWorked(date(tt.join), date(tt.lastDay)); got != tt.want {
+				t.Fatalf("MonthsWorked() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPayrollRunOffCycleBase(t *testing.T) {
+	year := 2024
+
+	tests := []struct {
+		name       string
+		multiplier float64
+		prorate    *int
+		join       string
+		lastDay    string
+		salary     string
+		want       string
+		wantErr    error
+	}{
+		{name: "full 13th month", multiplier: 1, prorate: &year, join: "2020-01-01", salary: "30000000", want: "30000000"},
+		{name: "13th month of a leaver", multiplier: 1, prorate: &year, join: "2020-01-01", lastDay: "2024-07-20", salary: "30000000", want: "17500000"},
+		{name: "bonus without pro-rating", multiplier: 1.5, join: "2024-11-01", salary: "12345678", want: "18518517"},
+		{name: "thirds are exact", multiplier: 1, prorate: &year, join: "2024-09-01", salary: "10000000", want: "3333333"},
+		{name: "multiplier out of range", multiplier: 1000, join: "2020-01-01", salary: "1", wantErr: ErrInvalidMultiplier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := &PayrollRun{Period: "2025-01", SalaryMultiplier: tt.multiplier, ProrateYear: tt.prorate}
+			got, err := run.OffCycleBase(vnd(t, tt.salary), date(tt.join), date(tt.lastDay))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("OffCycleBase() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("OffCycleBase() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinalMonthBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		earned  string
+		regular string
+		want    string
+	}{
+		{name: "no regular record", earned: "5000000", want: "5000000"},
+		{name: "regular run calculated before the leaver", earned: "14000000", regular: "0", want: "14000000"},
+		{name: "regular run paid part of the month", earned: "14000000", regular: "5000000", want: "9000000"},
+		{name: "regular run paid the whole month", earned: "14000000", regular: "21000000", want: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var regular *PayrollRecord
+			if tt.regular != "" {
+				regular = &PayrollRecord{BaseSalary: vnd(t, tt.regular)}
+			}
+
+			got, err := FinalMonthBase(vnd(t, tt.earned), regular)
+			if err != nil {
+				t.Fatalf("FinalMonthBase() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("FinalMonthBase() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func date(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+var (
+	ErrInvalidTaxYear           = errors.New("tax year must be between 2000 and the current year")
+	ErrNoTaxYearRecords         = errors.New("no approved payroll runs in the tax year")
+	ErrMixedTaxYearCurrencies   = errors.New("the tax year pays several currencies")
+	ErrTaxYearUnsupported       = errors.New("the tenant country has no annual income tax rules")
+	ErrUnknownDeclarationFormat = errors.New("unknown tax declaration format")
+	ErrTaxCertificateForbidden  = errors.New("only the employee and HR can access this tax certificate")
+)
+
+// EmployeeTaxYear sums one employee's approved records of a tax year.
+type EmployeeTaxYear struct {
+	EmployeeID   string  `json:"employee_id"`
+	EmployeeCode string  `json:"employee_code"`
+	EmployeeName string  `json:"employee_name"`
+	TaxCode      *string `json:"tax_code,omitempty"`
+	Dependents   int     `json:"dependents"`
+
+	// FromPeriod and ToPeriod are the first and last months paid, which
+	// differ from January and December for mid-year joiners and leavers.
+	FromPeriod        string `json:"from_period"`
+	ToPeriod          string `json:"to_period"`
+	MonthsEmployed    int    `json:"months_employed"`
+	EmployedAtYearEnd bool   `json:"employed_at_year_end"`
+
+	GrossIncome   money.Money `json:"gross_income"`
+	TaxableIncome money.Money `json:"taxable_income"`
+	Contributions money.Money `json:"contributions"`
+	TaxWithheld   money.Money `json:"tax_withheld"`
+
+	// TaxDue is the finalized tax for the year. EmployerSettles reports
+	// whether the employer settles TaxBalance, the tax still due
+	// (negative for a refund).
+	TaxDue          money.Money `json:"tax_due"`
+	TaxBalance      money.Money `json:"tax_balance"`
+	EmployerSettles bool        `json:"employer_settles"`
+}
+
+// TaxYear is the company-level finalization of a year of payroll. It
+// covers every employee paid by an approved run of the year.
+type TaxYear struct {
+	TenantID    string         `json:"tenant_id"`
+	Year        int            `json:"year"`
+	Country     string         `json:"country"`
+	Currency    money.Currency `json:"currency"`
+	CompanyName string         `json:"company_name"`
+
+	Employees []*EmployeeTaxYear `json:"employees"`
+
+	TotalGrossIncome   money.Money `json:"total_gross_income"`
+	TotalTaxableIncome money.Money `json:"total_taxable_income"`
+	TotalTaxWithheld   money.Money `json:"total_tax_withheld"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// ValidTaxYear reports whether year can be finalized at now.
+func ValidTaxYear(year int, now time.Time) error {
+	if year < 2000 || year > now.Year() {
+		return ErrInvalidTaxYear
+	}
+	return nil
+}
+
+// Totals sums the employee amounts into the company totals.
+func (t *TaxYear) Totals() error {
+	t.TotalGrossIncome = money.Zero(t.Currency)
+	t.TotalTaxableIncome = money.Zero(t.Currency)
+	t.TotalTaxWithheld = money.Zero(t.Currency)
+
+	var err error
+	for _, e := range t.Employees {
+		if t.TotalGrossIncome, err = t.TotalGrossIncome.Add(e.GrossIncome); err != nil {
+			return err
+		}
+		if t.TotalTaxableIncome, err = t.TotalTaxableIncome.Add(e.TaxableIncome); err != nil {
+			return err
+		}
+		if t.TotalTaxWithheld, err = t.TotalTaxWithheld.Add(e.TaxWithheld); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Employee returns the summary of one employee, or nil.
+func (t *TaxYear) Employee(employeeID string) *EmployeeTaxYear {
+	for _, e := range t.Employees {
+		if e.EmployeeID == employeeID {
+			return e
+		}
+	}
+	return nil
+}
+
+// TaxDeclaration is the employer's annual filing for a tax year.
+type TaxDeclaration struct {
+	*TaxYear
+
+	// CompanyTaxCode is the employer's tax identification number.
+	CompanyTaxCode string
+}
+
+type TaxCertificateStatus string
+
+const (
+	TaxCertificateGenerated TaxCertificateStatus = "GENERATED"
+	TaxCertificateFailed    TaxCertificateStatus = "FAILED"
+)
+
+// TaxCertificate points at the rendered withholding certificate of one
+// employee for one tax year.
+type TaxCertificate struct {
+	ID         string `json:"id"`
+	TenantID   string `json:"tenant_id"`
+	EmployeeID string `json:"employee_id"`
+	Year       int    `json:"year"`
+
+	Status      TaxCertificateStatus `json:"status"`
+	StoragePath string               `json:"-"`
+	Error       *string              `json:"error,omitempty"`
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+func NewGeneratedTaxCertificate(tenantID string, year int, employeeID, path string) *TaxCertificate {
+	return &TaxCertificate{
+		TenantID:    tenantID,
+		EmployeeID:  employeeID,
+		Year:        year,
+		Status:      TaxCertificateGenerated,
+		StoragePath: path,
+		GeneratedAt: time.Now().UTC(),
+	}
+}
+
+func NewFailedTaxCertificate(tenantID string, year int, employeeID string, cause error) *TaxCertificate {
+	msg := cause.Error()
+	return &TaxCertificate{
+		TenantID:    tenantID,
+		EmployeeID:  employeeID,
+		Year:        year,
+		Status:      TaxCertificateFailed,
+		Error:       &msg,
+		GeneratedAt: time.Now().UTC(),
+	}
+}
+
+// TaxCertificateDocument is everything a renderer needs to draw one
+// withholding certificate.
+type TaxCertificateDocument struct {
+	CompanyName    string
+	CompanyTaxCode string
+	LogoURL        *string
+
+	Year     int
+	Currency money.Currency
+	Employee *EmployeeTaxYear
+}
+
+type TaxCertificateRenderer interface {
+	Render(ctx context.Context, doc *TaxCertificateDocument) ([]byte, error)
+}
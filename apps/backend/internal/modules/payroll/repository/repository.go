@@ -1,6 +1,18 @@
 package payrollrepository
 
-import domain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+import (
+	"context"
+	"errors"
+
+	domain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+)
+
+var (
+	ErrPayrollRunNotFound      = errors.New("payroll run not found")
+	ErrPayrollRunAlreadyExists = errors.New("payroll run already exists for this period")
+	ErrPayslipNotFound         = errors.New("payslip not found")
+	ErrTaxCertificateNotFound  = errors.New("tax certificate not found")
+)
 
 type PayrollRepository interface {
 	Create(p *domain.PayrollRecord) error
@@ -8,5 +20,66 @@ type PayrollRepository interface {
 
 	FindByID(id string) (*domain.PayrollRecord, error)
 	ListByEmployee(employeeID string) ([]*domain.PayrollRecord, error)
-	ListByPeriod(period string) ([]*domain.PayrollRecord, error)
+	ListByTenantAndPeriod(ctx context.Context, tenantID, period string) ([]*domain.PayrollRecord, error)
+
+	CreateBatch(ctx context.Context, records []*domain.PayrollRecord) error
+	DeleteByRunID(ctx context.Context, runID string) error
+	ListByRunID(ctx context.Context, runID string) ([]*domain.PayrollRecord, error)
+}
+
+type PayrollRunRepository interface {
+	Create(ctx context.Context, run *domain.PayrollRun) error
+	Update(ctx context.Context, run *domain.PayrollRun) error
+
+	GetByID(ctx context.Context, id string) (*domain.PayrollRun, error)
+	// LockByID reads the run with a row lock; it must be called inside a transaction.
+	LockByID(ctx context.Context, id string) (*domain.PayrollRun, error)
+	GetByTenantAndPeriod(ctx context.Context, tenantID, period string) (*domain.PayrollRun, error)
+	ListByTenant(ctx context.Context, tenantID string) ([]*domain.PayrollRun, error)
+}
+
+type PayslipRepository interface {
+	// Upsert replaces the payslip of a record, so regeneration keeps one row.
+	Upsert(ctx context.Context, p *domain.Payslip) error
+	GetByRecordID(ctx context.Context, recordID string) (*domain.Payslip, error)
+	ListByEmployeeID(ctx context.Context, employeeID string) ([]*domain.Payslip, error)
+}
+
+type PayslipPreferenceRepository interface {
+	Upsert(ctx context.Context, p *domain.PayslipPreference) error
+	// GetByEmployeeID returns nil without error when the employee has no preference.
+	GetByEmployeeID(ctx context.Context, employeeID string) (*domain.PayslipPreference, error)
+}
+
+type TaxCertificateRepository interface {
+	// Upsert replaces the certificate of an employee's year, so
+	// regeneration keeps one row.
+	Upsert(ctx context.Context, c *domain.TaxCertificate) error
+	GetByID(ctx context.Context, id string) (*domain.TaxCertificate, error)
+	ListByTenantYear(ctx context.Context, tenantID string, year int) ([]*domain.TaxCertificate, error)
+}
+
+type BankExportRepository interface {
+	Create(ctx context.Context, e *domain.BankExport) error
+	ListByRunID(ctx context.Context, runID string) ([]*domain.BankExport, error)
+}
+
+type AdjustmentRepository interface {
+	Create(ctx context.Context, a *domain.PayrollAdjustment) error
+
+	ListBySourceRunID(ctx context.Context, runID string) ([]*domain.PayrollAdjustment, error)
+	ListByTargetRunID(ctx context.Context, runID string) ([]*domain.PayrollAdjustment, error)
+	// ListSettledBySourceRecordID returns the adjustments of a record that
+	// an approved or paid run has already paid.
+	ListSettledBySourceRecordID(ctx context.Context, recordID string) ([]*domain.PayrollAdjustment, error)
+	// ListPayable returns the tenant's adjustments from periods before
+	// period that are unassigned or assigned to runID.
+	ListPayable(ctx context.Context, tenantID, runID, period string) ([]*domain.PayrollAdjustment, error)
+
+	// DeleteUnsettledBySourceRecordID drops the adjustments of a record
+	// that no approved run has paid yet, so a new delta can replace them.
+	DeleteUnsettledBySourceRecordID(ctx context.Context, recordID string) error
+	// AssignTargetRun releases every adjustment assigned to runID and then
+	// assigns the given ones to it.
+	AssignTargetRun(ctx context.Context, runID string, ids []string) error
 }
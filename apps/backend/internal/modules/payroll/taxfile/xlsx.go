@@ -0,0 +1,106 @@
+package taxfile
+
+import (
+	"bytes"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	xlsxEmployerSheet = "Employer"
+	xlsxEmployeeSheet = "Employees"
+)
+
+// XLSX renders the declaration as a workbook with an employer summary
+// sheet and one row per employee, the layout most filing portals import.
+type XLSX struct{}
+
+func (XLSX) Name() string { return "xlsx" }
+func (XLSX) ContentType() string {
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+func (XLSX) Extension() string { return "xlsx" }
+
+func (XLSX) Render(decl *domain.TaxDeclaration) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", xlsxEmployerSheet); err != nil {
+		return nil, err
+	}
+
+	summary := [][]any{
+		{"Employer", decl.CompanyName},
+		{"Tax code", decl.CompanyTaxCode},
+		{"Country", decl.Country},
+		{"Tax year", decl.Year},
+		{"Currency", string(decl.Currency)},
+		{"Employees", len(decl.Employees)},
+		{"Gross income", decl.TotalGrossIncome.Round().Float64()},
+		{"Taxable income", decl.TotalTaxableIncome.Round().Float64()},
+		{"Tax withheld", decl.TotalTaxWithheld.Round().Float64()},
+	}
+	if err := writeRows(f, xlsxEmployerSheet, summary); err != nil {
+		return nil, err
+	}
+
+	if _, err := f.NewSheet(xlsxEmployeeSheet); err != nil {
+		return nil, err
+	}
+
+	rows := [][]any{{
+		"Employee code", "Employee name", "Tax code", "Dependents",
+		"From period", "To period", "Months employed", "Employed at year end",
+		"Gross income", "Taxable income", "Contributions", "Tax withheld",
+		"Tax due", "Tax balance", "Employer settles",
+	}}
+
+	for _, e := range decl.Employees {
+		taxCode := ""
+		if e.TaxCode != nil {
+			taxCode = *e.TaxCode
+		}
+
+		rows = append(rows, []any{
+			e.EmployeeCode,
+			e.EmployeeName,
+			taxCode,
+			e.Dependents,
+			e.FromPeriod,
+			e.ToPeriod,
+			e.MonthsEmployed,
+			e.EmployedAtYearEnd,
+			e.GrossIncome.Round().Float64(),
+			e.TaxableIncome.Round().Float64(),
+			e.Contributions.Round().Float64(),
+			e.TaxWithheld.Round().Float64(),
+			e.TaxDue.Round().Float64(),
+			e.TaxBalance.Round().Float64(),
+			e.EmployerSettles,
+		})
+	}
+	if err := writeRows(f, xlsxEmployeeSheet, rows); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeRows(f *excelize.File, sheet string, rows [][]any) error {
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
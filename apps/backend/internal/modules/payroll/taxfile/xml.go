@@ -0,0 +1,114 @@
+package taxfile
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+)
+
+// XML renders the declaration as one employer header and one entry per
+// employee. Amounts are plain decimals in the declaration currency.
+type XML struct{}
+
+func (XML) Name() string        { return "xml" }
+func (XML) ContentType() string { return "application/xml" }
+func (XML) Extension() string   { return "xml" }
+
+func (XML) Render(decl *domain.TaxDeclaration) ([]byte, error) {
+	doc := xmlDeclaration{
+		Year:     decl.Year,
+		Country:  decl.Country,
+		Currency: string(decl.Currency),
+		Employer: xmlEmployer{
+			Name:    decl.CompanyName,
+			TaxCode: decl.CompanyTaxCode,
+		},
+		Summary: xmlSummary{
+			Employees:     strconv.Itoa(len(decl.Employees)),
+			GrossIncome:   decl.TotalGrossIncome.Round().String(),
+			TaxableIncome: decl.TotalTaxableIncome.Round().String(),
+			TaxWithheld:   decl.TotalTaxWithheld.Round().String(),
+		},
+	}
+
+	for _, e := range decl.Employees {
+		taxCode := ""
+		if e.TaxCode != nil {
+			taxCode = *e.TaxCode
+		}
+
+		doc.Employees = append(doc.Employees, xmlEmployee{
+			Code:              e.EmployeeCode,
+			Name:              e.EmployeeName,
+			TaxCode:           taxCode,
+			Dependents:        e.Dependents,
+			FromPeriod:        e.FromPeriod,
+			ToPeriod:          e.ToPeriod,
+			MonthsEmployed:    e.MonthsEmployed,
+			EmployedAtYearEnd: e.EmployedAtYearEnd,
+			GrossIncome:       e.GrossIncome.Round().String(),
+			TaxableIncome:     e.TaxableIncome.Round().String(),
+			Contributions:     e.Contributions.Round().String(),
+			TaxWithheld:       e.TaxWithheld.Round().String(),
+			TaxDue:            e.TaxDue.Round().String(),
+			TaxBalance:        e.TaxBalance.Round().String(),
+			EmployerSettles:   e.EmployerSettles,
+		})
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type xmlDeclaration struct {
+	XMLName   xml.Name      `xml:"TaxDeclaration"`
+	Year      int           `xml:"year,attr"`
+	Country   string        `xml:"country,attr"`
+	Currency  string        `xml:"currency,attr"`
+	Employer  xmlEmployer   `xml:"Employer"`
+	Summary   xmlSummary    `xml:"Summary"`
+	Employees []xmlEmployee `xml:"Employees>Employee"`
+}
+
+type xmlEmployer struct {
+	Name    string `xml:"Name"`
+	TaxCode string `xml:"TaxCode"`
+}
+
+type xmlSummary struct {
+	Employees     string `xml:"EmployeeCount"`
+	GrossIncome   string `xml:"GrossIncome"`
+	TaxableIncome string `xml:"TaxableIncome"`
+	TaxWithheld   string `xml:"TaxWithheld"`
+}
+
+type xmlEmployee struct {
+	Code              string `xml:"Code"`
+	Name              string `xml:"Name"`
+	TaxCode           string `xml:"TaxCode,omitempty"`
+	Dependents        int    `xml:"Dependents"`
+	FromPeriod        string `xml:"FromPeriod"`
+	ToPeriod          string `xml:"ToPeriod"`
+	MonthsEmployed    int    `xml:"MonthsEmployed"`
+	EmployedAtYearEnd bool   `xml:"EmployedAtYearEnd"`
+	GrossIncome       string `xml:"GrossIncome"`
+	TaxableIncome     string `xml:"TaxableIncome"`
+	Contributions     string `xml:"Contributions"`
+	TaxWithheld       string `xml:"TaxWithheld"`
+	TaxDue            string `xml:"TaxDue"`
+	TaxBalance        string `xml:"TaxBalance"`
+	EmployerSettles   bool   `xml:"EmployerSettles"`
+}
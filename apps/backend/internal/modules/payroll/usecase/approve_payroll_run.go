@@ -0,0 +1,65 @@
+package payrollusecase
+
+import (
+	"context"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type ApprovePayrollRunUsecase struct {
+	runRepo   payrollrepository.PayrollRunRepository
+	txManager txpkg.Manager
+	queueSvc  queueports.QueueService
+	access    *PayAccess
+}
+
+func NewApprovePayrollRunUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	txManager txpkg.Manager,
+	queueSvc queueports.QueueService,
+	access *PayAccess,
+) *ApprovePayrollRunUsecase {
+	return &ApprovePayrollRunUsecase{
+		runRepo:   runRepo,
+		txManager: txManager,
+		queueSvc:  queueSvc,
+		access:    access,
+	}
+}
+
+// Execute approves the run and queues its payslips for generation. Only
+// HR and admins of the run's tenant may approve. The run is locked like
+// during calculation, so an approval never lands on records a concurrent
+// recalculation is replacing.
+func (uc *ApprovePayrollRunUsecase) Execute(ctx context.Context, runID, approverID string) (*domain.PayrollRun, error) {
+	var run *domain.PayrollRun
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		run, err = uc.runRepo.LockByID(txCtx, runID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.access.Tenant(txCtx, approverID, run.TenantID); err != nil {
+			return denied(err, domain.ErrRunForbidden)
+		}
+
+		if err := run.Approve(approverID); err != nil {
+			return err
+		}
+
+		return uc.runRepo.Update(txCtx, run)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enqueuePayslipGeneration(ctx, uc.queueSvc, run.ID); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,300 @@
+package payrollusecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
+	statutoryrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules"
+	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
+	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type BuildTaxYearUsecase struct {
+	runRepo        payrollrepository.PayrollRunRepository
+	payrollRepo    payrollrepository.PayrollRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	profileRepo    statutoryrepository.EmployeeProfileRepository
+	dependentRepo  dependentrepository.DependentRepository
+	tenantRepo     tenantrepository.TenantRepository
+	tenantProfiles tenantprofilerepository.TenantProfileRepository
+	statutoryRules *statutoryrules.Registry
+}
+
+func NewBuildTaxYearUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	profileRepo statutoryrepository.EmployeeProfileRepository,
+	dependentRepo dependentrepository.DependentRepository,
+	tenantRepo tenantrepository.TenantRepository,
+	tenantProfiles tenantprofilerepository.TenantProfileRepository,
+	statutoryRules *statutoryrules.Registry,
+) *BuildTaxYearUsecase {
+	return &BuildTaxYearUsecase{
+		runRepo:        runRepo,
+		payrollRepo:    payrollRepo,
+		employeeRepo:   employeeRepo,
+		profileRepo:    profileRepo,
+		dependentRepo:  dependentRepo,
+		tenantRepo:     tenantRepo,
+		tenantProfiles: tenantProfiles,
+		statutoryRules: statutoryRules,
+	}
+}
+
+// Execute sums every approved or paid run of the year, regular and
+// off-cycle, per employee and finalizes the income tax with the rules of
+// the tenant's country. Retroactive lines count in the year they are paid.
+func (uc *BuildTaxYearUsecase) Execute(ctx context.Context, tenantID string, year int) (*domain.TaxYear, error) {
+	now := time.Now().UTC()
+	if err := domain.ValidTaxYear(year, now); err != nil {
+		return nil, err
+	}
+
+	country, _, err := tenantSettings(ctx, uc.tenantProfiles, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	finalizer, err := uc.finalizer(country)
+	if err != nil {
+		return nil, err
+	}
+
+	company, _, err := tenantBranding(ctx, uc.tenantRepo, uc.tenantProfiles, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := uc.runRepo.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strconv.Itoa(year) + "-"
+	var records []*domain.PayrollRecord
+	regular := map[string]bool{}
+
+	for _, run := range runs {
+		if !run.IsLocked() || !strings.HasPrefix(run.Period, prefix) {
+			continue
+		}
+
+		runRecords, err := uc.payrollRepo.ListByRunID(ctx, run.ID)
+		if err != nil {
+			return nil, err
+		}
+		if run.Type == domain.RunRegular {
+			for _, r := range runRecords {
+				regular[r.ID] = true
+			}
+		}
+		records = append(records, runRecords...)
+	}
+
+	if len(records) == 0 {
+		return nil, domain.ErrNoTaxYearRecords
+	}
+
+	currency := records[0].Currency
+	for _, r := range records {
+		if r.Currency != currency {
+			return nil, domain.ErrMixedTaxYearCurrencies
+		}
+	}
+
+	taxYear := &domain.TaxYear{
+		TenantID:    tenantID,
+		Year:        year,
+		Country:     strings.ToUpper(country),
+		Currency:    currency,
+		CompanyName: company,
+		GeneratedAt: now,
+	}
+
+	byEmployee := map[string][]*domain.PayrollRecord{}
+	var order []string
+	for _, r := range records {
+		if _, ok := byEmployee[r.EmployeeID]; !ok {
+			order = append(order, r.EmployeeID)
+		}
+		byEmployee[r.EmployeeID] = append(byEmployee[r.EmployeeID], r)
+	}
+
+	for _, employeeID := range order {
+		summary, err := uc.summarize(ctx, year, employeeID, byEmployee[employeeID], regular, finalizer)
+		if err != nil {
+			return nil, err
+		}
+		taxYear.Employees = append(taxYear.Employees, summary)
+	}
+
+	sort.Slice(taxYear.Employees, func(i, j int) bool {
+		return taxYear.Employees[i].EmployeeCode < taxYear.Employees[j].EmployeeCode
+	})
+
+	if err := taxYear.Totals(); err != nil {
+		return nil, err
+	}
+
+	return taxYear, nil
+}
+
+func (uc *BuildTaxYearUsecase) finalizer(country string) (statutoryrules.AnnualFinalizer, error) {
+	pack, ok := uc.statutoryRules.Lookup(country)
+	if !ok {
+		return nil, domain.ErrTaxYearUnsupported
+	}
+
+	finalizer, ok := pack.(statutoryrules.AnnualFinalizer)
+	if !ok {
+		return nil, domain.ErrTaxYearUnsupported
+	}
+
+	return finalizer, nil
+}
+
+// summarize sums one employee's records of the year. Months employed
+// count the regular runs that paid the employee, so joiners and leavers
+// are finalized on the months they actually worked.
+func (uc *BuildTaxYearUsecase) summarize(
+	ctx context.Context,
+	year int,
+	employeeID string,
+	records []*domain.PayrollRecord,
+	regular map[string]bool,
+	finalizer statutoryrules.AnnualFinalizer,
+) (*domain.EmployeeTaxYear, error) {
+
+	emp, err := uc.employeeRepo.FindByID(employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", employeeID, err)
+	}
+
+	profile, err := uc.profileRepo.GetByEmployeeID(ctx, employeeID)
+	if errors.Is(err, statutoryrepository.ErrEmployeeProfileNotFound) {
+		profile = statutorydomain.DefaultEmployeeProfile(employeeID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	dependents, dependentMonths, err := yearDependents(ctx, uc.dependentRepo, profile, year)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := records[0].Currency
+	summary := &domain.EmployeeTaxYear{
+		EmployeeID:    emp.ID,
+		EmployeeCode:  emp.Code,
+		EmployeeName:  emp.FirstName + " " + emp.LastName,
+		TaxCode:       profile.TaxCode,
+		Dependents:    dependents,
+		GrossIncome:   money.Zero(currency),
+		TaxableIncome: money.Zero(currency),
+		Contributions: money.Zero(currency),
+		TaxWithheld:   money.Zero(currency),
+	}
+
+	contributionLines := map[string]bool{}
+	for _, code := range finalizer.ContributionLines() {
+		contributionLines[code] = true
+	}
+
+	months := map[string]bool{}
+	for _, r := range records {
+		if summary.FromPeriod == "" || r.Period < summary.FromPeriod {
+			summary.FromPeriod = r.Period
+		}
+		if r.Period > summary.ToPeriod {
+			summary.ToPeriod = r.Period
+		}
+		if regular[r.ID] {
+			months[r.Period] = true
+		}
+
+		if summary.GrossIncome, err = summary.GrossIncome.Add(r.GrossPay()); err != nil {
+			return nil, err
+		}
+		if summary.TaxableIncome, err = summary.TaxableIncome.Add(r.TaxableEarnings()); err != nil {
+			return nil, err
+		}
+
+		for _, l := range r.Lines {
+			if l.Kind != domain.LineDeduction {
+				continue
+			}
+
+			// Retroactive corrections of tax and insurance count too.
+			code := strings.TrimPrefix(l.Code, domain.RetroLinePrefix)
+			switch {
+			case code == finalizer.IncomeTaxLine():
+				summary.TaxWithheld, err = summary.TaxWithheld.Add(l.Amount)
+			case contributionLines[code]:
+				summary.Contributions, err = summary.Contributions.Add(l.Amount)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	summary.MonthsEmployed = len(months)
+	summary.EmployedAtYearEnd = emp.EmploymentStatus.Employed() &&
+		months[fmt.Sprintf("%d-12", year)]
+
+	result, err := finalizer.FinalizeYear(statutorydomain.AnnualInput{
+		Year:              year,
+		TaxableIncome:     summary.TaxableIncome.Float64(),
+		Contributions:     summary.Contributions.Float64(),
+		Dependents:        summary.Dependents,
+		DependentMonths:   dependentMonths,
+		MonthsEmployed:    summary.MonthsEmployed,
+		EmployedAtYearEnd: summary.EmployedAtYearEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taxDue, err := money.FromFloat(result.TaxDue, currency)
+	if err != nil {
+		return nil, fmt.Errorf("employee %s tax due: %w", emp.Code, err)
+	}
+	summary.TaxDue = taxDue.Round()
+	if summary.TaxBalance, err = summary.TaxDue.Sub(summary.TaxWithheld); err != nil {
+		return nil, err
+	}
+	summary.EmployerSettles = result.EmployerSettles
+
+	return summary, nil
+}
+
+type GetTaxYearUsecase struct {
+	taxYear *BuildTaxYearUsecase
+	access  *PayAccess
+}
+
+func NewGetTaxYearUsecase(taxYear *BuildTaxYearUsecase, access *PayAccess) *GetTaxYearUsecase {
+	return &GetTaxYearUsecase{taxYear: taxYear, access: access}
+}
+
+// Execute returns the tax year to HR and admins of the tenant.
+func (uc *GetTaxYearUsecase) Execute(ctx context.Context, tenantID string, year int, userID string) (*domain.TaxYear, error) {
+	if err := uc.access.Tenant(ctx, userID, tenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return uc.taxYear.Execute(ctx, tenantID, year)
+}
@@ -0,0 +1,583 @@
+package payrollusecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	attendancerepository "github.com/smart-hmm/smart-hmm/internal/modules/attendance/repository"
+	compensationrepository "github.com/smart-hmm/smart-hmm/internal/modules/compensation/repository"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	offboardingdomain "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingrepository "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	componentdomain "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
+	statutoryrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules"
+	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type CalculatePayrollRunUsecase struct {
+	runRepo        payrollrepository.PayrollRunRepository
+	payrollRepo    payrollrepository.PayrollRepository
+	adjustmentRepo payrollrepository.AdjustmentRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	salaryRepo     compensationrepository.SalaryChangeRepository
+	componentRepo  salarycomponentrepository.SalaryComponentRepository
+	attendanceRepo attendancerepository.AttendanceRepository
+	profileRepo    statutoryrepository.EmployeeProfileRepository
+	dependentRepo  dependentrepository.DependentRepository
+	offboardings   offboardingrepository.OffboardingRepository
+	tenantProfiles tenantprofilerepository.TenantProfileRepository
+	statutoryRules *statutoryrules.Registry
+	txManager      txpkg.Manager
+}
+
+func NewCalculatePayrollRunUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+	adjustmentRepo payrollrepository.AdjustmentRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	salaryRepo compensationrepository.SalaryChangeRepository,
+	componentRepo salarycomponentrepository.SalaryComponentRepository,
+	attendanceRepo attendancerepository.AttendanceRepository,
+	profileRepo statutoryrepository.EmployeeProfileRepository,
+	dependentRepo dependentrepository.DependentRepository,
+	offboardings offboardingrepository.OffboardingRepository,
+	tenantProfiles tenantprofilerepository.TenantProfileRepository,
+	statutoryRules *statutoryrules.Registry,
+	txManager txpkg.Manager,
+) *CalculatePayrollRunUsecase {
+	return &CalculatePayrollRunUsecase{
+		runRepo:        runRepo,
+		payrollRepo:    payrollRepo,
+		adjustmentRepo: adjustmentRepo,
+		employeeRepo:   employeeRepo,
+		salaryRepo:     salaryRepo,
+		componentRepo:  componentRepo,
+		attendanceRepo: attendanceRepo,
+		profileRepo:    profileRepo,
+		dependentRepo:  dependentRepo,
+		offboardings:   offboardings,
+		tenantProfiles: tenantProfiles,
+		statutoryRules: statutoryRules,
+		txManager:      txManager,
+	}
+}
+
+// Execute generates one record per employee of the run and adds the
+// retroactive adjustments the run pays. Records from a previous
+// calculation are replaced in the same transaction.
+func (uc *CalculatePayrollRunUsecase) Execute(ctx context.Context, runID string) error {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	if err := run.CanCalculate(); err != nil {
+		return err
+	}
+
+	inputs, err := uc.loadRunInputs(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	employees, err := uc.runEmployees(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	records := make([]*domain.PayrollRecord, 0, len(employees))
+
+	for _, emp := range employees {
+		record, err := uc.calculateRecord(ctx, run, emp, inputs, now)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+
+	adjustmentIDs, err := uc.applyAdjustments(ctx, run, records)
+	if err != nil {
+		return err
+	}
+
+	return uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// Lock the run so an approval cannot interleave with the swap.
+		run, err := uc.runRepo.LockByID(txCtx, runID)
+		if err != nil {
+			return err
+		}
+
+		if err := run.MarkCalculated(len(records)); err != nil {
+			return err
+		}
+
+		// Retroactive adjustments are replaced under the same lock; records
+		// built from replaced ones must not be approved.
+		current, err := uc.payableAdjustments(txCtx, run, records)
+		if err != nil {
+			return err
+		}
+		if !sameAdjustments(current, adjustmentIDs) {
+			return domain.ErrAdjustmentsChanged
+		}
+
+		if err := uc.payrollRepo.DeleteByRunID(txCtx, run.ID); err != nil {
+			return err
+		}
+
+		if err := uc.payrollRepo.CreateBatch(txCtx, records); err != nil {
+			return err
+		}
+
+		if err := uc.adjustmentRepo.AssignTargetRun(txCtx, run.ID, adjustmentIDs); err != nil {
+			return err
+		}
+
+		return uc.runRepo.Update(txCtx, run)
+	})
+}
+
+// runInputs holds what every record of a run is calculated from.
+type runInputs struct {
+	components  []*componentdomain.SalaryComponent
+	country     string
+	currency    money.Currency
+	periodStart time.Time
+
+	// regular maps employees to their record in the period's regular run,
+	// on top of which off-cycle pay is taxed.
+	regular map[string]*domain.PayrollRecord
+}
+
+func (uc *CalculatePayrollRunUsecase) loadRunInputs(
+	ctx context.Context,
+	run *domain.PayrollRun,
+) (*runInputs, error) {
+
+	periodStart, err := domain.ParsePeriod(run.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := uc.componentRepo.ListByTenant(ctx, run.TenantID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	country, currency, err := tenantSettings(ctx, uc.tenantProfiles, run.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	// Checked up front so no record is computed for nothing.
+	if _, err := statutoryPack(uc.statutoryRules, country); err != nil {
+		return nil, err
+	}
+
+	in := &runInputs{
+		country:     country,
+		currency:    currency,
+		periodStart: periodStart,
+		regular:     map[string]*domain.PayrollRecord{},
+	}
+
+	for _, c := range components {
+		if c.AppliesTo(run.Type) {
+			in.components = append(in.components, c)
+		}
+	}
+
+	if !run.Type.IsOffCycle() {
+		return in, nil
+	}
+
+	regularRun, err := uc.runRepo.GetByTenantAndPeriod(ctx, run.TenantID, run.Period)
+	if errors.Is(err, payrollrepository.ErrPayrollRunNotFound) {
+		return in, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := uc.payrollRepo.ListByRunID(ctx, regularRun.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		in.regular[r.EmployeeID] = r
+	}
+
+	return in, nil
+}
+
+// runEmployees returns the employees a run pays: those it lists, which
+// may have left already, or else every active employee of the tenant.
+func (uc *CalculatePayrollRunUsecase) runEmployees(
+	ctx context.Context,
+	run *domain.PayrollRun,
+) ([]*employeedomain.Employee, error) {
+
+	if len(run.EmployeeIDs) == 0 {
+		return uc.employeeRepo.ListActiveByTenant(ctx, run.TenantID)
+	}
+
+	employees := make([]*employeedomain.Employee, 0, len(run.EmployeeIDs))
+	for _, id := range run.EmployeeIDs {
+		emp, err := uc.employeeRepo.FindByID(id)
+		if err != nil {
+			return nil, fmt.Errorf("load employee %s: %w", id, err)
+		}
+		employees = append(employees, emp)
+	}
+
+	return employees, nil
+}
+
+// applyAdjustments adds pending retroactive adjustments to the records of
+// their employees and returns the IDs of those applied. Adjustments are
+// paid by the tenant's earliest open regular run only, so two open runs
+// never both pay one. They are added after statutory lines: the
+// adjustment already carries the tax and contribution difference of its
+// period.
+func (uc *CalculatePayrollRunUsecase) applyAdjustments(
+	ctx context.Context,
+	run *domain.PayrollRun,
+	records []*domain.PayrollRecord,
+) ([]string, error) {
+
+	adjustments, err := uc.payableAdjustments(ctx, run, records)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmployee := make(map[string]*domain.PayrollRecord, len(records))
+	for _, r := range records {
+		byEmployee[r.EmployeeID] = r
+	}
+
+	var ids []string
+	for _, a := range adjustments {
+		if err := a.ApplyTo(byEmployee[a.EmployeeID]); err != nil {
+			return nil, fmt.Errorf("apply adjustment %s: %w", a.ID, err)
+		}
+		ids = append(ids, a.ID)
+	}
+
+	return ids, nil
+}
+
+func sameAdjustments(adjustments []*domain.PayrollAdjustment, ids []string) bool {
+	if len(adjustments) != len(ids) {
+		return false
+	}
+	for _, a := range adjustments {
+		if !slices.Contains(ids, a.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// payableAdjustments returns the adjustments the run pays to the
+// employees of records.
+func (uc *CalculatePayrollRunUsecase) payableAdjustments(
+	ctx context.Context,
+	run *domain.PayrollRun,
+	records []*domain.PayrollRecord,
+) ([]*domain.PayrollAdjustment, error) {
+
+	if run.Type.IsOffCycle() {
+		return nil, nil
+	}
+
+	runs, err := uc.runRepo.ListByTenant(ctx, run.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, other := range runs {
+		if other.Type == domain.RunRegular && !other.IsLocked() && other.Period < run.Period {
+			return nil, nil
+		}
+	}
+
+	adjustments, err := uc.adjustmentRepo.ListPayable(ctx, run.TenantID, run.ID, run.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	employees := make(map[string]bool, len(records))
+	for _, r := range records {
+		employees[r.EmployeeID] = true
+	}
+
+	// Adjustments of employees without a record in this run stay pending.
+	payable := adjustments[:0]
+	for _, a := range adjustments {
+		if employees[a.EmployeeID] {
+			payable = append(payable, a)
+		}
+	}
+
+	return payable, nil
+}
+
+// tenantSettings returns the tenant's country and payroll currency. Either
+// is empty when the tenant profile does not set it.
+func tenantSettings(
+	ctx context.Context,
+	profiles tenantprofilerepository.TenantProfileRepository,
+	tenantID string,
+) (string, money.Currency, error) {
+	profile, err := profiles.GetByTenantID(ctx, tenantID)
+	if errors.Is(err, tenantprofilerepository.ErrTenantProfileNotFound) {
+		return "", "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	country := ""
+	if profile.Country != nil {
+		country = *profile.Country
+	}
+
+	var currency money.Currency
+	if profile.Currency != nil {
+		if currency, err = money.ParseCurrency(*profile.Currency); err != nil {
+			return "", "", err
+		}
+	}
+
+	return country, currency, nil
+}
+
+func (uc *CalculatePayrollRunUsecase) calculateRecord(
+	ctx context.Context,
+	run *domain.PayrollRun,
+	emp *employeedomain.Employee,
+	in *runInputs,
+	generatedAt time.Time,
+) (*domain.PayrollRecord, error) {
+	offboarding, err := uc.offboarding(ctx, run, emp)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastDay time.Time
+	if offboarding != nil {
+		lastDay = offboarding.LastWorkingDay
+	}
+
+	base, terminationPay, err := uc.baseAmount(ctx, run, emp, in, lastDay)
+	if err != nil {
+		return nil, err
+	}
+
+	// Salaries are paid in the tenant currency; there is no FX conversion.
+	if in.currency != "" && base.Currency() != in.currency {
+		return nil, fmt.Errorf("employee %s salary in %s, payroll in %s: %w",
+			emp.Code, base.Currency(), in.currency, money.ErrCurrencyMismatch)
+	}
+
+	periodEnd := in.periodStart.AddDate(0, 1, 0).Add(-time.Nanosecond)
+
+	attendance, err := uc.attendanceRepo.ListByDateRange(
+		emp.ID,
+		in.periodStart.Format(time.RFC3339Nano),
+		periodEnd.Format(time.RFC3339Nano),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := domain.NewPayrollRecord(
+		uuid.NewString(),
+		emp.ID,
+		run.Period,
+		base,
+		nil,
+		nil,
+		generatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	record.RunID = &run.ID
+
+	if !terminationPay.IsZero() {
+		err = record.AddLine(domain.PayrollLine{
+			Code:    domain.TerminationPayLine,
+			Name:    "Termination pay",
+			Kind:    domain.LineEarning,
+			Taxable: true,
+			Amount:  terminationPay,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The leave payout was fixed when the offboarding started.
+	if run.Type == domain.RunTermination && !offboarding.LeavePayout.IsZero() {
+		err = record.AddLine(domain.PayrollLine{
+			Code:    domain.LeavePayoutLine,
+			Name:    "Unused leave payout",
+			Kind:    domain.LineEarning,
+			Taxable: true,
+			Amount:  offboarding.LeavePayout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("employee %s leave payout: %w", emp.Code, err)
+		}
+	}
+
+	vars := buildFormulaVariables(emp, base, attendance, in.periodStart)
+	vars[componentdomain.VarMonthsWorked] = float64(run.MonthsWorked(emp.JoinDate, lastDay))
+
+	for _, c := range in.components {
+		amount, err := c.Evaluate(vars, record.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		// Later components may build on earlier ones, e.g. a gross subtotal.
+		vars[c.Code] = amount.Float64()
+
+		kind := domain.LineEarning
+		if c.Kind == componentdomain.Deduction {
+			kind = domain.LineDeduction
+		}
+
+		err = record.AddLine(domain.PayrollLine{
+			Code:    c.Code,
+			Name:    c.Name,
+			Kind:    kind,
+			Taxable: c.Taxable,
+			Amount:  amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := uc.profileRepo.GetByEmployeeID(ctx, emp.ID)
+	if errors.Is(err, statutoryrepository.ErrEmployeeProfileNotFound) {
+		profile = statutorydomain.DefaultEmployeeProfile(emp.ID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	dependents, err := eligibleDependents(ctx, uc.dependentRepo, profile, in.periodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	statutory := statutoryInput{
+		record:      record,
+		profile:     profile,
+		periodStart: in.periodStart,
+		dependents:  dependents,
+		offCycle:    run.Type.IsOffCycle(),
+		regular:     in.regular[emp.ID],
+	}
+	if err := applyStatutoryLines(uc.statutoryRules, in.country, statutory); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// offboarding returns the employee's offboarding, or nil when they have
+// none. Only termination runs and runs pro-rated by the year need it;
+// termination runs settle offboarded employees only.
+func (uc *CalculatePayrollRunUsecase) offboarding(
+	ctx context.Context,
+	run *domain.PayrollRun,
+	emp *employeedomain.Employee,
+) (*offboardingdomain.Offboarding, error) {
+
+	if run.ProrateYear == nil && run.Type != domain.RunTermination {
+		return nil, nil
+	}
+
+	o, err := uc.offboardings.GetByEmployeeID(ctx, emp.ID)
+	if errors.Is(err, offboardingrepository.ErrOffboardingNotFound) {
+		if run.Type == domain.RunTermination {
+			return nil, fmt.Errorf("employee %s: %w", emp.Code, domain.ErrNotOffboarded)
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// baseAmount is the record's base: the month's salary prorated across
+// salary changes for regular runs, or for off-cycle runs the salary in
+// effect at the end of the period times the run's multiplier, pro-rated
+// by the months worked up to lastDay. Termination runs instead pay the
+// period's salary earned up to lastDay that the regular run did not, and
+// return the multiplier amount as terminationPay.
+func (uc *CalculatePayrollRunUsecase) baseAmount(
+	ctx context.Context,
+	run *domain.PayrollRun,
+	emp *employeedomain.Employee,
+	in *runInputs,
+	lastDay time.Time,
+) (base, terminationPay money.Money, err error) {
+
+	history, err := uc.salaryRepo.ListByEmployeeID(ctx, emp.ID)
+	if err != nil {
+		return money.Money{}, money.Money{}, err
+	}
+
+	periodEnd := in.periodStart.AddDate(0, 1, 0)
+
+	if !run.Type.IsOffCycle() {
+		base, err := history.ProratedSalary(in.periodStart, periodEnd, emp.BaseSalary)
+		if err != nil {
+			return money.Money{}, money.Money{}, fmt.Errorf("employee %s salary: %w", emp.Code, err)
+		}
+		return base, money.Zero(base.Currency()), nil
+	}
+
+	salary, ok := history.SalaryOn(periodEnd.AddDate(0, 0, -1))
+	if !ok {
+		salary = emp.BaseSalary
+	}
+
+	offCycle, err := run.OffCycleBase(salary, emp.JoinDate, lastDay)
+	if err != nil {
+		return money.Money{}, money.Money{}, fmt.Errorf("employee %s off-cycle amount: %w", emp.Code, err)
+	}
+
+	if run.Type != domain.RunTermination {
+		return offCycle, money.Zero(offCycle.Currency()), nil
+	}
+
+	earned, err := history.EarnedSalary(in.periodStart, emp.JoinDate, lastDay, emp.BaseSalary)
+	if err != nil {
+		return money.Money{}, money.Money{}, fmt.Errorf("employee %s final salary: %w", emp.Code, err)
+	}
+
+	base, err = domain.FinalMonthBase(earned, in.regular[emp.ID])
+	if err != nil {
+		return money.Money{}, money.Money{}, fmt.Errorf("employee %s final salary: %w", emp.Code, err)
+	}
+	return base, offCycle, nil
+}
@@ -0,0 +1,268 @@
+package payrollusecase
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type ComputeRetroAdjustmentsUsecase struct {
+	runRepo        payrollrepository.PayrollRunRepository
+	payrollRepo    payrollrepository.PayrollRepository
+	adjustmentRepo payrollrepository.AdjustmentRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	calculator     *CalculatePayrollRunUsecase
+	queueSvc       queueports.QueueService
+	txManager      txpkg.Manager
+}
+
+func NewComputeRetroAdjustmentsUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+	adjustmentRepo payrollrepository.AdjustmentRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	calculator *CalculatePayrollRunUsecase,
+	queueSvc queueports.QueueService,
+	txManager txpkg.Manager,
+) *ComputeRetroAdjustmentsUsecase {
+	return &ComputeRetroAdjustmentsUsecase{
+		runRepo:        runRepo,
+		payrollRepo:    payrollRepo,
+		adjustmentRepo: adjustmentRepo,
+		employeeRepo:   employeeRepo,
+		calculator:     calculator,
+		queueSvc:       queueSvc,
+		txManager:      txManager,
+	}
+}
+
+type ComputeRetroAdjustmentsInput struct {
+	RunID string
+	// EmployeeIDs limits the recalculation; empty means every record of the run.
+	EmployeeIDs []string
+	Reason      string
+	UserID      string
+}
+
+// Execute recalculates the records of an approved run with today's data,
+// such as a backdated salary or corrected attendance, and stores the
+// difference as adjustments for the next open run. A new computation
+// replaces adjustments of the same record that are not yet paid; runs
+// already calculated with the replaced ones are recalculated.
+func (uc *ComputeRetroAdjustmentsUsecase) Execute(
+	ctx context.Context,
+	in ComputeRetroAdjustmentsInput,
+) ([]*domain.PayrollAdjustment, error) {
+
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, domain.ErrAdjustmentReason
+	}
+
+	run, err := uc.runRepo.GetByID(ctx, in.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !run.IsLocked() {
+		return nil, domain.ErrRetroRunNotLocked
+	}
+
+	records, err := uc.payrollRepo.ListByRunID(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err = filterRecordsByEmployee(records, in.EmployeeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := uc.calculator.loadRunInputs(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	recalculated := make(map[string]*domain.PayrollRecord, len(records))
+
+	for _, original := range records {
+		emp, err := uc.employeeRepo.FindByID(original.EmployeeID)
+		if err != nil {
+			return nil, fmt.Errorf("load employee %s: %w", original.EmployeeID, err)
+		}
+
+		recalculated[original.ID], err = uc.calculator.calculateRecord(ctx, run, emp, inputs, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	created := []*domain.PayrollAdjustment{}
+	var reopened []string
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// Lock every run that pays or may pay these records' adjustments,
+		// so none is approved while its adjustments are replaced; the
+		// settled ones are read under the same locks.
+		runs, err := uc.lockPayingRuns(txCtx, run, records)
+		if err != nil {
+			return err
+		}
+
+		for _, original := range records {
+			settled, err := uc.adjustmentRepo.ListSettledBySourceRecordID(txCtx, original.ID)
+			if err != nil {
+				return err
+			}
+
+			adj, err := domain.NewPayrollAdjustment(run.TenantID, original, recalculated[original.ID], settled, reason, in.UserID)
+			if err != nil {
+				return err
+			}
+
+			if err := uc.adjustmentRepo.DeleteUnsettledBySourceRecordID(txCtx, original.ID); err != nil {
+				return err
+			}
+
+			if adj == nil {
+				continue
+			}
+
+			if err := uc.adjustmentRepo.Create(txCtx, adj); err != nil {
+				return err
+			}
+			created = append(created, adj)
+		}
+
+		// Calculated runs hold the replaced lines; they go back to draft
+		// and cannot be approved before they are recalculated.
+		for _, r := range runs {
+			if r.Status != domain.RunCalculated {
+				continue
+			}
+			if err := r.Reopen(); err != nil {
+				return err
+			}
+			if err := uc.runRepo.Update(txCtx, r); err != nil {
+				return err
+			}
+			reopened = append(reopened, r.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range reopened {
+		if err := enqueueRunCalculation(ctx, uc.queueSvc, id); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, nil
+}
+
+// lockPayingRuns locks, in ID order, the open runs the records'
+// adjustments are assigned to and the tenant's earliest open regular run
+// after the source period, which pays new adjustments.
+func (uc *ComputeRetroAdjustmentsUsecase) lockPayingRuns(
+	ctx context.Context,
+	source *domain.PayrollRun,
+	records []*domain.PayrollRecord,
+) ([]*domain.PayrollRun, error) {
+
+	ids := map[string]bool{}
+
+	inRecords := make(map[string]bool, len(records))
+	for _, r := range records {
+		inRecords[r.ID] = true
+	}
+
+	existing, err := uc.adjustmentRepo.ListBySourceRunID(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range existing {
+		if inRecords[a.SourceRecordID] && a.TargetRunID != nil {
+			ids[*a.TargetRunID] = true
+		}
+	}
+
+	runs, err := uc.runRepo.ListByTenant(ctx, source.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var paying *domain.PayrollRun
+	for _, r := range runs {
+		if r.Type != domain.RunRegular || r.IsLocked() {
+			continue
+		}
+		if paying == nil || r.Period < paying.Period {
+			paying = r
+		}
+	}
+	if paying != nil && paying.Period > source.Period {
+		ids[paying.ID] = true
+	}
+
+	sorted := slices.Sorted(maps.Keys(ids))
+	locked := make([]*domain.PayrollRun, 0, len(sorted))
+	for _, id := range sorted {
+		r, err := uc.runRepo.LockByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		// Approved runs have settled their adjustments, which stay.
+		if !r.IsLocked() {
+			locked = append(locked, r)
+		}
+	}
+
+	return locked, nil
+}
+
+func filterRecordsByEmployee(records []*domain.PayrollRecord, employeeIDs []string) ([]*domain.PayrollRecord, error) {
+	if len(employeeIDs) == 0 {
+		return records, nil
+	}
+
+	byEmployee := make(map[string]*domain.PayrollRecord, len(records))
+	for _, r := range records {
+		byEmployee[r.EmployeeID] = r
+	}
+
+	var result []*domain.PayrollRecord
+	var missing []string
+	seen := map[string]bool{}
+	for _, id := range employeeIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		r, ok := byEmployee[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		result = append(result, r)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAdjustmentEmployees, strings.Join(missing, ", "))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package payrollusecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type CreatePayrollRunUsecase struct {
+	runRepo      payrollrepository.PayrollRunRepository
+	employeeRepo employeerepository.EmployeeRepository
+	queueSvc     queueports.QueueService
+}
+
+func NewCreatePayrollRunUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	queueSvc queueports.QueueService,
+) *CreatePayrollRunUsecase {
+	return &CreatePayrollRunUsecase{
+		runRepo:      runRepo,
+		employeeRepo: employeeRepo,
+		queueSvc:     queueSvc,
+	}
+}
+
+// Execute creates a run and queues its calculation. A tenant has one
+// regular run per period; off-cycle runs may share a period with it.
+func (uc *CreatePayrollRunUsecase) Execute(
+	ctx context.Context,
+	in domain.NewPayrollRunInput,
+) (*domain.PayrollRun, error) {
+	run, err := domain.NewPayrollRun(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.Type == domain.RunRegular {
+		existing, err := uc.runRepo.GetByTenantAndPeriod(ctx, run.TenantID, run.Period)
+		if err != nil && !errors.Is(err, payrollrepository.ErrPayrollRunNotFound) {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, payrollrepository.ErrPayrollRunAlreadyExists
+		}
+	}
+
+	for _, id := range run.EmployeeIDs {
+		if _, err := uc.employeeRepo.FindByID(id); err != nil {
+			return nil, fmt.Errorf("load employee %s: %w", id, err)
+		}
+	}
+
+	if err := uc.runRepo.Create(ctx, run); err != nil {
+		return nil, err
+	}
+
+	if err := enqueueRunCalculation(ctx, uc.queueSvc, run.ID); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+func enqueueRunCalculation(ctx context.Context, queueSvc queueports.QueueService, runID string) error {
+	data, err := json.Marshal(worker.CalculatePayrollRunPayload{RunID: runID})
+	if err != nil {
+		return err
+	}
+
+	return queueSvc.Publish(ctx, worker.CalculatePayrollRunTopic, queueports.Message{
+		Body: data,
+	})
+}
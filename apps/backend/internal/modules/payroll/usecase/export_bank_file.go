@@ -0,0 +1,255 @@
+package payrollusecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/bankfile"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
+	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type ExportBankFileUsecase struct {
+	runRepo        payrollrepository.PayrollRunRepository
+	payrollRepo    payrollrepository.PayrollRepository
+	exportRepo     payrollrepository.BankExportRepository
+	accountRepo    bankaccountrepository.BankAccountRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	tenantRepo     tenantrepository.TenantRepository
+	tenantProfiles tenantprofilerepository.TenantProfileRepository
+	formatters     *bankfile.Registry
+	access         *PayAccess
+}
+
+func NewExportBankFileUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+	exportRepo payrollrepository.BankExportRepository,
+	accountRepo bankaccountrepository.BankAccountRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	tenantRepo tenantrepository.TenantRepository,
+	tenantProfiles tenantprofilerepository.TenantProfileRepository,
+	formatters *bankfile.Registry,
+	access *PayAccess,
+) *ExportBankFileUsecase {
+	return &ExportBankFileUsecase{
+		runRepo:        runRepo,
+		payrollRepo:    payrollRepo,
+		exportRepo:     exportRepo,
+		accountRepo:    accountRepo,
+		employeeRepo:   employeeRepo,
+		tenantRepo:     tenantRepo,
+		tenantProfiles: tenantProfiles,
+		formatters:     formatters,
+		access:         access,
+	}
+}
+
+type ExportBankFileInput struct {
+	RunID  string
+	Format string
+	// Currency picks the records to pay when a run mixes currencies.
+	Currency      money.Currency
+	DebtorAccount string
+	DebtorBankBIC string
+	ExecutionDate *time.Time
+	UserID        string
+}
+
+type BankFile struct {
+	Export      *domain.BankExport
+	ContentType string
+	Data        []byte
+}
+
+// Execute builds a transfer file for every positive net salary of an
+// approved run and records the export with the file checksum. Only HR
+// and admins of the run's tenant export it.
+func (uc *ExportBankFileUsecase) Execute(ctx context.Context, in ExportBankFileInput) (*BankFile, error) {
+	formatter, ok := uc.formatters.Lookup(in.Format)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownBankFormat, in.Format)
+	}
+
+	run, err := uc.runRepo.GetByID(ctx, in.RunID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.Tenant(ctx, in.UserID, run.TenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	if !run.IsLocked() {
+		return nil, domain.ErrBankExportRunNotApproved
+	}
+
+	records, err := uc.payrollRepo.ListByRunID(ctx, run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, err := exportCurrency(records, in.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	debtorName, _, err := tenantBranding(ctx, uc.tenantRepo, uc.tenantProfiles, run.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	execution := now
+	if in.ExecutionDate != nil {
+		execution = *in.ExecutionDate
+	}
+
+	batch := &domain.TransferBatch{
+		MessageID:     strings.ReplaceAll(uuid.NewString(), "-", ""),
+		RunID:         run.ID,
+		Period:        run.Period,
+		Currency:      currency,
+		CreatedAt:     now,
+		ExecutionDate: execution,
+		DebtorName:    debtorName,
+		DebtorAccount: in.DebtorAccount,
+		DebtorBankBIC: in.DebtorBankBIC,
+		Total:         money.Zero(currency),
+	}
+
+	var payable []*domain.PayrollRecord
+	employeeIDs := make([]string, 0, len(records))
+	for _, r := range records {
+		if r.Currency != currency || r.NetSalary.IsNegative() || r.NetSalary.IsZero() {
+			continue
+		}
+		payable = append(payable, r)
+		employeeIDs = append(employeeIDs, r.EmployeeID)
+	}
+
+	if len(payable) == 0 {
+		return nil, domain.ErrNoTransfers
+	}
+
+	accounts, err := uc.accountRepo.ListPrimaryByEmployeeIDs(ctx, employeeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing, mismatched []string
+
+	for _, r := range payable {
+		emp, err := uc.employeeRepo.FindByID(r.EmployeeID)
+		if err != nil {
+			return nil, fmt.Errorf("load employee %s: %w", r.EmployeeID, err)
+		}
+
+		account, ok := accounts[r.EmployeeID]
+		if !ok {
+			missing = append(missing, emp.Code)
+			continue
+		}
+		if account.Currency != "" && account.Currency != currency {
+			mismatched = append(mismatched, emp.Code)
+			continue
+		}
+
+		branch := ""
+		if account.BranchCode != nil {
+			branch = *account.BranchCode
+		}
+
+		amount := r.NetSalary.Round()
+		batch.Transfers = append(batch.Transfers, domain.Transfer{
+			RecordID:      r.ID,
+			EmployeeCode:  emp.Code,
+			EmployeeName:  emp.FirstName + " " + emp.LastName,
+			BankName:      account.BankName,
+			BankCode:      account.BankCode,
+			BranchCode:    branch,
+			AccountHolder: account.AccountHolder,
+			AccountNumber: account.AccountNumber,
+			Amount:        amount,
+			Reference:     fmt.Sprintf("SAL-%s-%s", run.Period, emp.Code),
+		})
+
+		if batch.Total, err = batch.Total.Add(amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrMissingBankAccounts, strings.Join(missing, ", "))
+	}
+	if len(mismatched) > 0 {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBankCurrency, strings.Join(mismatched, ", "))
+	}
+
+	data, err := formatter.Render(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("salary-%s-%s.%s", run.Period, formatter.Name(), formatter.Extension())
+	export := domain.NewBankExport(batch, formatter.Name(), fileName, data, in.UserID)
+
+	if err := uc.exportRepo.Create(ctx, export); err != nil {
+		return nil, err
+	}
+
+	return &BankFile{
+		Export:      export,
+		ContentType: formatter.ContentType(),
+		Data:        data,
+	}, nil
+}
+
+func exportCurrency(records []*domain.PayrollRecord, requested money.Currency) (money.Currency, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	seen := map[money.Currency]bool{}
+	var currency money.Currency
+	for _, r := range records {
+		seen[r.Currency] = true
+		currency = r.Currency
+	}
+
+	if len(seen) > 1 {
+		return "", domain.ErrMixedRunCurrencies
+	}
+	if currency == "" {
+		return "", domain.ErrNoTransfers
+	}
+	return currency, nil
+}
+
+// ListExports lists the files exported for the run to HR and admins of
+// its tenant.
+func (uc *ExportBankFileUsecase) ListExports(ctx context.Context, runID, userID string) ([]*domain.BankExport, error) {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.Tenant(ctx, userID, run.TenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return uc.exportRepo.ListByRunID(ctx, run.ID)
+}
+
+// Formats lists the bank file formats clients can request.
+func (uc *ExportBankFileUsecase) Formats() []string {
+	return uc.formatters.Names()
+}
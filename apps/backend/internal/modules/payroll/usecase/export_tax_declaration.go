@@ -0,0 +1,80 @@
+package payrollusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/taxfile"
+)
+
+type ExportTaxDeclarationUsecase struct {
+	taxYear    *BuildTaxYearUsecase
+	formatters *taxfile.Registry
+	access     *PayAccess
+}
+
+func NewExportTaxDeclarationUsecase(
+	taxYear *BuildTaxYearUsecase,
+	formatters *taxfile.Registry,
+	access *PayAccess,
+) *ExportTaxDeclarationUsecase {
+	return &ExportTaxDeclarationUsecase{
+		taxYear:    taxYear,
+		formatters: formatters,
+		access:     access,
+	}
+}
+
+type ExportTaxDeclarationInput struct {
+	TenantID string
+	Year     int
+	Format   string
+	// CompanyTaxCode is the employer's tax identification number, which
+	// the tenant profile does not hold.
+	CompanyTaxCode string
+	UserID         string
+}
+
+type TaxDeclarationFile struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// Execute renders the employer's annual declaration for the tax year.
+// Only HR and admins of the tenant export it.
+func (uc *ExportTaxDeclarationUsecase) Execute(ctx context.Context, in ExportTaxDeclarationInput) (*TaxDeclarationFile, error) {
+	formatter, ok := uc.formatters.Lookup(in.Format)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownDeclarationFormat, in.Format)
+	}
+
+	if err := uc.access.Tenant(ctx, in.UserID, in.TenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	taxYear, err := uc.taxYear.Execute(ctx, in.TenantID, in.Year)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := formatter.Render(&domain.TaxDeclaration{
+		TaxYear:        taxYear,
+		CompanyTaxCode: in.CompanyTaxCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaxDeclarationFile{
+		FileName:    fmt.Sprintf("tax-declaration-%d.%s", in.Year, formatter.Extension()),
+		ContentType: formatter.ContentType(),
+		Data:        data,
+	}, nil
+}
+
+// Formats lists the declaration formats clients can request.
+func (uc *ExportTaxDeclarationUsecase) Formats() []string {
+	return uc.formatters.Names()
+}
@@ -0,0 +1,79 @@
+package payrollusecase
+
+import (
+	"math"
+	"time"
+
+	attendancedomain "github.com/smart-hmm/smart-hmm/internal/modules/attendance/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	componentdomain "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// standardHoursPerDay is the threshold above which weekday hours count as overtime.
+const standardHoursPerDay = 8.0
+
+// buildFormulaVariables derives the built-in formula variables for one
+// employee over the month starting at periodStart. base is the salary for
+// the period, prorated across salary changes.
+func buildFormulaVariables(
+	emp *employeedomain.Employee,
+	base money.Money,
+	attendance []*attendancedomain.AttendanceRecord,
+	periodStart time.Time,
+) map[string]interface{} {
+	periodEnd := periodStart.AddDate(0, 1, 0)
+
+	workedDays := map[string]struct{}{}
+	ot150 := 0.0
+	ot200 := 0.0
+
+	for _, a := range attendance {
+		if a.ClockOut == nil {
+			continue
+		}
+
+		workedDays[a.ClockIn.Format(time.DateOnly)] = struct{}{}
+
+		if isWeekend(a.ClockIn) {
+			ot200 += a.TotalHours
+			continue
+		}
+		ot150 += math.Max(0, a.TotalHours-standardHoursPerDay)
+	}
+
+	return map[string]interface{}{
+		componentdomain.VarBaseSalary:     base.Float64(),
+		componentdomain.VarWorkedDays:     float64(len(workedDays)),
+		componentdomain.VarStandardDays:   float64(countWeekdays(periodStart, periodEnd)),
+		componentdomain.VarOTHours150:     ot150,
+		componentdomain.VarOTHours200:     ot200,
+		componentdomain.VarSeniorityYears: float64(fullYearsBetween(emp.JoinDate, periodEnd)),
+	}
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+func countWeekdays(from, to time.Time) int {
+	n := 0
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if !isWeekend(d) {
+			n++
+		}
+	}
+	return n
+}
+
+func fullYearsBetween(from, to time.Time) int {
+	if from.IsZero() || !from.Before(to) {
+		return 0
+	}
+
+	years := to.Year() - from.Year()
+	if to.YearDay() < from.YearDay() {
+		years--
+	}
+	return max(years, 0)
+}
@@ -0,0 +1,366 @@
+package payrollusecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	leavedomain "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/domain"
+	leaverequestrepository "github.com/smart-hmm/smart-hmm/internal/modules/leave_request/repository"
+	leavetyperepository "github.com/smart-hmm/smart-hmm/internal/modules/leave_type/repository"
+	offboardingdomain "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
+	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type GeneratePayslipsUsecase struct {
+	runRepo        payrollrepository.PayrollRunRepository
+	payrollRepo    payrollrepository.PayrollRepository
+	payslipRepo    payrollrepository.PayslipRepository
+	preferenceRepo payrollrepository.PayslipPreferenceRepository
+	employeeRepo   employeerepository.EmployeeRepository
+	leaveTypeRepo  leavetyperepository.LeaveTypeRepository
+	leaveRepo      leaverequestrepository.LeaveRequestRepository
+	tenantRepo     tenantrepository.TenantRepository
+	tenantProfiles tenantprofilerepository.TenantProfileRepository
+	renderer       domain.PayslipRenderer
+	storage        storageports.StorageService
+}
+
+func NewGeneratePayslipsUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	payrollRepo payrollrepository.PayrollRepository,
+	payslipRepo payrollrepository.PayslipRepository,
+	preferenceRepo payrollrepository.PayslipPreferenceRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	leaveTypeRepo leavetyperepository.LeaveTypeRepository,
+	leaveRepo leaverequestrepository.LeaveRequestRepository,
+	tenantRepo tenantrepository.TenantRepository,
+	tenantProfiles tenantprofilerepository.TenantProfileRepository,
+	renderer domain.PayslipRenderer,
+	storage storageports.StorageService,
+) *GeneratePayslipsUsecase {
+	return &GeneratePayslipsUsecase{
+		runRepo:        runRepo,
+		payrollRepo:    payrollRepo,
+		payslipRepo:    payslipRepo,
+		preferenceRepo: preferenceRepo,
+		employeeRepo:   employeeRepo,
+		leaveTypeRepo:  leaveTypeRepo,
+		leaveRepo:      leaveRepo,
+		tenantRepo:     tenantRepo,
+		tenantProfiles: tenantProfiles,
+		renderer:       renderer,
+		storage:        storage,
+	}
+}
+
+// Execute renders and stores a payslip for every record of an approved run.
+// Existing payslips are overwritten, so the job is safe to retry.
+func (uc *GeneratePayslipsUsecase) Execute(ctx context.Context, runID string) error {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return err
+	}
+
+	if !run.IsLocked() {
+		return domain.ErrPayslipRunNotApproved
+	}
+
+	company, logoURL, err := tenantBranding(ctx, uc.tenantRepo, uc.tenantProfiles, run.TenantID)
+	if err != nil {
+		return err
+	}
+
+	records, err := uc.payrollRepo.ListByRunID(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+
+	locked, err := lockedRunIDs(ctx, uc.runRepo, run.TenantID)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		emp, err := uc.employeeRepo.FindByID(record.EmployeeID)
+		if err != nil {
+			return fmt.Errorf("load employee %s: %w", record.EmployeeID, err)
+		}
+
+		doc, err := uc.document(record, emp, locked)
+		if err != nil {
+			return err
+		}
+		doc.CompanyName = company
+		doc.LogoURL = logoURL
+
+		pref, err := uc.preferenceRepo.GetByEmployeeID(ctx, emp.ID)
+		if err != nil {
+			return err
+		}
+
+		protected := pref != nil && pref.PasswordProtected
+		if protected {
+			password, err := domain.PayslipPassword(emp.DateOfBirth)
+			if err != nil {
+				// Never fall back to an unprotected payslip the employee opted out of.
+				if err := uc.payslipRepo.Upsert(ctx, domain.NewFailedPayslip(record, err)); err != nil {
+					return err
+				}
+				continue
+			}
+			doc.Password = password
+		}
+
+		data, err := uc.renderer.Render(ctx, doc)
+		if err != nil {
+			return fmt.Errorf("render payslip %s: %w", record.ID, err)
+		}
+
+		dir := path.Join("payslips", run.TenantID, run.Period)
+		filename := record.ID + ".pdf"
+
+		if _, err := uc.storage.Upload(ctx, storageports.UploadInput{
+			Path:        dir,
+			Filename:    filename,
+			Reader:      bytes.NewReader(data),
+			Size:        int64(len(data)),
+			ContentType: "application/pdf",
+			Private:     true,
+		}); err != nil {
+			return fmt.Errorf("upload payslip %s: %w", record.ID, err)
+		}
+
+		payslip := domain.NewGeneratedPayslip(record, path.Join(dir, filename), protected)
+		if err := uc.payslipRepo.Upsert(ctx, payslip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tenantBranding returns the tenant's legal name, falling back to its
+// display name, and its logo URL.
+func tenantBranding(
+	ctx context.Context,
+	tenants tenantrepository.TenantRepository,
+	profiles tenantprofilerepository.TenantProfileRepository,
+	tenantID string,
+) (string, *string, error) {
+
+	tenant, err := tenants.GetByID(ctx, tenantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	profile, err := profiles.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, tenantprofilerepository.ErrTenantProfileNotFound) {
+			return tenant.Name, nil, nil
+		}
+		return "", nil, err
+	}
+
+	name := tenant.Name
+	if profile.LegalName != nil && *profile.LegalName != "" {
+		name = *profile.LegalName
+	}
+
+	return name, profile.LogoURL, nil
+}
+
+// lockedRunIDs returns the IDs of the tenant's approved and paid runs.
+func lockedRunIDs(
+	ctx context.Context,
+	runs payrollrepository.PayrollRunRepository,
+	tenantID string,
+) (map[string]bool, error) {
+
+	all, err := runs.ListByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := make(map[string]bool, len(all))
+	for _, r := range all {
+		if r.IsLocked() {
+			locked[r.ID] = true
+		}
+	}
+	return locked, nil
+}
+
+func (uc *GeneratePayslipsUsecase) document(
+	record *domain.PayrollRecord,
+	emp *employeedomain.Employee,
+	lockedRuns map[string]bool,
+) (*domain.PayslipDocument, error) {
+
+	doc := &domain.PayslipDocument{
+		EmployeeName:    emp.FirstName + " " + emp.LastName,
+		EmployeeCode:    emp.Code,
+		Position:        emp.Position,
+		Period:          record.Period,
+		Currency:        record.Currency,
+		BaseSalary:      record.BaseSalary,
+		GrossPay:        record.GrossPay(),
+		TotalDeductions: record.TotalDeductions(),
+		NetPay:          record.NetSalary,
+	}
+	if emp.DepartmentName != nil {
+		doc.Department = *emp.DepartmentName
+	}
+
+	for _, l := range record.Lines {
+		switch l.Kind {
+		case domain.LineEarning:
+			doc.Earnings = append(doc.Earnings, l)
+		case domain.LineDeduction:
+			doc.Deductions = append(doc.Deductions, l)
+		case domain.LineEmployerContribution:
+			doc.Employer = append(doc.Employer, l)
+		}
+	}
+
+	ytd, err := uc.yearToDate(record, lockedRuns)
+	if err != nil {
+		return nil, err
+	}
+	doc.YTD = ytd
+
+	balances, err := uc.leaveBalances(record)
+	if err != nil {
+		return nil, err
+	}
+	doc.LeaveBalances = balances
+
+	return doc, nil
+}
+
+// yearToDate sums the employee's records from January up to the record's
+// period. Only records of lockedRuns count: drafts and runs still being
+// calculated have not been paid. Records paid in another currency are
+// left out.
+func (uc *GeneratePayslipsUsecase) yearToDate(
+	record *domain.PayrollRecord,
+	lockedRuns map[string]bool,
+) (domain.PayslipYTD, error) {
+
+	ytd := domain.PayslipYTD{
+		GrossPay:   money.Zero(record.Currency),
+		Deductions: money.Zero(record.Currency),
+		NetPay:     money.Zero(record.Currency),
+	}
+
+	records, err := uc.payrollRepo.ListByEmployee(record.EmployeeID)
+	if err != nil {
+		return ytd, err
+	}
+
+	year := record.Period[:4]
+	for _, r := range records {
+		if r.Period[:4] != year || r.Period > record.Period || r.Currency != record.Currency {
+			continue
+		}
+		if r.RunID == nil || !lockedRuns[*r.RunID] {
+			continue
+		}
+
+		if ytd.GrossPay, err = ytd.GrossPay.Add(r.GrossPay()); err != nil {
+			return ytd, err
+		}
+		if ytd.Deductions, err = ytd.Deductions.Add(r.TotalDeductions()); err != nil {
+			return ytd, err
+		}
+		if ytd.NetPay, err = ytd.NetPay.Add(r.NetSalary); err != nil {
+			return ytd, err
+		}
+	}
+
+	return ytd, nil
+}
+
+// leaveBalances counts approved leave days taken from January to the end
+// of the record's period against each type's yearly entitlement.
+func (uc *GeneratePayslipsUsecase) leaveBalances(record *domain.PayrollRecord) ([]domain.LeaveBalance, error) {
+	periodStart, err := domain.ParsePeriod(record.Period)
+	if err != nil {
+		return nil, err
+	}
+	yearStart := time.Date(periodStart.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := periodStart.AddDate(0, 1, 0)
+
+	types, err := uc.leaveTypeRepo.ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := uc.leaveRepo.ListByEmployee(record.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := map[string]int{}
+	for _, req := range requests {
+		if req.Status != leavedomain.Approved {
+			continue
+		}
+		taken[req.LeaveTypeID] += daysWithin(req.StartDate, req.EndDate, yearStart, periodEnd)
+	}
+
+	balances := make([]domain.LeaveBalance, 0, len(types))
+	for _, t := range types {
+		balances = append(balances, domain.LeaveBalance{
+			LeaveType: t.Name,
+			Entitled:  t.DefaultDays,
+			Taken:     taken[t.ID],
+			Remaining: t.DefaultDays - taken[t.ID],
+		})
+	}
+
+	return balances, nil
+}
+
+// daysWithin counts the weekdays of the inclusive leave [start, end] that
+// fall in [from, to).
+func daysWithin(start, end, from, to time.Time) int {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	end = time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	if !end.After(start) {
+		return 0
+	}
+
+	return offboardingdomain.Weekdays(start, end)
+}
+
+func enqueuePayslipGeneration(ctx context.Context, queueSvc queueports.QueueService, runID string) error {
+	data, err := json.Marshal(worker.GeneratePayslipsPayload{RunID: runID})
+	if err != nil {
+		return err
+	}
+
+	return queueSvc.Publish(ctx, worker.GeneratePayslipsTopic, queueports.Message{
+		Body: data,
+	})
+}
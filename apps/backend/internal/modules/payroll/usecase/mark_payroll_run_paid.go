@@ -0,0 +1,56 @@
+package payrollusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type MarkPayrollRunPaidUsecase struct {
+	runRepo   payrollrepository.PayrollRunRepository
+	txManager txpkg.Manager
+	access    *PayAccess
+}
+
+func NewMarkPayrollRunPaidUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	txManager txpkg.Manager,
+	access *PayAccess,
+) *MarkPayrollRunPaidUsecase {
+	return &MarkPayrollRunPaidUsecase{
+		runRepo:   runRepo,
+		txManager: txManager,
+		access:    access,
+	}
+}
+
+// Execute marks an approved run paid. Only HR and admins of the run's
+// tenant may do so, and the run is locked so the transition cannot race
+// an approval or a reopen.
+func (uc *MarkPayrollRunPaidUsecase) Execute(ctx context.Context, runID, userID string) (*domain.PayrollRun, error) {
+	var run *domain.PayrollRun
+	err := uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		var err error
+		run, err = uc.runRepo.LockByID(txCtx, runID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.access.Tenant(txCtx, userID, run.TenantID); err != nil {
+			return denied(err, domain.ErrRunForbidden)
+		}
+
+		if err := run.MarkPaid(); err != nil {
+			return err
+		}
+
+		return uc.runRepo.Update(txCtx, run)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,100 @@
+package payrollusecase
+
+import (
+	"context"
+	"errors"
+
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	tenantmemberrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_member/repository"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+// ErrNoPayAccess is returned to users who may not see the pay; callers
+// outside payroll map it to their own forbidden error.
+var ErrNoPayAccess = errors.New("no access to this pay")
+
+// PayAccess decides who may see pay: the employee it belongs to, and HR
+// and admins of the employee's tenant.
+type PayAccess struct {
+	userRepo     userrepository.UserRepository
+	employeeRepo employeerepository.EmployeeRepository
+	memberRepo   tenantmemberrepository.TenantMemberRepository
+}
+
+func NewPayAccess(
+	userRepo userrepository.UserRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	memberRepo tenantmemberrepository.TenantMemberRepository,
+) *PayAccess {
+	return &PayAccess{
+		userRepo:     userRepo,
+		employeeRepo: employeeRepo,
+		memberRepo:   memberRepo,
+	}
+}
+
+// Employee returns ErrNoPayAccess unless the user may see the pay of the
+// employee.
+func (a *PayAccess) Employee(ctx context.Context, userID, employeeID string) error {
+	user, err := a.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.EmployeeID != nil && *user.EmployeeID == employeeID {
+		return nil
+	}
+	if !isPayroller(user) {
+		return ErrNoPayAccess
+	}
+
+	emp, err := a.employeeRepo.FindByID(employeeID)
+	if err != nil {
+		return err
+	}
+	return a.inTenant(ctx, user, emp.TenantID)
+}
+
+// Tenant returns ErrNoPayAccess unless the user is HR or an admin of the
+// tenant.
+func (a *PayAccess) Tenant(ctx context.Context, userID, tenantID string) error {
+	user, err := a.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	if !isPayroller(user) {
+		return ErrNoPayAccess
+	}
+	return a.inTenant(ctx, user, tenantID)
+}
+
+// inTenant accepts users employed by the tenant or added as members.
+func (a *PayAccess) inTenant(ctx context.Context, user *userdomain.User, tenantID string) error {
+	if user.EmployeeID != nil {
+		emp, err := a.employeeRepo.FindByID(*user.EmployeeID)
+		if err == nil && emp.TenantID == tenantID {
+			return nil
+		}
+	}
+
+	member, err := a.memberRepo.Exists(ctx, tenantID, user.ID)
+	if err != nil {
+		return err
+	}
+	if !member {
+		return ErrNoPayAccess
+	}
+	return nil
+}
+
+func isPayroller(user *userdomain.User) bool {
+	return user.Role == userdomain.Admin || user.Role == userdomain.HR
+}
+
+// denied replaces ErrNoPayAccess with the caller's forbidden error.
+func denied(err, forbidden error) error {
+	if errors.Is(err, ErrNoPayAccess) {
+		return forbidden
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+package payrollusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type GetPayrollRecordUsecase struct {
+	repo   payrollrepository.PayrollRepository
+	access *PayAccess
+}
+
+func NewGetPayrollRecordUsecase(repo payrollrepository.PayrollRepository, access *PayAccess) *GetPayrollRecordUsecase {
+	return &GetPayrollRecordUsecase{repo: repo, access: access}
+}
+
+// Execute returns the record, or nil when there is none.
+func (uc *GetPayrollRecordUsecase) Execute(ctx context.Context, id, userID string) (*domain.PayrollRecord, error) {
+	record, err := uc.repo.FindByID(id)
+	if err != nil || record == nil {
+		return nil, err
+	}
+
+	if err := uc.access.Employee(ctx, userID, record.EmployeeID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return record, nil
+}
+
+type ListEmployeePayrollRecordsUsecase struct {
+	repo   payrollrepository.PayrollRepository
+	access *PayAccess
+}
+
+func NewListEmployeePayrollRecordsUsecase(repo payrollrepository.PayrollRepository, access *PayAccess) *ListEmployeePayrollRecordsUsecase {
+	return &ListEmployeePayrollRecordsUsecase{repo: repo, access: access}
+}
+
+func (uc *ListEmployeePayrollRecordsUsecase) Execute(ctx context.Context, employeeID, userID string) ([]*domain.PayrollRecord, error) {
+	if err := uc.access.Employee(ctx, userID, employeeID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return uc.repo.ListByEmployee(employeeID)
+}
+
+type ListPeriodPayrollRecordsUsecase struct {
+	repo   payrollrepository.PayrollRepository
+	access *PayAccess
+}
+
+func NewListPeriodPayrollRecordsUsecase(repo payrollrepository.PayrollRepository, access *PayAccess) *ListPeriodPayrollRecordsUsecase {
+	return &ListPeriodPayrollRecordsUsecase{repo: repo, access: access}
+}
+
+func (uc *ListPeriodPayrollRecordsUsecase) Execute(ctx context.Context, tenantID, period, userID string) ([]*domain.PayrollRecord, error) {
+	if err := uc.access.Tenant(ctx, userID, tenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return uc.repo.ListByTenantAndPeriod(ctx, tenantID, period)
+}
+
+type ListRunRecordsUsecase struct {
+	repo    payrollrepository.PayrollRepository
+	runRepo payrollrepository.PayrollRunRepository
+	access  *PayAccess
+}
+
+func NewListRunRecordsUsecase(
+	repo payrollrepository.PayrollRepository,
+	runRepo payrollrepository.PayrollRunRepository,
+	access *PayAccess,
+) *ListRunRecordsUsecase {
+	return &ListRunRecordsUsecase{repo: repo, runRepo: runRepo, access: access}
+}
+
+func (uc *ListRunRecordsUsecase) Execute(ctx context.Context, runID, userID string) ([]*domain.PayrollRecord, error) {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.Tenant(ctx, userID, run.TenantID); err != nil {
+		return nil, denied(err, domain.ErrPayrollForbidden)
+	}
+
+	return uc.repo.ListByRunID(ctx, run.ID)
+}
@@ -0,0 +1,136 @@
+package payrollusecase
+
+import (
+	"context"
+	"time"
+
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+const payslipURLTTL = 15 * time.Minute
+
+type PayslipDownload struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type GetPayslipDownloadURLUsecase struct {
+	payslipRepo payrollrepository.PayslipRepository
+	access      *PayAccess
+	storage     storageports.StorageService
+}
+
+func NewGetPayslipDownloadURLUsecase(
+	payslipRepo payrollrepository.PayslipRepository,
+	access *PayAccess,
+	storage storageports.StorageService,
+) *GetPayslipDownloadURLUsecase {
+	return &GetPayslipDownloadURLUsecase{
+		payslipRepo: payslipRepo,
+		access:      access,
+		storage:     storage,
+	}
+}
+
+// Execute presigns a short-lived link for the employee on the payslip or
+// for HR and admins of their tenant.
+func (uc *GetPayslipDownloadURLUsecase) Execute(ctx context.Context, recordID, userID string) (*PayslipDownload, error) {
+	payslip, err := uc.payslipRepo.GetByRecordID(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.Employee(ctx, userID, payslip.EmployeeID); err != nil {
+		return nil, denied(err, domain.ErrPayslipForbidden)
+	}
+
+	if payslip.Status != domain.PayslipGenerated {
+		return nil, payrollrepository.ErrPayslipNotFound
+	}
+
+	url, err := uc.storage.PresignURL(ctx, storageports.PresignInput{
+		Path:      payslip.StoragePath,
+		ExpiresIn: payslipURLTTL,
+		Method:    "GET",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayslipDownload{
+		URL:       url,
+		ExpiresAt: time.Now().UTC().Add(payslipURLTTL),
+	}, nil
+}
+
+type ListMyPayslipsUsecase struct {
+	payslipRepo payrollrepository.PayslipRepository
+	userRepo    userrepository.UserRepository
+}
+
+func NewListMyPayslipsUsecase(
+	payslipRepo payrollrepository.PayslipRepository,
+	userRepo userrepository.UserRepository,
+) *ListMyPayslipsUsecase {
+	return &ListMyPayslipsUsecase{
+		payslipRepo: payslipRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (uc *ListMyPayslipsUsecase) Execute(ctx context.Context, userID string) ([]*domain.Payslip, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.EmployeeID == nil {
+		return []*domain.Payslip{}, nil
+	}
+
+	return uc.payslipRepo.ListByEmployeeID(ctx, *user.EmployeeID)
+}
+
+type UpdatePayslipPreferenceUsecase struct {
+	preferenceRepo payrollrepository.PayslipPreferenceRepository
+	access         *PayAccess
+}
+
+func NewUpdatePayslipPreferenceUsecase(
+	preferenceRepo payrollrepository.PayslipPreferenceRepository,
+	access *PayAccess,
+) *UpdatePayslipPreferenceUsecase {
+	return &UpdatePayslipPreferenceUsecase{
+		preferenceRepo: preferenceRepo,
+		access:         access,
+	}
+}
+
+// Execute applies to payslips generated afterwards; existing files are
+// not re-encrypted until the run is regenerated.
+func (uc *UpdatePayslipPreferenceUsecase) Execute(
+	ctx context.Context,
+	employeeID string,
+	passwordProtected bool,
+	userID string,
+) (*domain.PayslipPreference, error) {
+
+	if err := uc.access.Employee(ctx, userID, employeeID); err != nil {
+		return nil, denied(err, domain.ErrPayslipForbidden)
+	}
+
+	pref := &domain.PayslipPreference{
+		EmployeeID:        employeeID,
+		PasswordProtected: passwordProtected,
+		UpdatedAt:         time.Now().UTC(),
+	}
+
+	if err := uc.preferenceRepo.Upsert(ctx, pref); err != nil {
+		return nil, err
+	}
+
+	return pref, nil
+}
@@ -0,0 +1,41 @@
+package payrollusecase
+
+import (
+	"context"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type RecalculatePayrollRunUsecase struct {
+	runRepo  payrollrepository.PayrollRunRepository
+	queueSvc queueports.QueueService
+}
+
+func NewRecalculatePayrollRunUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	queueSvc queueports.QueueService,
+) *RecalculatePayrollRunUsecase {
+	return &RecalculatePayrollRunUsecase{
+		runRepo:  runRepo,
+		queueSvc: queueSvc,
+	}
+}
+
+func (uc *RecalculatePayrollRunUsecase) Execute(ctx context.Context, runID string) (*domain.PayrollRun, error) {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run.CanCalculate(); err != nil {
+		return nil, err
+	}
+
+	if err := enqueueRunCalculation(ctx, uc.queueSvc, run.ID); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,41 @@
+package payrollusecase
+
+import (
+	"context"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+)
+
+type RegeneratePayslipsUsecase struct {
+	runRepo  payrollrepository.PayrollRunRepository
+	queueSvc queueports.QueueService
+}
+
+func NewRegeneratePayslipsUsecase(
+	runRepo payrollrepository.PayrollRunRepository,
+	queueSvc queueports.QueueService,
+) *RegeneratePayslipsUsecase {
+	return &RegeneratePayslipsUsecase{
+		runRepo:  runRepo,
+		queueSvc: queueSvc,
+	}
+}
+
+func (uc *RegeneratePayslipsUsecase) Execute(ctx context.Context, runID string) (*domain.PayrollRun, error) {
+	run, err := uc.runRepo.GetByID(ctx, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !run.IsLocked() {
+		return nil, domain.ErrPayslipRunNotApproved
+	}
+
+	if err := enqueuePayslipGeneration(ctx, uc.queueSvc, run.ID); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
@@ -0,0 +1,147 @@
+package payrollusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dependentdomain "github.com/smart-hmm/smart-hmm/internal/modules/dependent/domain"
+	dependentrepository "github.com/smart-hmm/smart-hmm/internal/modules/dependent/repository"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrules "github.com/smart-hmm/smart-hmm/internal/modules/statutory/rules"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type statutoryInput struct {
+	record      *domain.PayrollRecord
+	profile     *statutorydomain.EmployeeProfile
+	periodStart time.Time
+	// dependents eligible for the family allowance in the period.
+	dependents int
+
+	// offCycle marks pay outside the monthly salary; regular is the
+	// employee's record in the period's regular run, if any.
+	offCycle bool
+	regular  *domain.PayrollRecord
+}
+
+// applyStatutoryLines adds the tax and contribution lines produced by the
+// country's rule pack. Countries without a registered pack fail the
+// calculation rather than pay without tax and insurance.
+func applyStatutoryLines(
+	rules *statutoryrules.Registry,
+	country string,
+	in statutoryInput,
+) error {
+	pack, err := statutoryPack(rules, country)
+	if err != nil {
+		return err
+	}
+
+	record := in.record
+	profile := in.profile
+
+	contributionSalary := func(r *domain.PayrollRecord) float64 {
+		if profile.InsuranceSalary != nil {
+			return *profile.InsuranceSalary
+		}
+		return r.BaseSalary.Float64()
+	}
+
+	input := statutorydomain.Input{
+		PeriodStart:   in.periodStart,
+		TaxableIncome: record.TaxableEarnings().Float64(),
+		WageRegion:    profile.WageRegion,
+		Dependents:    in.dependents,
+		OffCycle:      in.offCycle,
+	}
+
+	if !in.offCycle {
+		input.ContributionSalary = contributionSalary(record)
+	} else if in.regular != nil {
+		input.PriorTaxableIncome = in.regular.TaxableEarnings().Float64()
+		input.PriorContributionSalary = contributionSalary(in.regular)
+	}
+
+	lines, err := pack.Calculate(input)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lines {
+		kind := domain.LineDeduction
+		if l.Kind == statutorydomain.EmployerContribution {
+			kind = domain.LineEmployerContribution
+		}
+
+		amount, err := money.FromFloat(l.Amount, record.Currency)
+		if err != nil {
+			return fmt.Errorf("statutory line %s: %w", l.Code, err)
+		}
+
+		err = record.AddLine(domain.PayrollLine{
+			Code:   l.Code,
+			Name:   l.Name,
+			Kind:   kind,
+			Amount: amount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statutoryPack returns the rule pack of the country.
+func statutoryPack(rules *statutoryrules.Registry, country string) (statutoryrules.RulePack, error) {
+	pack, ok := rules.Lookup(country)
+	if !ok {
+		if country == "" {
+			return nil, fmt.Errorf("%w: the tenant profile has no country", domain.ErrStatutoryUnsupported)
+		}
+		return nil, fmt.Errorf("%w: %s", domain.ErrStatutoryUnsupported, country)
+	}
+	return pack, nil
+}
+
+// eligibleDependents counts the employee's dependents registered in the
+// month starting periodStart. Employees with none in the registry keep
+// the count of their statutory profile.
+func eligibleDependents(
+	ctx context.Context,
+	repo dependentrepository.DependentRepository,
+	profile *statutorydomain.EmployeeProfile,
+	periodStart time.Time,
+) (int, error) {
+	deps, err := repo.ListByEmployeeID(ctx, profile.EmployeeID)
+	if err != nil {
+		return 0, err
+	}
+	if len(deps) == 0 {
+		return profile.Dependents, nil
+	}
+	return dependentdomain.EligibleIn(deps, periodStart), nil
+}
+
+// yearDependents returns the dependents registered during the year and
+// their dependent-months, falling back to the statutory profile for the
+// whole year like eligibleDependents.
+func yearDependents(
+	ctx context.Context,
+	repo dependentrepository.DependentRepository,
+	profile *statutorydomain.EmployeeProfile,
+	year int,
+) (registered, months int, err error) {
+	deps, err := repo.ListByEmployeeID(ctx, profile.EmployeeID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(deps) == 0 {
+		return profile.Dependents, 12 * profile.Dependents, nil
+	}
+	registered, months = dependentdomain.YearCounts(deps, year)
+	return registered, months, nil
+}
@@ -0,0 +1,234 @@
+package payrollusecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	storageports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/storage"
+	"github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	payrollrepository "github.com/smart-hmm/smart-hmm/internal/modules/payroll/repository"
+	tenantrepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant/repository"
+	tenantprofilerepository "github.com/smart-hmm/smart-hmm/internal/modules/tenant_profile/repository"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type RequestTaxCertificatesUsecase struct {
+	taxYear  *BuildTaxYearUsecase
+	queueSvc queueports.QueueService
+	access   *PayAccess
+}
+
+func NewRequestTaxCertificatesUsecase(
+	taxYear *BuildTaxYearUsecase,
+	queueSvc queueports.QueueService,
+	access *PayAccess,
+) *RequestTaxCertificatesUsecase {
+	return &RequestTaxCertificatesUsecase{
+		taxYear:  taxYear,
+		queueSvc: queueSvc,
+		access:   access,
+	}
+}
+
+// Execute checks the year can be finalized and queues certificate
+// generation for every employee paid in it. Only HR and admins of the
+// tenant request it.
+func (uc *RequestTaxCertificatesUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	year int,
+	companyTaxCode string,
+	userID string,
+) (*domain.TaxYear, error) {
+
+	if err := uc.access.Tenant(ctx, userID, tenantID); err != nil {
+		return nil, denied(err, domain.ErrTaxCertificateForbidden)
+	}
+
+	taxYear, err := uc.taxYear.Execute(ctx, tenantID, year)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(worker.GenerateTaxCertificatesPayload{
+		TenantID:       tenantID,
+		Year:           year,
+		CompanyTaxCode: companyTaxCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.queueSvc.Publish(ctx, worker.GenerateTaxCertificatesTopic, queueports.Message{
+		Body: data,
+	}); err != nil {
+		return nil, err
+	}
+
+	return taxYear, nil
+}
+
+type GenerateTaxCertificatesUsecase struct {
+	taxYear         *BuildTaxYearUsecase
+	certificateRepo payrollrepository.TaxCertificateRepository
+	tenantRepo      tenantrepository.TenantRepository
+	tenantProfiles  tenantprofilerepository.TenantProfileRepository
+	renderer        domain.TaxCertificateRenderer
+	storage         storageports.StorageService
+}
+
+func NewGenerateTaxCertificatesUsecase(
+	taxYear *BuildTaxYearUsecase,
+	certificateRepo payrollrepository.TaxCertificateRepository,
+	tenantRepo tenantrepository.TenantRepository,
+	tenantProfiles tenantprofilerepository.TenantProfileRepository,
+	renderer domain.TaxCertificateRenderer,
+	storage storageports.StorageService,
+) *GenerateTaxCertificatesUsecase {
+	return &GenerateTaxCertificatesUsecase{
+		taxYear:         taxYear,
+		certificateRepo: certificateRepo,
+		tenantRepo:      tenantRepo,
+		tenantProfiles:  tenantProfiles,
+		renderer:        renderer,
+		storage:         storage,
+	}
+}
+
+// Execute renders and stores a certificate for every employee paid in
+// the year, including those who left. Existing certificates are
+// overwritten, so the job is safe to retry.
+func (uc *GenerateTaxCertificatesUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	year int,
+	companyTaxCode string,
+) error {
+
+	taxYear, err := uc.taxYear.Execute(ctx, tenantID, year)
+	if err != nil {
+		return err
+	}
+
+	_, logoURL, err := tenantBranding(ctx, uc.tenantRepo, uc.tenantProfiles, tenantID)
+	if err != nil {
+		return err
+	}
+
+	dir := path.Join("tax-certificates", tenantID, strconv.Itoa(year))
+
+	for _, e := range taxYear.Employees {
+		data, err := uc.renderer.Render(ctx, &domain.TaxCertificateDocument{
+			CompanyName:    taxYear.CompanyName,
+			CompanyTaxCode: companyTaxCode,
+			LogoURL:        logoURL,
+			Year:           year,
+			Currency:       taxYear.Currency,
+			Employee:       e,
+		})
+		if err != nil {
+			failed := domain.NewFailedTaxCertificate(tenantID, year, e.EmployeeID, err)
+			if err := uc.certificateRepo.Upsert(ctx, failed); err != nil {
+				return err
+			}
+			continue
+		}
+
+		filename := e.EmployeeID + ".pdf"
+
+		if _, err := uc.storage.Upload(ctx, storageports.UploadInput{
+			Path:        dir,
+			Filename:    filename,
+			Reader:      bytes.NewReader(data),
+			Size:        int64(len(data)),
+			ContentType: "application/pdf",
+			Private:     true,
+		}); err != nil {
+			return fmt.Errorf("upload tax certificate %s: %w", e.EmployeeID, err)
+		}
+
+		certificate := domain.NewGeneratedTaxCertificate(tenantID, year, e.EmployeeID, path.Join(dir, filename))
+		if err := uc.certificateRepo.Upsert(ctx, certificate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type ListTaxCertificatesUsecase struct {
+	certificateRepo payrollrepository.TaxCertificateRepository
+	access          *PayAccess
+}
+
+func NewListTaxCertificatesUsecase(
+	certificateRepo payrollrepository.TaxCertificateRepository,
+	access *PayAccess,
+) *ListTaxCertificatesUsecase {
+	return &ListTaxCertificatesUsecase{certificateRepo: certificateRepo, access: access}
+}
+
+// Execute lists the tenant's certificates of the year to its HR and
+// admins.
+func (uc *ListTaxCertificatesUsecase) Execute(ctx context.Context, tenantID string, year int, userID string) ([]*domain.TaxCertificate, error) {
+	if err := uc.access.Tenant(ctx, userID, tenantID); err != nil {
+		return nil, denied(err, domain.ErrTaxCertificateForbidden)
+	}
+
+	return uc.certificateRepo.ListByTenantYear(ctx, tenantID, year)
+}
+
+type GetTaxCertificateDownloadURLUsecase struct {
+	certificateRepo payrollrepository.TaxCertificateRepository
+	access          *PayAccess
+	storage         storageports.StorageService
+}
+
+func NewGetTaxCertificateDownloadURLUsecase(
+	certificateRepo payrollrepository.TaxCertificateRepository,
+	access *PayAccess,
+	storage storageports.StorageService,
+) *GetTaxCertificateDownloadURLUsecase {
+	return &GetTaxCertificateDownloadURLUsecase{
+		certificateRepo: certificateRepo,
+		access:          access,
+		storage:         storage,
+	}
+}
+
+// Execute presigns a short-lived link for the employee on the
+// certificate or for HR and admins of their tenant.
+func (uc *GetTaxCertificateDownloadURLUsecase) Execute(ctx context.Context, id, userID string) (*PayslipDownload, error) {
+	certificate, err := uc.certificateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.access.Employee(ctx, userID, certificate.EmployeeID); err != nil {
+		return nil, denied(err, domain.ErrTaxCertificateForbidden)
+	}
+
+	if certificate.Status != domain.TaxCertificateGenerated {
+		return nil, payrollrepository.ErrTaxCertificateNotFound
+	}
+
+	url, err := uc.storage.PresignURL(ctx, storageports.PresignInput{
+		Path:      certificate.StoragePath,
+		ExpiresIn: payslipURLTTL,
+		Method:    "GET",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PayslipDownload{
+		URL:       url,
+		ExpiresAt: time.Now().UTC().Add(payslipURLTTL),
+	}, nil
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type Status string
+
+const (
+	StatusOpen   Status = "OPEN"
+	StatusFilled Status = "FILLED"
+	// StatusFrozen keeps the position in the plan without hiring for it.
+	StatusFrozen Status = "FROZEN"
+)
+
+var (
+	ErrTitleRequired       = errors.New("title is required")
+	ErrDepartmentRequired  = errors.New("department is required")
+	ErrFTE                 = errors.New("FTE must be more than 0 and at most 1")
+	ErrSalaryRange         = errors.New("salary range must be non-negative, in one currency, with the minimum not above the maximum")
+	ErrNotOpen             = errors.New("only open positions can be filled or frozen")
+	ErrNotFilled           = errors.New("the position is not filled")
+	ErrNotFrozen           = errors.New("the position is not frozen")
+	ErrEmployeeNotEmployed = errors.New("only employed employees of the tenant can fill a position")
+)
+
+// Position is a budgeted seat in a department. SalaryMin and SalaryMax
+// are the monthly base salary budgeted for a full-time holder; FTE scales
+// them to the share of full time the position is budgeted for.
+type Position struct {
+	ID           string  `json:"id"`
+	TenantID     string  `json:"tenant_id"`
+	DepartmentID string  `json:"department_id"`
+	Title        string  `json:"title"`
+	Grade        *string `json:"grade,omitempty"`
+
+	SalaryMin money.Money `json:"salary_min"`
+	SalaryMax money.Money `json:"salary_max"`
+	FTE       float64     `json:"fte"`
+
+	Status Status `json:"status"`
+	// EmployeeID is the employee filling the position.
+	EmployeeID *string    `json:"employee_id,omitempty"`
+	FilledAt   *time.Time `json:"filled_at,omitempty"`
+
+	CreatedBy string    `json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Read-only, filled by listings.
+	DepartmentName *string `json:"department_name,omitempty"`
+	EmployeeCode   *string `json:"employee_code,omitempty"`
+	EmployeeName   *string `json:"employee_name,omitempty"`
+}
+
+type PositionInput struct {
+	DepartmentID string
+	Title        string
+	Grade        *string
+	SalaryMin    money.Money
+	SalaryMax    money.Money
+	FTE          float64
+}
+
+func NewPosition(tenantID string, in PositionInput, createdBy string) (*Position, error) {
+	if tenantID == "" {
+		return nil, errors.New("tenantID is required")
+	}
+
+	now := time.Now().UTC()
+	p := &Position{
+		TenantID:  tenantID,
+		Status:    StatusOpen,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+	}
+
+	if err := p.Update(in); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Update replaces the budget of the position; its status and holder stay.
+func (p *Position) Update(in PositionInput) error {
+	if in.Title == "" {
+		return ErrTitleRequired
+	}
+	if in.DepartmentID == "" {
+		return ErrDepartmentRequired
+	}
+	if in.FTE <= 0 || in.FTE > 1 {
+		return ErrFTE
+	}
+	if in.SalaryMin.IsNegative() ||
+		in.SalaryMin.Currency() != in.SalaryMax.Currency() ||
+		in.SalaryMin.Cmp(in.SalaryMax) > 0 {
+		return ErrSalaryRange
+	}
+
+	p.DepartmentID = in.DepartmentID
+	p.Title = in.Title
+	p.Grade = in.Grade
+	p.SalaryMin = in.SalaryMin
+	p.SalaryMax = in.SalaryMax
+	p.FTE = in.FTE
+	p.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// Fill links the employee to the open position.
+func (p *Position) Fill(employeeID string, now time.Time) error {
+	if p.Status != StatusOpen {
+		return ErrNotOpen
+	}
+
+	p.Status = StatusFilled
+	p.EmployeeID = &employeeID
+	p.FilledAt = &now
+	p.UpdatedAt = now
+	return nil
+}
+
+// Vacate unlinks the holder and reopens the position.
+func (p *Position) Vacate(now time.Time) error {
+	if p.Status != StatusFilled {
+		return ErrNotFilled
+	}
+
+	p.Status = StatusOpen
+	p.EmployeeID = nil
+	p.FilledAt = nil
+	p.UpdatedAt = now
+	return nil
+}
+
+func (p *Position) Freeze(now time.Time) error {
+	if p.Status != StatusOpen {
+		return ErrNotOpen
+	}
+
+	p.Status = StatusFrozen
+	p.UpdatedAt = now
+	return nil
+}
+
+func (p *Position) Unfreeze(now time.Time) error {
+	if p.Status != StatusFrozen {
+		return ErrNotFrozen
+	}
+
+	p.Status = StatusOpen
+	p.UpdatedAt = now
+	return nil
+}
+
+// Budgeted reports whether the position counts towards the budget;
+// frozen ones do not.
+func (p *Position) Budgeted() bool {
+	return p.Status != StatusFrozen
+}
+
+// BudgetedCost returns the monthly salary range scaled by FTE.
+func (p *Position) BudgetedCost() (low, high money.Money, err error) {
+	c := p.SalaryMin.Currency()
+	if low, err = money.FromFloat(p.SalaryMin.Float64()*p.FTE, c); err != nil {
+		return money.Money{}, money.Money{}, err
+	}
+	if high, err = money.FromFloat(p.SalaryMax.Float64()*p.FTE, c); err != nil {
+		return money.Money{}, money.Money{}, err
+	}
+	return low.Round(), high.Round(), nil
+}
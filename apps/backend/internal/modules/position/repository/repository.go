@@ -0,0 +1,29 @@
+package positionrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+)
+
+var (
+	ErrPositionNotFound    = errors.New("position not found")
+	ErrEmployeeHasPosition = errors.New("the employee already fills another position")
+)
+
+type PositionFilter struct {
+	DepartmentID string
+	EmployeeID   string
+	Status       domain.Status
+}
+
+type PositionRepository interface {
+	Create(ctx context.Context, p *domain.Position) error
+	Update(ctx context.Context, p *domain.Position) error
+
+	GetByID(ctx context.Context, id string) (*domain.Position, error)
+	// List returns the tenant's positions matching f, by department and
+	// title.
+	List(ctx context.Context, tenantID string, f PositionFilter) ([]*domain.Position, error)
+}
@@ -0,0 +1,61 @@
+package positionusecase
+
+import (
+	"context"
+
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+)
+
+type CreatePositionUsecase struct {
+	repo     positionrepository.PositionRepository
+	deptRepo departmentrepository.DepartmentRepository
+}
+
+func NewCreatePositionUsecase(
+	repo positionrepository.PositionRepository,
+	deptRepo departmentrepository.DepartmentRepository,
+) *CreatePositionUsecase {
+	return &CreatePositionUsecase{repo: repo, deptRepo: deptRepo}
+}
+
+func (uc *CreatePositionUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	in domain.PositionInput,
+	createdBy string,
+) (*domain.Position, error) {
+
+	p, err := domain.NewPosition(tenantID, in, createdBy)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDepartment(ctx, uc.deptRepo, tenantID, in.DepartmentID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// checkDepartment makes sure the department exists in the tenant.
+func checkDepartment(
+	ctx context.Context,
+	deptRepo departmentrepository.DepartmentRepository,
+	tenantID string,
+	departmentID string,
+) error {
+
+	d, err := deptRepo.GetByID(ctx, departmentID)
+	if err != nil {
+		return err
+	}
+	if d.TenantID == nil || *d.TenantID != tenantID {
+		return departmentrepository.ErrDepartmentNotFound
+	}
+	return nil
+}
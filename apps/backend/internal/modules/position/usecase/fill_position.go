@@ -0,0 +1,105 @@
+package positionusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+)
+
+type FillPositionUsecase struct {
+	repo         positionrepository.PositionRepository
+	employeeRepo employeerepository.EmployeeRepository
+}
+
+func NewFillPositionUsecase(
+	repo positionrepository.PositionRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+) *FillPositionUsecase {
+	return &FillPositionUsecase{repo: repo, employeeRepo: employeeRepo}
+}
+
+// Execute links the employee to the open position. An employee fills one
+// position at a time; vacate the old one first.
+func (uc *FillPositionUsecase) Execute(ctx context.Context, id, employeeID string) (*domain.Position, error) {
+	p, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	emp, err := uc.employeeRepo.FindByID(employeeID)
+	if err != nil {
+		return nil, fmt.Errorf("load employee %s: %w", employeeID, err)
+	}
+	if emp.TenantID != p.TenantID || !emp.EmploymentStatus.Employed() {
+		return nil, domain.ErrEmployeeNotEmployed
+	}
+
+	if err := p.Fill(emp.ID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, id)
+}
+
+type VacatePositionUsecase struct {
+	repo positionrepository.PositionRepository
+}
+
+func NewVacatePositionUsecase(repo positionrepository.PositionRepository) *VacatePositionUsecase {
+	return &VacatePositionUsecase{repo: repo}
+}
+
+// Execute unlinks the holder and reopens the position.
+func (uc *VacatePositionUsecase) Execute(ctx context.Context, id string) (*domain.Position, error) {
+	p, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Vacate(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, id)
+}
+
+type FreezePositionUsecase struct {
+	repo positionrepository.PositionRepository
+}
+
+func NewFreezePositionUsecase(repo positionrepository.PositionRepository) *FreezePositionUsecase {
+	return &FreezePositionUsecase{repo: repo}
+}
+
+// Execute freezes the open position, or reopens it when frozen is false.
+func (uc *FreezePositionUsecase) Execute(ctx context.Context, id string, frozen bool) (*domain.Position, error) {
+	p, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if frozen {
+		err = p.Freeze(now)
+	} else {
+		err = p.Unfreeze(now)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,173 @@
+package positionusecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	departmentdomain "github.com/smart-hmm/smart-hmm/internal/modules/department/domain"
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+)
+
+type GetHeadcountReportUsecase struct {
+	repo         positionrepository.PositionRepository
+	employeeRepo employeerepository.EmployeeRepository
+	deptRepo     departmentrepository.DepartmentRepository
+}
+
+func NewGetHeadcountReportUsecase(
+	repo positionrepository.PositionRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	deptRepo departmentrepository.DepartmentRepository,
+) *GetHeadcountReportUsecase {
+	return &GetHeadcountReportUsecase{repo: repo, employeeRepo: employeeRepo, deptRepo: deptRepo}
+}
+
+// DepartmentHeadcount compares a department's budget with the employees
+// working in it. Costs are monthly base salaries; budgeted ones span the
+// positions' salary ranges scaled by FTE.
+type DepartmentHeadcount struct {
+	DepartmentID   *string `json:"department_id"`
+	DepartmentName *string `json:"department_name"`
+	CostCenter     *string `json:"cost_center,omitempty"`
+
+	// BudgetedFTE sums the open and filled positions; frozen ones are
+	// counted apart.
+	BudgetedFTE     float64 `json:"budgeted_fte"`
+	FilledFTE       float64 `json:"filled_fte"`
+	OpenPositions   int     `json:"open_positions"`
+	FrozenPositions int     `json:"frozen_positions"`
+	// HeldByLeavers counts filled positions whose holder is no longer
+	// employed; vacate them to hire again.
+	HeldByLeavers int `json:"held_by_leavers"`
+
+	ActualHeadcount int `json:"actual_headcount"`
+	// Unbudgeted counts the employees working in the department without
+	// filling one of its positions.
+	Unbudgeted int `json:"unbudgeted"`
+
+	BudgetedCostMin departmentdomain.Costs `json:"budgeted_cost_min"`
+	BudgetedCostMax departmentdomain.Costs `json:"budgeted_cost_max"`
+	ActualCost      departmentdomain.Costs `json:"actual_cost"`
+}
+
+type HeadcountReport struct {
+	AsOf        time.Time              `json:"as_of"`
+	Departments []*DepartmentHeadcount `json:"departments"`
+}
+
+// Execute reports every department of the tenant, then any other
+// department a position or employee is in, then the employees without
+// one, from today's jobs and salaries.
+func (uc *GetHeadcountReportUsecase) Execute(ctx context.Context, tenantID string, today time.Time) (*HeadcountReport, error) {
+	y, m, d := today.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	departments, err := uc.deptRepo.ListOn(ctx, tenantID, day)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := uc.repo.List(ctx, tenantID, positionrepository.PositionFilter{})
+	if err != nil {
+		return nil, err
+	}
+	employees, err := uc.employeeRepo.ListActiveByTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &HeadcountReport{AsOf: day, Departments: []*DepartmentHeadcount{}}
+	rows := map[string]*DepartmentHeadcount{}
+	var order []*DepartmentHeadcount
+	row := func(id *string, name *string) *DepartmentHeadcount {
+		key := ""
+		if id != nil {
+			key = *id
+		}
+		if r, ok := rows[key]; ok {
+			return r
+		}
+		r := &DepartmentHeadcount{
+			DepartmentID:    id,
+			DepartmentName:  name,
+			BudgetedCostMin: departmentdomain.Costs{},
+			BudgetedCostMax: departmentdomain.Costs{},
+			ActualCost:      departmentdomain.Costs{},
+		}
+		rows[key] = r
+		order = append(order, r)
+		return r
+	}
+
+	for _, dept := range departments {
+		row(&dept.ID, &dept.Name).CostCenter = dept.CostCenter
+	}
+
+	employed := make(map[string]bool, len(employees))
+	for _, e := range employees {
+		employed[e.ID] = true
+	}
+
+	holders := map[string]bool{}
+	for _, p := range positions {
+		r := row(&p.DepartmentID, p.DepartmentName)
+
+		switch p.Status {
+		case domain.StatusFrozen:
+			r.FrozenPositions++
+			continue
+		case domain.StatusOpen:
+			r.OpenPositions++
+		case domain.StatusFilled:
+			r.FilledFTE += p.FTE
+			if p.EmployeeID != nil {
+				holders[*p.EmployeeID] = true
+				if !employed[*p.EmployeeID] {
+					r.HeldByLeavers++
+				}
+			}
+		}
+
+		r.BudgetedFTE += p.FTE
+		low, high, err := p.BudgetedCost()
+		if err != nil {
+			return nil, fmt.Errorf("position %s budgeted cost: %w", p.ID, err)
+		}
+		r.BudgetedCostMin.Add(low)
+		r.BudgetedCostMax.Add(high)
+	}
+
+	for _, e := range employees {
+		r := row(e.DepartmentID, e.DepartmentName)
+		r.ActualHeadcount++
+		r.ActualCost.Add(e.BaseSalary)
+		if !holders[e.ID] {
+			r.Unbudgeted++
+		}
+	}
+
+	// Employees without a department come last.
+	for _, r := range order {
+		if r.DepartmentID != nil {
+			report.Departments = append(report.Departments, r)
+		}
+	}
+	if r, ok := rows[""]; ok {
+		report.Departments = append(report.Departments, r)
+	}
+
+	for _, r := range report.Departments {
+		r.BudgetedFTE = roundFTE(r.BudgetedFTE)
+		r.FilledFTE = roundFTE(r.FilledFTE)
+	}
+
+	return report, nil
+}
+
+func roundFTE(v float64) float64 {
+	return math.Round(v*100) / 100
+}
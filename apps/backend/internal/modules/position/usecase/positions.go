@@ -0,0 +1,44 @@
+package positionusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+)
+
+type GetPositionUsecase struct {
+	repo positionrepository.PositionRepository
+}
+
+func NewGetPositionUsecase(repo positionrepository.PositionRepository) *GetPositionUsecase {
+	return &GetPositionUsecase{repo: repo}
+}
+
+func (uc *GetPositionUsecase) Execute(ctx context.Context, id string) (*domain.Position, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+type ListPositionsUsecase struct {
+	repo positionrepository.PositionRepository
+}
+
+func NewListPositionsUsecase(repo positionrepository.PositionRepository) *ListPositionsUsecase {
+	return &ListPositionsUsecase{repo: repo}
+}
+
+func (uc *ListPositionsUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	f positionrepository.PositionFilter,
+) ([]*domain.Position, error) {
+
+	positions, err := uc.repo.List(ctx, tenantID, f)
+	if err != nil {
+		return nil, err
+	}
+	if positions == nil {
+		positions = []*domain.Position{}
+	}
+	return positions, nil
+}
@@ -0,0 +1,41 @@
+package positionusecase
+
+import (
+	"context"
+
+	departmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/department/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/position/domain"
+	positionrepository "github.com/smart-hmm/smart-hmm/internal/modules/position/repository"
+)
+
+type UpdatePositionUsecase struct {
+	repo     positionrepository.PositionRepository
+	deptRepo departmentrepository.DepartmentRepository
+}
+
+func NewUpdatePositionUsecase(
+	repo positionrepository.PositionRepository,
+	deptRepo departmentrepository.DepartmentRepository,
+) *UpdatePositionUsecase {
+	return &UpdatePositionUsecase{repo: repo, deptRepo: deptRepo}
+}
+
+func (uc *UpdatePositionUsecase) Execute(ctx context.Context, id string, in domain.PositionInput) (*domain.Position, error) {
+	p, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Update(in); err != nil {
+		return nil, err
+	}
+	if err := checkDepartment(ctx, uc.deptRepo, p.TenantID, in.DepartmentID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	contractdomain "github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "PENDING"
+	StatusDecided Status = "DECIDED"
+)
+
+// Decision is how a probation ends.
+type Decision string
+
+const (
+	DecisionPass      Decision = "PASS"
+	DecisionExtend    Decision = "EXTEND"
+	DecisionTerminate Decision = "TERMINATE"
+)
+
+const (
+	// LeadDays is how long before the end of probation the evaluation is
+	// issued to the manager.
+	LeadDays = 7
+
+	MinRating = 1
+	MaxRating = 5
+)
+
+var (
+	ErrInvalidDecision   = errors.New("decision must be PASS, EXTEND or TERMINATE")
+	ErrRating            = errors.New("ratings must be between 1 and 5")
+	ErrExtendedUntil     = errors.New("an extended probation must end after the current one and within the longest probation allowed from its start")
+	ErrAlreadyDecided    = errors.New("the evaluation has already been decided")
+	ErrAlreadyExtended   = errors.New("a probation can be extended only once")
+	ErrNotEvaluator      = errors.New("the evaluation is issued to someone else")
+	ErrContractRequired  = errors.New("passing a probation contract needs the contract that follows it")
+	ErrContractForbidden = errors.New("only a probation contract is followed by a new contract")
+)
+
+// Evaluation is the manager's assessment of an employee at the end of
+// their probation. It is issued LeadDays before ProbationEndDate and
+// decided once.
+type Evaluation struct {
+	ID         string `json:"id"`
+	TenantID   string `json:"tenant_id"`
+	EmployeeID string `json:"employee_id"`
+	// EvaluatorID is the employee's manager when the evaluation was
+	// issued; without one HR evaluates.
+	EvaluatorID *string `json:"evaluator_id,omitempty"`
+
+	ProbationEndDate time.Time `json:"probation_end_date"` // date, UTC midnight
+	Status           Status    `json:"status"`
+
+	PerformanceRating *int       `json:"performance_rating,omitempty"`
+	ConductRating     *int       `json:"conduct_rating,omitempty"`
+	Comments          *string    `json:"comments,omitempty"`
+	Decision          *Decision  `json:"decision,omitempty"`
+	ExtendedUntil     *time.Time `json:"extended_until,omitempty"`
+	DecidedBy         *string    `json:"decided_by,omitempty"`
+	DecidedAt         *time.Time `json:"decided_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Read-only, filled by queries.
+	EmployeeCode  string `json:"employee_code,omitempty"`
+	EmployeeName  string `json:"employee_name,omitempty"`
+	EmployeeEmail string `json:"-"`
+	// JoinDate is the employee's, from which the probation is measured.
+	JoinDate       time.Time `json:"-"`
+	EvaluatorName  *string   `json:"evaluator_name,omitempty"`
+	EvaluatorEmail *string   `json:"-"`
+}
+
+type DecisionInput struct {
+	PerformanceRating int
+	ConductRating     int
+	Comments          *string
+	Decision          Decision
+	// ExtendedUntil is the new last day of probation of an EXTEND.
+	ExtendedUntil *time.Time
+}
+
+// ExtensionLimit is the latest day an extended probation may end. A
+// probation agreed in contract runs at most contractdomain.MaxProbationDays
+// from the contract start; any other at most employeedomain.MaxProbationDays
+// from the join date.
+func (e *Evaluation) ExtensionLimit(contract *contractdomain.Contract) time.Time {
+	if contract != nil {
+		return contract.StartDate.AddDate(0, 0, contractdomain.MaxProbationDays)
+	}
+
+	y, m, d := e.JoinDate.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, 0, employeedomain.MaxProbationDays)
+}
+
+// Decide records the evaluation form and its decision. An extension
+// must end by limit, see ExtensionLimit.
+func (e *Evaluation) Decide(in DecisionInput, limit time.Time, userID string) error {
+	if e.Status == StatusDecided {
+		return ErrAlreadyDecided
+	}
+
+	for _, rating := range []int{in.PerformanceRating, in.ConductRating} {
+		if rating < MinRating || rating > MaxRating {
+			return ErrRating
+		}
+	}
+
+	var extendedUntil *time.Time
+	switch in.Decision {
+	case DecisionPass, DecisionTerminate:
+	case DecisionExtend:
+		if in.ExtendedUntil == nil {
+			return ErrExtendedUntil
+		}
+		y, m, d := in.ExtendedUntil.Date()
+		until := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		if !until.After(e.ProbationEndDate) || until.After(limit) {
+			return ErrExtendedUntil
+		}
+		extendedUntil = &until
+	default:
+		return ErrInvalidDecision
+	}
+
+	now := time.Now().UTC()
+	performance, conduct, decision := in.PerformanceRating, in.ConductRating, in.Decision
+
+	e.Status = StatusDecided
+	e.PerformanceRating = &performance
+	e.ConductRating = &conduct
+	e.Comments = in.Comments
+	e.Decision = &decision
+	e.ExtendedUntil = extendedUntil
+	e.DecidedBy = &userID
+	e.DecidedAt = &now
+	e.UpdatedAt = now
+	return nil
+}
+
+// Overdue reports whether the evaluation is still pending after the
+// probation ended.
+func (e *Evaluation) Overdue(today time.Time) bool {
+	y, m, d := today.Date()
+	return e.Status == StatusPending && e.ProbationEndDate.Before(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+}
+
+// LastWorkingDay is the last day of an employee who does not pass: the
+// end of probation, or the decision day when it comes later.
+func (e *Evaluation) LastWorkingDay(today time.Time) time.Time {
+	y, m, d := today.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	if day.After(e.ProbationEndDate) {
+		return day
+	}
+	return e.ProbationEndDate
+}
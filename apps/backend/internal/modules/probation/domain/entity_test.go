@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	contractdomain "github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestEvaluationExtensionLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		join     time.Time
+		contract *contractdomain.Contract
+		want     string
+	}{
+		{name: "from the join date", join: date("2025-01-01"), want: "2025-03-02"},
+		{name: "join time ignored", join: time.Date(2025, 1, 1, 18, 0, 0, 0, time.FixedZone("ICT", 7*3600)), want: "2025-03-02"},
+		{name: "from the contract start", join: date("2025-01-01"), contract: &contractdomain.Contract{StartDate: date("2025-01-06")}, want: "2025-07-05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Evaluation{JoinDate: tt.join}
+			if got := e.ExtensionLimit(tt.contract).Format(time.DateOnly); got != tt.want {
+				t.Fatalf("ExtensionLimit() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluationDecide(t *testing.T) {
+	until := func(s string) *time.Time {
+		d := date(s)
+		return &d
+	}
+
+	// Joined 2025-01-01 with a 30-day probation; the limit is 60 days
+	// from joining, not from the end of probation.
+	limit := date("2025-03-02")
+
+	tests := []struct {
+		name    string
+		status  Status
+		in      DecisionInput
+		wantErr error
+	}{
+		{name: "pass", in: DecisionInput{PerformanceRating: 4, ConductRating: 5, Decision: DecisionPass}},
+		{name: "terminate", in: DecisionInput{PerformanceRating: 1, ConductRating: 2, Decision: DecisionTerminate}},
+		{name: "extend to the limit", in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: DecisionExtend, ExtendedUntil: until("2025-03-02")}},
+		{name: "extend past the limit", in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: DecisionExtend, ExtendedUntil: until("2025-03-03")}, wantErr: ErrExtendedUntil},
+		{name: "extend to the current end", in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: DecisionExtend, ExtendedUntil: until("2025-01-31")}, wantErr: ErrExtendedUntil},
+		{name: "extend without a date", in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: DecisionExtend}, wantErr: ErrExtendedUntil},
+		{name: "rating out of range", in: DecisionInput{PerformanceRating: 6, ConductRating: 3, Decision: DecisionPass}, wantErr: ErrRating},
+		{name: "unknown decision", in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: "HOLD"}, wantErr: ErrInvalidDecision},
+		{name: "already decided", status: StatusDecided, in: DecisionInput{PerformanceRating: 3, ConductRating: 3, Decision: DecisionPass}, wantErr: ErrAlreadyDecided},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == "" {
+				status = StatusPending
+			}
+			e := &Evaluation{
+				Status:           status,
+				JoinDate:         date("2025-01-01"),
+				ProbationEndDate: date("2025-01-31"),
+			}
+
+			err := e.Decide(tt.in, limit, "u1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decide() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if e.Status != status {
+					t.Fatalf("status changed to %s on error", e.Status)
+				}
+				return
+			}
+			if e.Status != StatusDecided || e.Decision == nil || *e.Decision != tt.in.Decision || e.DecidedBy == nil {
+				t.Fatalf("evaluation not decided: %+v", e)
+			}
+			if (tt.in.Decision == DecisionExtend) != (e.ExtendedUntil != nil) {
+				t.Fatalf("extended until = %v for %s", e.ExtendedUntil, tt.in.Decision)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package probationrepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+)
+
+var ErrEvaluationNotFound = errors.New("probation evaluation not found")
+
+// EvaluationFilter narrows a listing; empty fields match everything.
+type EvaluationFilter struct {
+	EmployeeID  string
+	EvaluatorID string
+	Status      domain.Status
+}
+
+type EvaluationRepository interface {
+	Update(ctx context.Context, e *domain.Evaluation) error
+
+	GetByID(ctx context.Context, id string) (*domain.Evaluation, error)
+	// List returns the tenant's evaluations matching f, soonest probation
+	// end first.
+	List(ctx context.Context, tenantID string, f EvaluationFilter) ([]*domain.Evaluation, error)
+
+	// IssueDue creates a pending evaluation for every employee still on
+	// probation whose probation ends on or before until and has none for
+	// that date yet, and returns the evaluations created.
+	IssueDue(ctx context.Context, until time.Time) ([]*domain.Evaluation, error)
+	// SetProbationEndDate moves the last day of the employee's probation.
+	SetProbationEndDate(ctx context.Context, employeeID string, end time.Time) error
+}
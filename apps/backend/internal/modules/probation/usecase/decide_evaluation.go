@@ -0,0 +1,336 @@
+package probationusecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	contractdomain "github.com/smart-hmm/smart-hmm/internal/modules/contract/domain"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employmentdomain "github.com/smart-hmm/smart-hmm/internal/modules/employment/domain"
+	employmentrepository "github.com/smart-hmm/smart-hmm/internal/modules/employment/repository"
+	offboardingdomain "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/domain"
+	offboardingusecase "github.com/smart-hmm/smart-hmm/internal/modules/offboarding/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type DecideEvaluationUsecase struct {
+	repo             probationrepository.EvaluationRepository
+	jobRepo          employmentrepository.JobRecordRepository
+	contractRepo     contractrepository.ContractRepository
+	userRepo         userrepository.UserRepository
+	startOffboarding *offboardingusecase.StartOffboardingUsecase
+	txManager        txpkg.Manager
+	queueSvc         queueports.QueueService
+}
+
+func NewDecideEvaluationUsecase(
+	repo probationrepository.EvaluationRepository,
+	jobRepo employmentrepository.JobRecordRepository,
+	contractRepo contractrepository.ContractRepository,
+	userRepo userrepository.UserRepository,
+	startOffboarding *offboardingusecase.StartOffboardingUsecase,
+	txManager txpkg.Manager,
+	queueSvc queueports.QueueService,
+) *DecideEvaluationUsecase {
+	return &DecideEvaluationUsecase{
+		repo:             repo,
+		jobRepo:          jobRepo,
+		contractRepo:     contractRepo,
+		userRepo:         userRepo,
+		startOffboarding: startOffboarding,
+		txManager:        txManager,
+		queueSvc:         queueSvc,
+	}
+}
+
+type DecideEvaluationInput struct {
+	domain.DecisionInput
+	// NextContract is the contract that follows a probation contract when
+	// the employee passes. Probations agreed inside a longer contract
+	// need none.
+	NextContract *contractdomain.ContractInput
+}
+
+// Execute records the evaluation and carries out its decision:
+//   - PASS makes the employee ACTIVE from the day after probation and
+//     signs the contract that follows a probation contract.
+//   - EXTEND moves the end of probation on the employee and the contract;
+//     a new evaluation is issued ahead of the new date.
+//   - TERMINATE offboards the employee, their last working day being the
+//     end of probation, and ends the contract then.
+//
+// The employee is e-mailed the outcome. Admins and HR decide any
+// evaluation; managers only those issued to them.
+func (uc *DecideEvaluationUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in DecideEvaluationInput,
+	userID string,
+) (*domain.Evaluation, error) {
+
+	e, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+
+	switch user.Role {
+	case userdomain.Admin, userdomain.HR:
+	default:
+		if e.EvaluatorID == nil || user.EmployeeID == nil || *e.EvaluatorID != *user.EmployeeID {
+			return nil, domain.ErrNotEvaluator
+		}
+	}
+
+	contract, err := uc.probationContract(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.Decide(in.DecisionInput, e.ExtensionLimit(contract), userID); err != nil {
+		return nil, err
+	}
+
+	if in.NextContract != nil && *e.Decision != domain.DecisionPass {
+		return nil, domain.ErrContractForbidden
+	}
+
+	today := time.Now().UTC()
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		switch *e.Decision {
+		case domain.DecisionPass:
+			if err := uc.pass(txCtx, e, contract, in.NextContract, userID); err != nil {
+				return err
+			}
+		case domain.DecisionExtend:
+			if err := uc.extend(txCtx, e, contract); err != nil {
+				return err
+			}
+		case domain.DecisionTerminate:
+			if err := uc.terminate(txCtx, e, contract, today, userID); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.repo.Update(txCtx, e); err != nil {
+			return err
+		}
+
+		return uc.notify(txCtx, e, today)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// probationContract returns the employee's contract whose probation ends
+// with the evaluated one, or nil.
+func (uc *DecideEvaluationUsecase) probationContract(ctx context.Context, e *domain.Evaluation) (*contractdomain.Contract, error) {
+	contracts, err := uc.contractRepo.ListByEmployeeID(ctx, e.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range contracts {
+		if c.ProbationEndDate != nil && c.ProbationEndDate.Equal(e.ProbationEndDate) {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (uc *DecideEvaluationUsecase) pass(
+	ctx context.Context,
+	e *domain.Evaluation,
+	contract *contractdomain.Contract,
+	next *contractdomain.ContractInput,
+	userID string,
+) error {
+
+	if err := uc.activate(ctx, e, userID); err != nil {
+		return err
+	}
+
+	if contract == nil || contract.Type != contractdomain.TypeProbation {
+		if next != nil {
+			return domain.ErrContractForbidden
+		}
+		return nil
+	}
+	if next == nil {
+		return domain.ErrContractRequired
+	}
+
+	renewal, err := contract.Renew(*next)
+	if err != nil {
+		return err
+	}
+	return uc.contractRepo.Create(ctx, renewal)
+}
+
+// activate makes the employee ACTIVE from the day after probation,
+// keeping the rest of the job they hold then.
+func (uc *DecideEvaluationUsecase) activate(ctx context.Context, e *domain.Evaluation, userID string) error {
+	history, err := uc.jobRepo.ListByEmployeeID(ctx, e.EmployeeID)
+	if err != nil {
+		return err
+	}
+
+	current, ok := history.On(e.ProbationEndDate)
+	if !ok || current.EmploymentStatus != employeedomain.Probation {
+		return nil
+	}
+
+	note := "Passed probation"
+	record, err := employmentdomain.NewJobRecord(employmentdomain.NewJobRecordInput{
+		EmployeeID:       e.EmployeeID,
+		EffectiveFrom:    e.ProbationEndDate.AddDate(0, 0, 1),
+		Position:         current.Position,
+		DepartmentID:     current.DepartmentID,
+		ManagerID:        current.ManagerID,
+		EmploymentType:   current.EmploymentType,
+		EmploymentStatus: employeedomain.Active,
+		Reason:           employmentdomain.ReasonStatusChange,
+		Note:             &note,
+		ChangedBy:        userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return uc.jobRepo.Create(ctx, record)
+}
+
+func (uc *DecideEvaluationUsecase) extend(
+	ctx context.Context,
+	e *domain.Evaluation,
+	contract *contractdomain.Contract,
+) error {
+
+	earlier, err := uc.repo.List(ctx, e.TenantID, probationrepository.EvaluationFilter{EmployeeID: e.EmployeeID})
+	if err != nil {
+		return err
+	}
+	for _, other := range earlier {
+		if other.ID != e.ID && other.Decision != nil && *other.Decision == domain.DecisionExtend {
+			return domain.ErrAlreadyExtended
+		}
+	}
+
+	until := *e.ExtendedUntil
+
+	if contract != nil {
+		in := contractInput(contract)
+		in.ProbationEndDate = &until
+		if contract.Type == contractdomain.TypeProbation {
+			in.EndDate = &until
+		}
+		if err := contract.Update(in); err != nil {
+			return err
+		}
+		if err := uc.contractRepo.Update(ctx, contract); err != nil {
+			return err
+		}
+	}
+
+	return uc.repo.SetProbationEndDate(ctx, e.EmployeeID, until)
+}
+
+func (uc *DecideEvaluationUsecase) terminate(
+	ctx context.Context,
+	e *domain.Evaluation,
+	contract *contractdomain.Contract,
+	today time.Time,
+	userID string,
+) error {
+
+	lastDay := e.LastWorkingDay(today)
+	note := "Did not pass probation"
+
+	// Joins the surrounding transaction.
+	_, err := uc.startOffboarding.Execute(ctx, e.TenantID, e.EmployeeID, offboardingdomain.OffboardingInput{
+		LastWorkingDay: lastDay,
+		Reason:         offboardingdomain.ReasonDismissal,
+		Note:           &note,
+	}, userID)
+	if err != nil {
+		return err
+	}
+
+	// Indefinite contracts have no end date; the offboarding ends them.
+	if contract == nil || contract.EndDate == nil || !contract.EndDate.After(lastDay) {
+		return nil
+	}
+
+	in := contractInput(contract)
+	in.EndDate = &lastDay
+	if err := contract.Update(in); err != nil {
+		return err
+	}
+	return uc.contractRepo.Update(ctx, contract)
+}
+
+func contractInput(c *contractdomain.Contract) contractdomain.ContractInput {
+	return contractdomain.ContractInput{
+		Type:             c.Type,
+		Number:           c.Number,
+		StartDate:        c.StartDate,
+		EndDate:          c.EndDate,
+		ProbationEndDate: c.ProbationEndDate,
+		SignedFileID:     c.SignedFileID,
+		Note:             c.Note,
+	}
+}
+
+func (uc *DecideEvaluationUsecase) notify(ctx context.Context, e *domain.Evaluation, today time.Time) error {
+	var body string
+	switch *e.Decision {
+	case domain.DecisionPass:
+		body = fmt.Sprintf(
+			"Congratulations, you have passed your probation. Your employment continues from %s.",
+			e.ProbationEndDate.AddDate(0, 0, 1).Format(time.DateOnly),
+		)
+	case domain.DecisionExtend:
+		body = fmt.Sprintf(
+			"Your probation has been extended until %s. Your manager will evaluate it again before then.",
+			e.ExtendedUntil.Format(time.DateOnly),
+		)
+	case domain.DecisionTerminate:
+		body = fmt.Sprintf(
+			"Your probation has not been passed. Your last working day is %s; HR will contact you about the final settlement.",
+			e.LastWorkingDay(today).Format(time.DateOnly),
+		)
+	}
+
+	data, err := json.Marshal(worker.SendEmailPayload{
+		To:      e.EmployeeEmail,
+		Subject: "Your probation evaluation",
+		Body:    fmt.Sprintf("Dear %s,\n\n%s\n", e.EmployeeName, body),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := uc.queueSvc.Publish(ctx, worker.SendEmailTopic, queueports.Message{Body: data}); err != nil {
+		return fmt.Errorf("publish probation decision: %w", err)
+	}
+
+	return nil
+}
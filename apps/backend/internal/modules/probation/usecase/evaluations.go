@@ -0,0 +1,113 @@
+package probationusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+type GetEvaluationUsecase struct {
+	repo probationrepository.EvaluationRepository
+}
+
+func NewGetEvaluationUsecase(repo probationrepository.EvaluationRepository) *GetEvaluationUsecase {
+	return &GetEvaluationUsecase{repo: repo}
+}
+
+func (uc *GetEvaluationUsecase) Execute(ctx context.Context, id string) (*domain.Evaluation, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+type ListEvaluationsUsecase struct {
+	repo probationrepository.EvaluationRepository
+}
+
+func NewListEvaluationsUsecase(repo probationrepository.EvaluationRepository) *ListEvaluationsUsecase {
+	return &ListEvaluationsUsecase{repo: repo}
+}
+
+func (uc *ListEvaluationsUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	f probationrepository.EvaluationFilter,
+) ([]*domain.Evaluation, error) {
+	return uc.repo.List(ctx, tenantID, f)
+}
+
+type ListMyEvaluationsUsecase struct {
+	repo     probationrepository.EvaluationRepository
+	userRepo userrepository.UserRepository
+}
+
+func NewListMyEvaluationsUsecase(
+	repo probationrepository.EvaluationRepository,
+	userRepo userrepository.UserRepository,
+) *ListMyEvaluationsUsecase {
+	return &ListMyEvaluationsUsecase{repo: repo, userRepo: userRepo}
+}
+
+// Execute lists the pending evaluations issued to the user's employee as
+// manager.
+func (uc *ListMyEvaluationsUsecase) Execute(ctx context.Context, tenantID, userID string) ([]*domain.Evaluation, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.EmployeeID == nil {
+		return []*domain.Evaluation{}, nil
+	}
+
+	return uc.repo.List(ctx, tenantID, probationrepository.EvaluationFilter{
+		EvaluatorID: *user.EmployeeID,
+		Status:      domain.StatusPending,
+	})
+}
+
+// Dashboard is the tenant's outstanding probation evaluations.
+type Dashboard struct {
+	Outstanding int `json:"outstanding"`
+	// Overdue counts the outstanding evaluations whose probation already
+	// ended.
+	Overdue int `json:"overdue"`
+	// Unassigned counts those without a manager to evaluate; HR decides
+	// them.
+	Unassigned  int                  `json:"unassigned"`
+	Evaluations []*domain.Evaluation `json:"evaluations"`
+}
+
+type GetDashboardUsecase struct {
+	repo probationrepository.EvaluationRepository
+}
+
+func NewGetDashboardUsecase(repo probationrepository.EvaluationRepository) *GetDashboardUsecase {
+	return &GetDashboardUsecase{repo: repo}
+}
+
+func (uc *GetDashboardUsecase) Execute(ctx context.Context, tenantID string, today time.Time) (*Dashboard, error) {
+	pending, err := uc.repo.List(ctx, tenantID, probationrepository.EvaluationFilter{Status: domain.StatusPending})
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil {
+		pending = []*domain.Evaluation{}
+	}
+
+	d := &Dashboard{
+		Outstanding: len(pending),
+		Evaluations: pending,
+	}
+	for _, e := range pending {
+		if e.Overdue(today) {
+			d.Overdue++
+		}
+		if e.EvaluatorID == nil {
+			d.Unassigned++
+		}
+	}
+
+	return d, nil
+}
@@ -0,0 +1,107 @@
+package probationusecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	contractrepository "github.com/smart-hmm/smart-hmm/internal/modules/contract/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/probation/domain"
+	probationrepository "github.com/smart-hmm/smart-hmm/internal/modules/probation/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type IssueEvaluationsUsecase struct {
+	repo         probationrepository.EvaluationRepository
+	contractRepo contractrepository.ContractRepository
+	txManager    txpkg.Manager
+	queueSvc     queueports.QueueService
+}
+
+func NewIssueEvaluationsUsecase(
+	repo probationrepository.EvaluationRepository,
+	contractRepo contractrepository.ContractRepository,
+	txManager txpkg.Manager,
+	queueSvc queueports.QueueService,
+) *IssueEvaluationsUsecase {
+	return &IssueEvaluationsUsecase{
+		repo:         repo,
+		contractRepo: contractRepo,
+		txManager:    txManager,
+		queueSvc:     queueSvc,
+	}
+}
+
+// Execute issues the evaluation of every probation ending within
+// domain.LeadDays of today and e-mails it to the employee's manager, or
+// to HR when there is none. A failed publish rolls the issue back to the
+// next run.
+func (uc *IssueEvaluationsUsecase) Execute(ctx context.Context, today time.Time) error {
+	y, m, d := today.Date()
+	until := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).AddDate(0, 0, domain.LeadDays)
+
+	return uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		issued, err := uc.repo.IssueDue(txCtx, until)
+		if err != nil {
+			return err
+		}
+
+		hr := map[string][]string{}
+		for _, e := range issued {
+			var recipients []string
+			if e.EvaluatorEmail != nil {
+				recipients = []string{*e.EvaluatorEmail}
+			} else {
+				if _, ok := hr[e.TenantID]; !ok {
+					if hr[e.TenantID], err = uc.contractRepo.ListReminderRecipients(txCtx, e.TenantID); err != nil {
+						return err
+					}
+				}
+				recipients = hr[e.TenantID]
+			}
+			if len(recipients) == 0 {
+				log.Println("no probation evaluator for employee:", e.EmployeeID)
+				continue
+			}
+
+			subject, body := evaluationRequest(e)
+			for _, recipient := range recipients {
+				data, err := json.Marshal(worker.SendEmailPayload{
+					To:      recipient,
+					Subject: subject,
+					Body:    body,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := uc.queueSvc.Publish(txCtx, worker.SendEmailTopic, queueports.Message{
+					Body: data,
+				}); err != nil {
+					return fmt.Errorf("publish probation evaluation: %w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func evaluationRequest(e *domain.Evaluation) (string, string) {
+	subject := fmt.Sprintf("Probation evaluation for %s due by %s", e.EmployeeName, e.ProbationEndDate.Format(time.DateOnly))
+
+	body := fmt.Sprintf(
+		"The probation of %s %s ends on %s.\n\n"+
+			"Please rate their performance and conduct and decide whether they pass, "+
+			"have their probation extended or leave at its end. "+
+			"The decision updates their employment and contract and is e-mailed to them.\n\n"+
+			"Evaluation: %s\n",
+		e.EmployeeCode, e.EmployeeName, e.ProbationEndDate.Format(time.DateOnly), e.ID,
+	)
+
+	return subject, body
+}
@@ -0,0 +1,329 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	bankdomain "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/domain"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	statutorydomain "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+// Field is a part of the employee record employees may change themselves.
+// Tax code and bank account wait for HR approval; the rest apply at once.
+type Field string
+
+const (
+	FieldPhone       Field = "phone"
+	FieldAddress     Field = "address"
+	FieldTaxCode     Field = "tax_code"
+	FieldBankAccount Field = "bank_account"
+)
+
+type Status string
+
+const (
+	StatusPending Status = "PENDING"
+	// StatusApplied requests are on the employee record: contact details
+	// at once, sensitive fields once approved.
+	StatusApplied   Status = "APPLIED"
+	StatusRejected  Status = "REJECTED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+const MaxAddressLength = 500
+
+var (
+	ErrNoChanges          = errors.New("the request does not change anything")
+	ErrAddress            = errors.New("address must be at most 500 characters")
+	ErrNotPending         = errors.New("only pending requests can be reviewed or cancelled")
+	ErrReviewNoteRequired = errors.New("a note is required to reject a request")
+	ErrNotReviewer        = errors.New("only HR can review change requests")
+	ErrOwnRequest         = errors.New("employees cannot review their own change requests")
+	ErrNotRequester       = errors.New("only the employee who made the request can cancel it")
+	ErrNoEmployee         = errors.New("the user is not linked to an employee")
+)
+
+// Change is one field of a request with its value before and after.
+// Bank accounts appear as an AccountSummary, never with the full number.
+type Change struct {
+	Field  Field `json:"field"`
+	Before any   `json:"before"`
+	After  any   `json:"after"`
+}
+
+// AccountSummary is a bank account as shown in the audit trail.
+type AccountSummary struct {
+	BankName      string         `json:"bank_name"`
+	BankCode      string         `json:"bank_code"`
+	BranchCode    *string        `json:"branch_code,omitempty"`
+	AccountHolder string         `json:"account_holder"`
+	AccountLast4  string         `json:"account_last4"`
+	Currency      money.Currency `json:"currency"`
+}
+
+func Summarize(a *bankdomain.BankAccount) *AccountSummary {
+	if a == nil {
+		return nil
+	}
+	return &AccountSummary{
+		BankName:      a.BankName,
+		BankCode:      a.BankCode,
+		BranchCode:    a.BranchCode,
+		AccountHolder: a.AccountHolder,
+		AccountLast4:  a.AccountLast4,
+		Currency:      a.Currency,
+	}
+}
+
+// Pending holds the proposed values of sensitive fields until the request
+// is applied. Storage keeps it encrypted and drops it once the request is
+// reviewed or cancelled.
+type Pending struct {
+	TaxCode     *string                      `json:"tax_code,omitempty"`
+	BankAccount *bankdomain.BankAccountInput `json:"bank_account,omitempty"`
+}
+
+// Current is what the employee's record holds for the fields a request
+// may change.
+type Current struct {
+	Phone   string
+	Address *string
+	TaxCode *string
+	// BankAccount is the primary account, nil when there is none.
+	BankAccount *bankdomain.BankAccount
+}
+
+type ChangeRequest struct {
+	ID         string   `json:"id"`
+	TenantID   string   `json:"tenant_id"`
+	EmployeeID string   `json:"employee_id"`
+	Status     Status   `json:"status"`
+	Changes    []Change `json:"changes"`
+	Reason     *string  `json:"reason,omitempty"`
+
+	RequestedBy string     `json:"requested_by,omitempty"`
+	ReviewedBy  *string    `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote  *string    `json:"review_note,omitempty"`
+	AppliedAt   *time.Time `json:"applied_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	Pending *Pending `json:"-"`
+
+	// Read-only, filled by listings.
+	EmployeeCode  string `json:"employee_code,omitempty"`
+	EmployeeName  string `json:"employee_name,omitempty"`
+	EmployeeEmail string `json:"-"`
+}
+
+// ProposalInput lists the fields an employee wants to change; nil fields
+// are kept. An empty address clears it.
+type ProposalInput struct {
+	Phone       *string
+	Address     *string
+	TaxCode     *string
+	BankAccount *bankdomain.BankAccountInput
+	Reason      *string
+}
+
+// Propose splits the proposal against the current values. Contact details
+// go into applied, ready to write to the employee; tax code and bank
+// account go into pending for HR. Either is nil when it has nothing, and
+// values equal to the current ones are dropped.
+func Propose(
+	tenantID, employeeID string,
+	in ProposalInput,
+	current Current,
+	requestedBy string,
+	now time.Time,
+) (applied, pending *ChangeRequest, err error) {
+
+	if tenantID == "" {
+		return nil, nil, errors.New("tenantID is required")
+	}
+
+	var direct []Change
+	if in.Phone != nil {
+		phone, err := employeedomain.NormalizePhone(*in.Phone)
+		if err != nil {
+			return nil, nil, err
+		}
+		if phone != current.Phone {
+			direct = append(direct, Change{Field: FieldPhone, Before: current.Phone, After: phone})
+		}
+	}
+	if in.Address != nil {
+		address := trimmed(in.Address)
+		if address != nil && len([]rune(*address)) > MaxAddressLength {
+			return nil, nil, ErrAddress
+		}
+		if !sameString(address, current.Address) {
+			direct = append(direct, Change{Field: FieldAddress, Before: current.Address, After: address})
+		}
+	}
+
+	var sensitive []Change
+	values := &Pending{}
+	if in.TaxCode != nil {
+		code, err := statutorydomain.NormalizeTaxCode(*in.TaxCode)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !sameString(&code, current.TaxCode) {
+			sensitive = append(sensitive, Change{Field: FieldTaxCode, Before: current.TaxCode, After: code})
+			values.TaxCode = &code
+		}
+	}
+	if in.BankAccount != nil {
+		// Validates and normalizes the account as it will be stored.
+		account, err := bankdomain.NewBankAccount(employeeID, *in.BankAccount)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !sameAccount(account, current.BankAccount) {
+			sensitive = append(sensitive, Change{
+				Field:  FieldBankAccount,
+				Before: Summarize(current.BankAccount),
+				After:  Summarize(account),
+			})
+			values.BankAccount = &bankdomain.BankAccountInput{
+				BankName:      account.BankName,
+				BankCode:      account.BankCode,
+				BranchCode:    account.BranchCode,
+				AccountHolder: account.AccountHolder,
+				AccountNumber: account.AccountNumber,
+				Currency:      account.Currency,
+				IsPrimary:     true,
+			}
+		}
+	}
+
+	if len(direct) == 0 && len(sensitive) == 0 {
+		return nil, nil, ErrNoChanges
+	}
+
+	newRequest := func(status Status, changes []Change) *ChangeRequest {
+		return &ChangeRequest{
+			TenantID:    tenantID,
+			EmployeeID:  employeeID,
+			Status:      status,
+			Changes:     changes,
+			Reason:      trimmed(in.Reason),
+			RequestedBy: requestedBy,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+	}
+
+	if len(direct) > 0 {
+		applied = newRequest(StatusApplied, direct)
+		applied.AppliedAt = &now
+	}
+	if len(sensitive) > 0 {
+		pending = newRequest(StatusPending, sensitive)
+		pending.Pending = values
+	}
+
+	return applied, pending, nil
+}
+
+// ApplyContact writes the contact details of a request just proposed to
+// the current values.
+func (r *ChangeRequest) ApplyContact(current *Current) {
+	for _, c := range r.Changes {
+		switch c.Field {
+		case FieldPhone:
+			if phone, ok := c.After.(string); ok {
+				current.Phone = phone
+			}
+		case FieldAddress:
+			address, _ := c.After.(*string)
+			current.Address = address
+		}
+	}
+}
+
+// Approve marks a pending request applied. The before values are taken
+// again from current, as the record may have changed since the request
+// was made; the caller writes the pending values.
+func (r *ChangeRequest) Approve(current Current, reviewerID string, note *string, now time.Time) error {
+	if r.Status != StatusPending {
+		return ErrNotPending
+	}
+
+	for i, c := range r.Changes {
+		switch c.Field {
+		case FieldTaxCode:
+			r.Changes[i].Before = current.TaxCode
+		case FieldBankAccount:
+			r.Changes[i].Before = Summarize(current.BankAccount)
+		}
+	}
+
+	r.Status = StatusApplied
+	r.review(reviewerID, note, now)
+	r.AppliedAt = &now
+	return nil
+}
+
+func (r *ChangeRequest) Reject(reviewerID string, note *string, now time.Time) error {
+	if r.Status != StatusPending {
+		return ErrNotPending
+	}
+	if trimmed(note) == nil {
+		return ErrReviewNoteRequired
+	}
+
+	r.Status = StatusRejected
+	r.review(reviewerID, note, now)
+	return nil
+}
+
+// Cancel withdraws a pending request.
+func (r *ChangeRequest) Cancel(now time.Time) error {
+	if r.Status != StatusPending {
+		return ErrNotPending
+	}
+
+	r.Status = StatusCancelled
+	r.Pending = nil
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *ChangeRequest) review(reviewerID string, note *string, now time.Time) {
+	r.ReviewedBy = &reviewerID
+	r.ReviewedAt = &now
+	r.ReviewNote = trimmed(note)
+	r.Pending = nil
+	r.UpdatedAt = now
+}
+
+func trimmed(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	return &t
+}
+
+func sameString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameAccount(a, b *bankdomain.BankAccount) bool {
+	return b != nil &&
+		a.AccountNumber == b.AccountNumber &&
+		a.BankCode == b.BankCode &&
+		sameString(a.BranchCode, b.BranchCode) &&
+		a.AccountHolder == b.AccountHolder &&
+		a.Currency == b.Currency
+}
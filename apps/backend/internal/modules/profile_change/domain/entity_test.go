@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChangeRequestTransitions(t *testing.T) {
+	now := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	note := "tax code does not match the ID card"
+	blank := "  "
+
+	approve := func(r *ChangeRequest) error { return r.Approve(Current{}, "hr-1", nil, now) }
+	reject := func(r *ChangeRequest) error { return r.Reject("hr-1", &note, now) }
+	cancel := func(r *ChangeRequest) error { return r.Cancel(now) }
+
+	tests := []struct {
+		name       string
+		status     Status
+		transition func(r *ChangeRequest) error
+		wantStatus Status
+		wantErr    error
+	}{
+		{name: "approve pending", status: StatusPending, transition: approve, wantStatus: StatusApplied},
+		{name: "reject pending", status: StatusPending, transition: reject, wantStatus: StatusRejected},
+		{name: "cancel pending", status: StatusPending, transition: cancel, wantStatus: StatusCancelled},
+		{
+			name:       "reject without note",
+			status:     StatusPending,
+			transition: func(r *ChangeRequest) error { return r.Reject("hr-1", &blank, now) },
+			wantStatus: StatusPending,
+			wantErr:    ErrReviewNoteRequired,
+		},
+		{name: "approve applied", status: StatusApplied, transition: approve, wantStatus: StatusApplied, wantErr: ErrNotPending},
+		{name: "approve cancelled", status: StatusCancelled, transition: approve, wantStatus: StatusCancelled, wantErr: ErrNotPending},
+		{name: "reject applied", status: StatusApplied, transition: reject, wantStatus: StatusApplied, wantErr: ErrNotPending},
+		{name: "cancel rejected", status: StatusRejected, transition: cancel, wantStatus: StatusRejected, wantErr: ErrNotPending},
+		{name: "cancel applied", status: StatusApplied, transition: cancel, wantStatus: StatusApplied, wantErr: ErrNotPending},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ChangeRequest{Status: tt.status, Pending: &Pending{}}
+
+			err := tt.transition(r)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if r.Status != tt.wantStatus {
+				t.Fatalf("status = %s, want %s", r.Status, tt.wantStatus)
+			}
+		})
+	}
+}
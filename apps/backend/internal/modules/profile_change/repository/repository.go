@@ -0,0 +1,33 @@
+package profilechangerepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+)
+
+var (
+	ErrChangeRequestNotFound = errors.New("change request not found")
+	ErrPendingRequestExists  = errors.New("the employee already has a change request waiting for review")
+)
+
+// ChangeRequestFilter narrows a listing; empty fields match everything.
+type ChangeRequestFilter struct {
+	EmployeeID string
+	Status     domain.Status
+}
+
+type ChangeRequestRepository interface {
+	Create(ctx context.Context, r *domain.ChangeRequest) error
+	Update(ctx context.Context, r *domain.ChangeRequest) error
+
+	// GetByID loads the request with its pending values decrypted.
+	GetByID(ctx context.Context, id string) (*domain.ChangeRequest, error)
+	// LockByID is GetByID with a row lock; it must be called inside a
+	// transaction.
+	LockByID(ctx context.Context, id string) (*domain.ChangeRequest, error)
+	// List returns the tenant's requests matching f, newest first, without
+	// their pending values.
+	List(ctx context.Context, tenantID string, f ChangeRequestFilter) ([]*domain.ChangeRequest, error)
+}
@@ -0,0 +1,110 @@
+package profilechangeusecase
+
+import (
+	"context"
+	"time"
+
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type GetChangeRequestUsecase struct {
+	repo profilechangerepository.ChangeRequestRepository
+}
+
+func NewGetChangeRequestUsecase(repo profilechangerepository.ChangeRequestRepository) *GetChangeRequestUsecase {
+	return &GetChangeRequestUsecase{repo: repo}
+}
+
+func (uc *GetChangeRequestUsecase) Execute(ctx context.Context, id string) (*domain.ChangeRequest, error) {
+	return uc.repo.GetByID(ctx, id)
+}
+
+type ListChangeRequestsUsecase struct {
+	repo profilechangerepository.ChangeRequestRepository
+}
+
+func NewListChangeRequestsUsecase(repo profilechangerepository.ChangeRequestRepository) *ListChangeRequestsUsecase {
+	return &ListChangeRequestsUsecase{repo: repo}
+}
+
+func (uc *ListChangeRequestsUsecase) Execute(
+	ctx context.Context,
+	tenantID string,
+	f profilechangerepository.ChangeRequestFilter,
+) ([]*domain.ChangeRequest, error) {
+	return uc.repo.List(ctx, tenantID, f)
+}
+
+type ListMyChangeRequestsUsecase struct {
+	repo         profilechangerepository.ChangeRequestRepository
+	employeeRepo employeerepository.EmployeeRepository
+	userRepo     userrepository.UserRepository
+}
+
+func NewListMyChangeRequestsUsecase(
+	repo profilechangerepository.ChangeRequestRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	userRepo userrepository.UserRepository,
+) *ListMyChangeRequestsUsecase {
+	return &ListMyChangeRequestsUsecase{repo: repo, employeeRepo: employeeRepo, userRepo: userRepo}
+}
+
+// Execute lists the requests of the user's own employee record.
+func (uc *ListMyChangeRequestsUsecase) Execute(ctx context.Context, userID string) ([]*domain.ChangeRequest, error) {
+	employee, err := selfEmployee(uc.userRepo, uc.employeeRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.List(ctx, employee.TenantID, profilechangerepository.ChangeRequestFilter{
+		EmployeeID: employee.ID,
+	})
+}
+
+type CancelChangeRequestUsecase struct {
+	repo      profilechangerepository.ChangeRequestRepository
+	userRepo  userrepository.UserRepository
+	txManager txpkg.Manager
+}
+
+func NewCancelChangeRequestUsecase(
+	repo profilechangerepository.ChangeRequestRepository,
+	userRepo userrepository.UserRepository,
+	txManager txpkg.Manager,
+) *CancelChangeRequestUsecase {
+	return &CancelChangeRequestUsecase{repo: repo, userRepo: userRepo, txManager: txManager}
+}
+
+// Execute withdraws one of the user's own pending requests.
+func (uc *CancelChangeRequestUsecase) Execute(ctx context.Context, id, userID string) (*domain.ChangeRequest, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *domain.ChangeRequest
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// Locked like a review, so the two cannot both succeed.
+		r, err = uc.repo.LockByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if user.EmployeeID == nil || *user.EmployeeID != r.EmployeeID {
+			return domain.ErrNotRequester
+		}
+
+		if err := r.Cancel(time.Now().UTC()); err != nil {
+			return err
+		}
+		return uc.repo.Update(txCtx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
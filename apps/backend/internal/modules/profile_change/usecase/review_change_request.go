@@ -0,0 +1,192 @@
+package profilechangeusecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	bankaccountusecase "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/usecase"
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
+	statutoryusecase "github.com/smart-hmm/smart-hmm/internal/modules/statutory/usecase"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+	"github.com/smart-hmm/smart-hmm/internal/worker"
+)
+
+type ReviewChangeRequestUsecase struct {
+	repo          profilechangerepository.ChangeRequestRepository
+	userRepo      userrepository.UserRepository
+	bankRepo      bankaccountrepository.BankAccountRepository
+	getProfile    *statutoryusecase.GetEmployeeProfileUsecase
+	updateProfile *statutoryusecase.UpdateEmployeeProfileUsecase
+	createAccount *bankaccountusecase.CreateBankAccountUsecase
+	txManager     txpkg.Manager
+	queueSvc      queueports.QueueService
+}
+
+func NewReviewChangeRequestUsecase(
+	repo profilechangerepository.ChangeRequestRepository,
+	userRepo userrepository.UserRepository,
+	bankRepo bankaccountrepository.BankAccountRepository,
+	getProfile *statutoryusecase.GetEmployeeProfileUsecase,
+	updateProfile *statutoryusecase.UpdateEmployeeProfileUsecase,
+	createAccount *bankaccountusecase.CreateBankAccountUsecase,
+	txManager txpkg.Manager,
+	queueSvc queueports.QueueService,
+) *ReviewChangeRequestUsecase {
+	return &ReviewChangeRequestUsecase{
+		repo:          repo,
+		userRepo:      userRepo,
+		bankRepo:      bankRepo,
+		getProfile:    getProfile,
+		updateProfile: updateProfile,
+		createAccount: createAccount,
+		txManager:     txManager,
+		queueSvc:      queueSvc,
+	}
+}
+
+type ReviewInput struct {
+	Approve bool
+	// Note is required to reject.
+	Note *string
+}
+
+// Execute approves or rejects a pending request. An approved tax code
+// replaces the one in the statutory profile; an approved bank account is
+// added as the primary account, the previous one staying on file. The
+// employee is e-mailed the outcome once it is committed. Only admins and
+// HR review, and never their own requests.
+func (uc *ReviewChangeRequestUsecase) Execute(
+	ctx context.Context,
+	id string,
+	in ReviewInput,
+	userID string,
+) (*domain.ChangeRequest, error) {
+
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.Role != userdomain.Admin && user.Role != userdomain.HR {
+		return nil, domain.ErrNotReviewer
+	}
+
+	var r *domain.ChangeRequest
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		// Held until commit, so a concurrent review or cancellation waits
+		// and then finds the request no longer pending.
+		r, err = uc.repo.LockByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if r.Status != domain.StatusPending {
+			return domain.ErrNotPending
+		}
+		if user.EmployeeID != nil && *user.EmployeeID == r.EmployeeID {
+			return domain.ErrOwnRequest
+		}
+
+		now := time.Now().UTC()
+
+		if !in.Approve {
+			if err := r.Reject(userID, in.Note, now); err != nil {
+				return err
+			}
+		} else if err := uc.approve(txCtx, r, userID, in.Note, now); err != nil {
+			return err
+		}
+
+		return uc.repo.Update(txCtx, r)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The review stands when the e-mail cannot be sent.
+	if err := uc.notify(ctx, r); err != nil {
+		log.Printf("profile change review e-mail for request %s: %v", r.ID, err)
+	}
+
+	return r, nil
+}
+
+func (uc *ReviewChangeRequestUsecase) approve(
+	ctx context.Context,
+	r *domain.ChangeRequest,
+	userID string,
+	note *string,
+	now time.Time,
+) error {
+
+	values := r.Pending
+	if values == nil {
+		return errors.New("pending change request has no values to apply")
+	}
+
+	current, err := sensitiveValues(ctx, uc.getProfile, uc.bankRepo, r.EmployeeID)
+	if err != nil {
+		return err
+	}
+	if err := r.Approve(current, userID, note, now); err != nil {
+		return err
+	}
+
+	if values.TaxCode != nil {
+		profile, err := uc.getProfile.Execute(ctx, r.EmployeeID)
+		if err != nil {
+			return err
+		}
+		_, err = uc.updateProfile.Execute(ctx, statutoryusecase.UpdateEmployeeProfileInput{
+			EmployeeID:      r.EmployeeID,
+			WageRegion:      profile.WageRegion,
+			Dependents:      profile.Dependents,
+			InsuranceSalary: profile.InsuranceSalary,
+			TaxCode:         values.TaxCode,
+		}, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if values.BankAccount != nil {
+		// Joins the surrounding transaction.
+		if _, err := uc.createAccount.Execute(ctx, r.EmployeeID, *values.BankAccount, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *ReviewChangeRequestUsecase) notify(ctx context.Context, r *domain.ChangeRequest) error {
+	body := "Your requested changes to your tax code or bank account have been approved and applied to your record."
+	if r.Status == domain.StatusRejected {
+		body = fmt.Sprintf(
+			"Your requested changes to your tax code or bank account have not been approved.\n\nHR's note: %s",
+			*r.ReviewNote,
+		)
+	}
+
+	data, err := json.Marshal(worker.SendEmailPayload{
+		To:      r.EmployeeEmail,
+		Subject: "Your profile change request",
+		Body:    fmt.Sprintf("Dear %s,\n\n%s\n", r.EmployeeName, body),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := uc.queueSvc.Publish(ctx, worker.SendEmailTopic, queueports.Message{Body: data}); err != nil {
+		return fmt.Errorf("publish profile change review: %w", err)
+	}
+
+	return nil
+}
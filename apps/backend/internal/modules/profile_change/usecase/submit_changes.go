@@ -0,0 +1,152 @@
+package profilechangeusecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bankaccountrepository "github.com/smart-hmm/smart-hmm/internal/modules/bank_account/repository"
+	employeedomain "github.com/smart-hmm/smart-hmm/internal/modules/employee/domain"
+	employeerepository "github.com/smart-hmm/smart-hmm/internal/modules/employee/repository"
+	"github.com/smart-hmm/smart-hmm/internal/modules/profile_change/domain"
+	profilechangerepository "github.com/smart-hmm/smart-hmm/internal/modules/profile_change/repository"
+	statutoryusecase "github.com/smart-hmm/smart-hmm/internal/modules/statutory/usecase"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+	txpkg "github.com/smart-hmm/smart-hmm/internal/pkg/tx"
+)
+
+type SubmitChangesUsecase struct {
+	repo         profilechangerepository.ChangeRequestRepository
+	employeeRepo employeerepository.EmployeeRepository
+	userRepo     userrepository.UserRepository
+	bankRepo     bankaccountrepository.BankAccountRepository
+	getProfile   *statutoryusecase.GetEmployeeProfileUsecase
+	txManager    txpkg.Manager
+}
+
+func NewSubmitChangesUsecase(
+	repo profilechangerepository.ChangeRequestRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	userRepo userrepository.UserRepository,
+	bankRepo bankaccountrepository.BankAccountRepository,
+	getProfile *statutoryusecase.GetEmployeeProfileUsecase,
+	txManager txpkg.Manager,
+) *SubmitChangesUsecase {
+	return &SubmitChangesUsecase{
+		repo:         repo,
+		employeeRepo: employeeRepo,
+		userRepo:     userRepo,
+		bankRepo:     bankRepo,
+		getProfile:   getProfile,
+		txManager:    txManager,
+	}
+}
+
+// Submitted is what became of a proposal: Applied holds the contact
+// details already written, Pending the sensitive fields waiting for HR.
+type Submitted struct {
+	Applied *domain.ChangeRequest `json:"applied,omitempty"`
+	Pending *domain.ChangeRequest `json:"pending,omitempty"`
+}
+
+// Execute proposes changes to the user's own employee record. Phone and
+// address are written at once; tax code and bank account are applied
+// when HR approves them. Both are kept as requests for the audit.
+func (uc *SubmitChangesUsecase) Execute(
+	ctx context.Context,
+	userID string,
+	in domain.ProposalInput,
+) (*Submitted, error) {
+
+	employee, err := selfEmployee(uc.userRepo, uc.employeeRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := sensitiveValues(ctx, uc.getProfile, uc.bankRepo, employee.ID)
+	if err != nil {
+		return nil, err
+	}
+	current.Phone = employee.Phone
+	current.Address = employee.Address
+
+	now := time.Now().UTC()
+	applied, pending, err := domain.Propose(employee.TenantID, employee.ID, in, current, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txManager.WithTx(ctx, func(txCtx context.Context) error {
+		if applied != nil {
+			applied.ApplyContact(&current)
+			employee.Phone = current.Phone
+			employee.Address = current.Address
+			employee.UpdatedAt = now
+
+			if err := uc.employeeRepo.UpdateContact(txCtx, employee); err != nil {
+				return err
+			}
+			if err := uc.repo.Create(txCtx, applied); err != nil {
+				return err
+			}
+		}
+
+		if pending != nil {
+			return uc.repo.Create(txCtx, pending)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Submitted{Applied: applied, Pending: pending}, nil
+}
+
+// selfEmployee loads the employee the user is linked to.
+func selfEmployee(
+	userRepo userrepository.UserRepository,
+	employeeRepo employeerepository.EmployeeRepository,
+	userID string,
+) (*employeedomain.Employee, error) {
+
+	user, err := userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.EmployeeID == nil {
+		return nil, domain.ErrNoEmployee
+	}
+
+	return employeeRepo.FindByID(*user.EmployeeID)
+}
+
+// sensitiveValues loads the employee's tax code and primary bank account.
+func sensitiveValues(
+	ctx context.Context,
+	getProfile *statutoryusecase.GetEmployeeProfileUsecase,
+	bankRepo bankaccountrepository.BankAccountRepository,
+	employeeID string,
+) (domain.Current, error) {
+
+	var current domain.Current
+
+	profile, err := getProfile.Execute(ctx, employeeID)
+	if err != nil {
+		return current, err
+	}
+	current.TaxCode = profile.TaxCode
+
+	accounts, err := bankRepo.ListByEmployeeID(ctx, employeeID)
+	if err != nil {
+		return current, err
+	}
+	for _, a := range accounts {
+		if a.IsPrimary {
+			current.BankAccount = a
+			break
+		}
+	}
+
+	return current, nil
+}
@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"time"
+
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/formula"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/money"
+)
+
+type ComponentKind string
+
+const (
+	Earning   ComponentKind = "EARNING"
+	Deduction ComponentKind = "DEDUCTION"
+)
+
+func (k ComponentKind) IsValid() bool {
+	switch k {
+	case Earning, Deduction:
+		return true
+	}
+	return false
+}
+
+// Variables every formula can reference. Components may also reference
+// the code of any active component evaluated before them; see
+// ValidateFormulas.
+const (
+	VarBaseSalary     = "base_salary"
+	VarWorkedDays     = "worked_days"
+	VarStandardDays   = "standard_days"
+	VarOTHours150     = "ot_hours_150"
+	VarOTHours200     = "ot_hours_200"
+	VarSeniorityYears = "seniority_years"
+	// VarMonthsWorked is the months worked in the run's pro-rating year,
+	// or 12 when the run does not pro-rate.
+	VarMonthsWorked = "months_worked"
+)
+
+var ReservedVariables = []string{
+	VarBaseSalary,
+	VarWorkedDays,
+	VarStandardDays,
+	VarOTHours150,
+	VarOTHours200,
+	VarSeniorityYears,
+	VarMonthsWorked,
+}
+
+// sampleVariables are typical values of the built-in variables, used to
+// try a formula once when it is saved.
+var sampleVariables = map[string]interface{}{
+	VarBaseSalary:     10_000_000.0,
+	VarWorkedDays:     22.0,
+	VarStandardDays:   26.0,
+	VarOTHours150:     4.0,
+	VarOTHours200:     2.0,
+	VarSeniorityYears: 3.0,
+	VarMonthsWorked:   12.0,
+}
+
+var (
+	ErrInvalidTenantID  = errors.New("tenantID is required")
+	ErrInvalidName      = errors.New("name is required")
+	ErrInvalidCode      = errors.New("code must start with a lowercase letter and contain only lowercase letters, digits and underscores")
+	ErrReservedCode     = errors.New("code clashes with a built-in formula variable")
+	ErrInvalidKind      = errors.New("kind must be EARNING or DEDUCTION")
+	ErrFormulaRequired  = errors.New("formula is required")
+	ErrNegativeComputed = errors.New("formula evaluated to a negative amount")
+	ErrInvalidRunTypes  = errors.New("run types must be REGULAR, BONUS, THIRTEENTH_MONTH or TERMINATION")
+)
+
+var codePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+type SalaryComponent struct {
+	ID       string        `json:"id"`
+	TenantID string        `json:"tenantId"`
+	Code     string        `json:"code"`
+	Name     string        `json:"name"`
+	Kind     ComponentKind `json:"kind"`
+	Taxable  bool          `json:"taxable"`
+	Formula  string        `json:"formula"`
+
+	// RunTypes lists the payroll run types the component is evaluated in.
+	RunTypes []payrolldomain.RunType `json:"runTypes"`
+
+	SortOrder int  `json:"sortOrder"`
+	IsActive  bool `json:"isActive"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type NewSalaryComponentInput struct {
+	TenantID  string
+	Code      string
+	Name      string
+	Kind      ComponentKind
+	Taxable   bool
+	Formula   string
+	RunTypes  []payrolldomain.RunType
+	SortOrder int
+}
+
+func NewSalaryComponent(in NewSalaryComponentInput) (*SalaryComponent, error) {
+	if in.TenantID == "" {
+		return nil, ErrInvalidTenantID
+	}
+
+	now := time.Now().UTC()
+
+	c := &SalaryComponent{
+		TenantID:  in.TenantID,
+		Code:      in.Code,
+		Name:      in.Name,
+		Kind:      in.Kind,
+		Taxable:   in.Taxable,
+		Formula:   in.Formula,
+		RunTypes:  defaultRunTypes(in.RunTypes),
+		SortOrder: in.SortOrder,
+		IsActive:  true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *SalaryComponent) Update(name string, kind ComponentKind, taxable bool, expression string, runTypes []payrolldomain.RunType, sortOrder int, isActive bool) error {
+	updated := *c
+	updated.Name = name
+	updated.Kind = kind
+	updated.Taxable = taxable
+	updated.Formula = expression
+	updated.RunTypes = defaultRunTypes(runTypes)
+	updated.SortOrder = sortOrder
+	updated.IsActive = isActive
+
+	if err := updated.validate(); err != nil {
+		return err
+	}
+
+	updated.UpdatedAt = time.Now().UTC()
+	*c = updated
+	return nil
+}
+
+func (c *SalaryComponent) validate() error {
+	if c.Name == "" {
+		return ErrInvalidName
+	}
+	if !codePattern.MatchString(c.Code) {
+		return ErrInvalidCode
+	}
+	if slices.Contains(ReservedVariables, c.Code) {
+		return ErrReservedCode
+	}
+	if !c.Kind.IsValid() {
+		return ErrInvalidKind
+	}
+	for _, t := range c.RunTypes {
+		if !t.IsValid() {
+			return ErrInvalidRunTypes
+		}
+	}
+	if c.Formula == "" {
+		return ErrFormulaRequired
+	}
+	if _, err := formula.Compile(c.Formula); err != nil {
+		return fmt.Errorf("invalid formula: %w", err)
+	}
+	return nil
+}
+
+// ValidateFormulas tries the formula of every active component of a
+// tenant once. A formula may only reference the built-in variables and
+// the codes of active components with a lower sort order that are
+// evaluated in all of its run types, since only those are set when it is
+// evaluated. components is the tenant's whole set as it would be saved.
+func ValidateFormulas(components []*SalaryComponent) error {
+	for _, c := range components {
+		if !c.IsActive {
+			continue
+		}
+
+		vars := maps.Clone(sampleVariables)
+		for _, earlier := range components {
+			if earlier.IsActive && earlier.SortOrder < c.SortOrder && earlier.covers(c) {
+				vars[earlier.Code] = 1.0
+			}
+		}
+
+		if err := formula.Validate(c.Formula, vars); err != nil {
+			return fmt.Errorf("component %s: invalid formula: %w", c.Code, err)
+		}
+	}
+	return nil
+}
+
+// covers reports whether c is evaluated in every run type other is.
+func (c *SalaryComponent) covers(other *SalaryComponent) bool {
+	for _, t := range other.RunTypes {
+		if !c.AppliesTo(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultRunTypes limits components to regular runs unless told otherwise.
+func defaultRunTypes(types []payrolldomain.RunType) []payrolldomain.RunType {
+	if len(types) == 0 {
+		return []payrolldomain.RunType{payrolldomain.RunRegular}
+	}
+	return types
+}
+
+// AppliesTo reports whether the component is evaluated in runs of the type.
+func (c *SalaryComponent) AppliesTo(t payrolldomain.RunType) bool {
+	return slices.Contains(c.RunTypes, t)
+}
+
+// Evaluate computes the component amount for one employee, rounded with
+// the currency's rule.
+func (c *SalaryComponent) Evaluate(vars map[string]interface{}, currency money.Currency) (money.Money, error) {
+	result, err := formula.Evaluate(c.Formula, vars)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("component %s: %w", c.Code, err)
+	}
+
+	amount, err := money.FromFloat(result, currency)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("component %s: %w", c.Code, err)
+	}
+	if amount.IsNegative() {
+		return money.Money{}, fmt.Errorf("component %s: %w", c.Code, ErrNegativeComputed)
+	}
+	return amount, nil
+}
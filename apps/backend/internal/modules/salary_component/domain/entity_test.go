@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/pkg/formula"
+)
+
+func TestNewSalaryComponentValidatesFormula(t *testing.T) {
+	tests := []struct {
+		formula string
+		wantErr bool
+	}{
+		{formula: "base_salary * worked_days / standard_days"},
+		{formula: "if(seniority_years >= 5, lunch, 0)"},
+		{formula: "base_salary *", wantErr: true},
+		{formula: "(base_salary", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formula, func(t *testing.T) {
+			_, err := NewSalaryComponent(NewSalaryComponentInput{
+				TenantID: "t1",
+				Code:     "allowance",
+				Name:     "Allowance",
+				Kind:     Earning,
+				Formula:  tt.formula,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewSalaryComponent(%q) error = %v, wantErr %v", tt.formula, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFormulas(t *testing.T) {
+	lunch := &SalaryComponent{Code: "lunch", Formula: "730000", SortOrder: 1, IsActive: true, RunTypes: []payrolldomain.RunType{payrolldomain.RunRegular}}
+	inactive := &SalaryComponent{Code: "phone", Formula: "200000", SortOrder: 1, RunTypes: []payrolldomain.RunType{payrolldomain.RunRegular}}
+
+	component := func(expression string, sortOrder int, runTypes ...payrolldomain.RunType) *SalaryComponent {
+		return &SalaryComponent{Code: "gross", Formula: expression, SortOrder: sortOrder, IsActive: true, RunTypes: defaultRunTypes(runTypes)}
+	}
+
+	tests := []struct {
+		name       string
+		components []*SalaryComponent
+		wantErr    bool
+		unknown    bool
+	}{
+		{name: "built-in variables", components: []*SalaryComponent{component("base_salary * worked_days / standard_days", 2)}},
+		{name: "earlier component", components: []*SalaryComponent{lunch, component("base_salary + lunch", 2)}},
+		{name: "misspelled variable", components: []*SalaryComponent{component("base_salry * 2", 2)}, wantErr: true, unknown: true},
+		{name: "later component", components: []*SalaryComponent{lunch, component("base_salary + lunch", 0)}, wantErr: true, unknown: true},
+		{name: "same sort order", components: []*SalaryComponent{lunch, component("base_salary + lunch", 1)}, wantErr: true, unknown: true},
+		{name: "inactive component", components: []*SalaryComponent{inactive, component("base_salary + phone", 2)}, wantErr: true, unknown: true},
+		{name: "component missing from a run type", components: []*SalaryComponent{lunch, component("lunch", 2, payrolldomain.RunBonus)}, wantErr: true, unknown: true},
+		{name: "inactive formulas are not checked", components: []*SalaryComponent{{Code: "old", Formula: "gone * 2", SortOrder: 3}}},
+		{name: "wrong argument count", components: []*SalaryComponent{component("max(base_salary)", 2)}, wantErr: true},
+		{name: "boolean result", components: []*SalaryComponent{component("worked_days > standard_days", 2)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFormulas(tt.components)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateFormulas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, formula.ErrUnknownVariable) != tt.unknown {
+				t.Fatalf("ValidateFormulas() error = %v, want unknown variable %v", err, tt.unknown)
+			}
+		})
+	}
+}
+
+func TestSalaryComponentEvaluate(t *testing.T) {
+	c := &SalaryComponent{Code: "daily_rate", Formula: "base_salary / worked_days"}
+
+	tests := []struct {
+		name    string
+		days    float64
+		want    string
+		wantErr bool
+	}{
+		{name: "worked days", days: 20, want: "500000"},
+		{name: "no worked days", days: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Evaluate(map[string]interface{}{
+				VarBaseSalary: 10_000_000.0,
+				VarWorkedDays: tt.days,
+			}, "VND")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("Evaluate() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package salarycomponentrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+)
+
+var (
+	ErrSalaryComponentNotFound      = errors.New("salary component not found")
+	ErrSalaryComponentAlreadyExists = errors.New("salary component code already exists")
+)
+
+type SalaryComponentRepository interface {
+	Create(ctx context.Context, c *domain.SalaryComponent) error
+	Update(ctx context.Context, c *domain.SalaryComponent) error
+	Delete(ctx context.Context, id string) error
+
+	GetByID(ctx context.Context, id string) (*domain.SalaryComponent, error)
+	// ListByTenant returns components in evaluation order.
+	ListByTenant(ctx context.Context, tenantID string, activeOnly bool) ([]*domain.SalaryComponent, error)
+}
@@ -0,0 +1,40 @@
+package salarycomponentusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type CreateSalaryComponentUsecase struct {
+	repo salarycomponentrepository.SalaryComponentRepository
+}
+
+func NewCreateSalaryComponentUsecase(repo salarycomponentrepository.SalaryComponentRepository) *CreateSalaryComponentUsecase {
+	return &CreateSalaryComponentUsecase{repo: repo}
+}
+
+func (uc *CreateSalaryComponentUsecase) Execute(
+	ctx context.Context,
+	in domain.NewSalaryComponentInput,
+) (*domain.SalaryComponent, error) {
+	component, err := domain.NewSalaryComponent(in)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := uc.repo.ListByTenant(ctx, component.TenantID, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateFormulas(append(components, component)); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Create(ctx, component); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
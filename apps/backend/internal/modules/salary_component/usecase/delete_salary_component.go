@@ -0,0 +1,38 @@
+package salarycomponentusecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type DeleteSalaryComponentUsecase struct {
+	repo salarycomponentrepository.SalaryComponentRepository
+}
+
+func NewDeleteSalaryComponentUsecase(repo salarycomponentrepository.SalaryComponentRepository) *DeleteSalaryComponentUsecase {
+	return &DeleteSalaryComponentUsecase{repo: repo}
+}
+
+// Execute deletes a component unless an active one builds on it.
+func (uc *DeleteSalaryComponentUsecase) Execute(ctx context.Context, id string) error {
+	component, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	components, err := uc.repo.ListByTenant(ctx, component.TenantID, false)
+	if err != nil {
+		return err
+	}
+	components = slices.DeleteFunc(components, func(c *domain.SalaryComponent) bool {
+		return c.ID == id
+	})
+	if err := domain.ValidateFormulas(components); err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
@@ -0,0 +1,20 @@
+package salarycomponentusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type GetSalaryComponentUsecase struct {
+	repo salarycomponentrepository.SalaryComponentRepository
+}
+
+func NewGetSalaryComponentUsecase(repo salarycomponentrepository.SalaryComponentRepository) *GetSalaryComponentUsecase {
+	return &GetSalaryComponentUsecase{repo: repo}
+}
+
+func (uc *GetSalaryComponentUsecase) Execute(ctx context.Context, id string) (*domain.SalaryComponent, error) {
+	return uc.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,20 @@
+package salarycomponentusecase
+
+import (
+	"context"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type ListSalaryComponentsUsecase struct {
+	repo salarycomponentrepository.SalaryComponentRepository
+}
+
+func NewListSalaryComponentsUsecase(repo salarycomponentrepository.SalaryComponentRepository) *ListSalaryComponentsUsecase {
+	return &ListSalaryComponentsUsecase{repo: repo}
+}
+
+func (uc *ListSalaryComponentsUsecase) Execute(ctx context.Context, tenantID string, activeOnly bool) ([]*domain.SalaryComponent, error) {
+	return uc.repo.ListByTenant(ctx, tenantID, activeOnly)
+}
@@ -0,0 +1,62 @@
+package salarycomponentusecase
+
+import (
+	"context"
+
+	payrolldomain "github.com/smart-hmm/smart-hmm/internal/modules/payroll/domain"
+	"github.com/smart-hmm/smart-hmm/internal/modules/salary_component/domain"
+	salarycomponentrepository "github.com/smart-hmm/smart-hmm/internal/modules/salary_component/repository"
+)
+
+type UpdateSalaryComponentUsecase struct {
+	repo salarycomponentrepository.SalaryComponentRepository
+}
+
+func NewUpdateSalaryComponentUsecase(repo salarycomponentrepository.SalaryComponentRepository) *UpdateSalaryComponentUsecase {
+	return &UpdateSalaryComponentUsecase{repo: repo}
+}
+
+type UpdateSalaryComponentInput struct {
+	ID        string
+	Name      string
+	Kind      domain.ComponentKind
+	Taxable   bool
+	Formula   string
+	RunTypes  []payrolldomain.RunType
+	SortOrder int
+	IsActive  bool
+}
+
+func (uc *UpdateSalaryComponentUsecase) Execute(
+	ctx context.Context,
+	in UpdateSalaryComponentInput,
+) (*domain.SalaryComponent, error) {
+	component, err := uc.repo.GetByID(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := component.Update(in.Name, in.Kind, in.Taxable, in.Formula, in.RunTypes, in.SortOrder, in.IsActive); err != nil {
+		return nil, err
+	}
+
+	// Components that build on this one must still find it.
+	components, err := uc.repo.ListByTenant(ctx, component.TenantID, false)
+	if err != nil {
+		return nil, err
+	}
+	for i, c := range components {
+		if c.ID == component.ID {
+			components[i] = component
+		}
+	}
+	if err := domain.ValidateFormulas(components); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Update(ctx, component); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
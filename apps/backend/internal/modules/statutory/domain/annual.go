@@ -0,0 +1,31 @@
+package domain
+
+// AnnualInput describes one employee's year of pay with one employer.
+type AnnualInput struct {
+	Year int
+
+	// TaxableIncome is the year's gross pay subject to income tax.
+	TaxableIncome float64
+	// Contributions are the employee's compulsory insurance for the year.
+	Contributions float64
+
+	Dependents int
+	// DependentMonths sums, over the months of the year, the dependents
+	// registered in each.
+	DependentMonths int
+
+	// MonthsEmployed counts the months the employer paid salary in.
+	MonthsEmployed int
+	// EmployedAtYearEnd is false for employees who left during the year.
+	EmployedAtYearEnd bool
+}
+
+// AnnualResult is the tax due for the year.
+type AnnualResult struct {
+	TaxDue float64
+
+	// EmployerSettles reports whether the employer finalizes the tax for
+	// the employee. Otherwise the employee files their own return with
+	// the withholding certificate.
+	EmployerSettles bool
+}
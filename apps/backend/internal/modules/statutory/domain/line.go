@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+type LineKind string
+
+const (
+	// EmployeeDeduction is withheld from the employee's pay.
+	EmployeeDeduction LineKind = "EMPLOYEE_DEDUCTION"
+	// EmployerContribution is an employer cost on top of gross pay.
+	EmployerContribution LineKind = "EMPLOYER_CONTRIBUTION"
+)
+
+// Line is one statutory tax or contribution amount.
+type Line struct {
+	Code   string   `json:"code"`
+	Name   string   `json:"name"`
+	Kind   LineKind `json:"kind"`
+	Amount float64  `json:"amount"`
+}
+
+// Input describes one employee's pay for a period.
+type Input struct {
+	// PeriodStart selects the rule version in force.
+	PeriodStart time.Time
+
+	// TaxableIncome is gross pay subject to income tax.
+	TaxableIncome float64
+
+	// ContributionSalary is the basis for insurance contributions.
+	ContributionSalary float64
+
+	WageRegion int
+	Dependents int
+
+	// OffCycle marks one-off pay outside the monthly salary, such as a
+	// bonus. It carries no contributions, and income tax is withheld at
+	// the marginal rate on top of the pay described by the Prior fields.
+	OffCycle bool
+
+	// PriorTaxableIncome and PriorContributionSalary describe the regular
+	// salary of the same period, for off-cycle pay.
+	PriorTaxableIncome      float64
+	PriorContributionSalary float64
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidEmployeeID       = errors.New("employeeID is required")
+	ErrInvalidWageRegion       = errors.New("wage region must be between 1 and 4")
+	ErrNegativeDependents      = errors.New("dependents cannot be negative")
+	ErrNegativeInsuranceSalary = errors.New("insurance salary cannot be negative")
+	ErrNotHR                   = errors.New("only HR can change statutory profiles; employees request a tax code change for review")
+	ErrTaxCode                 = errors.New("tax code must be 10 or 13 digits, or 10 digits, a dash and 3 digits")
+)
+
+// taxCodePattern accepts Vietnamese personal tax codes, with or without
+// the 3-digit suffix, and 13-digit Thai tax IDs.
+var taxCodePattern = regexp.MustCompile(`^[0-9]{10}([0-9]{3}|-[0-9]{3})?$`)
+
+// NormalizeTaxCode trims the code and checks its format.
+func NormalizeTaxCode(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if !taxCodePattern.MatchString(code) {
+		return "", ErrTaxCode
+	}
+	return code, nil
+}
+
+// EmployeeProfile holds the per-employee inputs statutory rules need
+// beyond what the employee record already carries.
+type EmployeeProfile struct {
+	EmployeeID string `json:"employeeId"`
+
+	// WageRegion is the regional minimum wage zone (1-4) of the workplace.
+	WageRegion int `json:"wageRegion"`
+
+	// Dependents is the family allowance count for employees without
+	// dependents in the registry; once any are registered, the registry
+	// decides month by month.
+	Dependents int `json:"dependents"`
+
+	// InsuranceSalary overrides the base salary as the contribution basis.
+	InsuranceSalary *float64 `json:"insuranceSalary,omitempty"`
+
+	// TaxCode is the employee's personal income tax identification number.
+	TaxCode *string `json:"taxCode,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DefaultEmployeeProfile is used for employees without a stored profile.
+func DefaultEmployeeProfile(employeeID string) *EmployeeProfile {
+	now := time.Now().UTC()
+	return &EmployeeProfile{
+		EmployeeID: employeeID,
+		WageRegion: 1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func (p *EmployeeProfile) Update(wageRegion, dependents int, insuranceSalary *float64, taxCode *string) error {
+	if p.EmployeeID == "" {
+		return ErrInvalidEmployeeID
+	}
+	if wageRegion < 1 || wageRegion > 4 {
+		return ErrInvalidWageRegion
+	}
+	if dependents < 0 {
+		return ErrNegativeDependents
+	}
+	if insuranceSalary != nil && *insuranceSalary < 0 {
+		return ErrNegativeInsuranceSalary
+	}
+
+	p.WageRegion = wageRegion
+	p.Dependents = dependents
+	p.InsuranceSalary = insuranceSalary
+	p.TaxCode = taxCode
+	p.UpdatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,15 @@
+package statutoryrepository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+var ErrEmployeeProfileNotFound = errors.New("employee statutory profile not found")
+
+type EmployeeProfileRepository interface {
+	Upsert(ctx context.Context, profile *domain.EmployeeProfile) error
+	GetByEmployeeID(ctx context.Context, employeeID string) (*domain.EmployeeProfile, error)
+}
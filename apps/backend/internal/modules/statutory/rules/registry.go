@@ -0,0 +1,62 @@
+// Package statutoryrules selects the statutory rule pack for a country.
+// Each country lives in its own subpackage and is registered at wiring
+// time, so adding a country never touches the payroll core.
+package statutoryrules
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+// RulePack computes a country's statutory tax and contribution lines
+// from one employee's monthly pay.
+type RulePack interface {
+	// Country is the ISO 3166-1 alpha-2 code the pack applies to.
+	Country() string
+	Calculate(in domain.Input) ([]domain.Line, error)
+}
+
+// AnnualFinalizer is implemented by packs that can settle a year of
+// withheld income tax.
+type AnnualFinalizer interface {
+	// IncomeTaxLine is the code of the monthly income tax withholding line.
+	IncomeTaxLine() string
+	// ContributionLines are the codes of the employee insurance lines,
+	// which reduce taxable income.
+	ContributionLines() []string
+	FinalizeYear(in domain.AnnualInput) (domain.AnnualResult, error)
+}
+
+type Registry struct {
+	packs map[string]RulePack
+}
+
+func NewRegistry(packs ...RulePack) *Registry {
+	r := &Registry{packs: map[string]RulePack{}}
+	for _, p := range packs {
+		r.Register(p)
+	}
+	return r
+}
+
+// Register adds a pack, replacing any pack already registered for the country.
+func (r *Registry) Register(p RulePack) {
+	r.packs[strings.ToUpper(p.Country())] = p
+}
+
+func (r *Registry) Lookup(country string) (RulePack, bool) {
+	p, ok := r.packs[strings.ToUpper(country)]
+	return p, ok
+}
+
+// Countries lists the registered country codes in order.
+func (r *Registry) Countries() []string {
+	codes := make([]string, 0, len(r.packs))
+	for code := range r.packs {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -0,0 +1,22 @@
+package thrules
+
+import (
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+// FinalizeYear computes the tax due on a year of employment income with
+// the rule set in force at year end. Thai employees file their own return
+// with the employer's withholding certificate, so the employer never
+// settles the difference.
+func FinalizeYear(in domain.AnnualInput) (domain.AnnualResult, error) {
+	rules, err := RuleSetAt(date(in.Year, time.December, 31))
+	if err != nil {
+		return domain.AnnualResult{}, err
+	}
+
+	return domain.AnnualResult{
+		TaxDue: round(annualTax(in.TaxableIncome, in.Contributions, in.Dependents, rules)),
+	}, nil
+}
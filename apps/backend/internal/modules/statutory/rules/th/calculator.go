@@ -0,0 +1,105 @@
+package thrules
+
+import (
+	"math"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+const (
+	LineSocialSecurity         = "th_sso"
+	LineEmployerSocialSecurity = "th_sso_employer"
+	LinePersonalIncomeTax      = "th_pit"
+)
+
+// Calculate returns the employee deductions and employer contributions
+// for one month of salary. Income tax is withheld as one twelfth of the
+// tax due on the annualised monthly income. Off-cycle pay only carries
+// income tax.
+func Calculate(in domain.Input) ([]domain.Line, error) {
+	rules, err := RuleSetAt(in.PeriodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.OffCycle {
+		return calculateOffCycle(in, rules), nil
+	}
+
+	wage := contributionWage(in.ContributionSalary, rules)
+	sso := round(wage * rules.SocialSecurityEmployee)
+
+	pit := round(annualTax(in.TaxableIncome*12, sso*12, in.Dependents, rules) / 12)
+
+	lines := []domain.Line{
+		{Code: LineSocialSecurity, Name: "Social security", Kind: domain.EmployeeDeduction, Amount: sso},
+		{Code: LinePersonalIncomeTax, Name: "Personal income tax", Kind: domain.EmployeeDeduction, Amount: pit},
+		{Code: LineEmployerSocialSecurity, Name: "Employer social security", Kind: domain.EmployerContribution, Amount: round(wage * rules.SocialSecurityEmployer)},
+	}
+
+	return lines, nil
+}
+
+// calculateOffCycle withholds the full increase in annual tax that the
+// one-off pay causes on top of the annualised regular salary.
+func calculateOffCycle(in domain.Input, rules *RuleSet) []domain.Line {
+	sso := round(contributionWage(in.PriorContributionSalary, rules) * rules.SocialSecurityEmployee)
+	annual := in.PriorTaxableIncome * 12
+
+	pit := annualTax(annual+in.TaxableIncome, sso*12, in.Dependents, rules) -
+		annualTax(annual, sso*12, in.Dependents, rules)
+
+	return []domain.Line{
+		{Code: LinePersonalIncomeTax, Name: "Personal income tax", Kind: domain.EmployeeDeduction, Amount: round(pit)},
+	}
+}
+
+// contributionWage clamps the salary to the social security floor and cap.
+func contributionWage(salary float64, rules *RuleSet) float64 {
+	if salary <= 0 {
+		return 0
+	}
+	return math.Min(math.Max(salary, rules.SocialSecurityWageFloor), rules.SocialSecurityWageCap)
+}
+
+// annualTax is the tax due on an annual income after the expense
+// deduction, allowances and the year's social security.
+func annualTax(annualIncome, annualSSO float64, dependents int, rules *RuleSet) float64 {
+	expenses := math.Min(annualIncome*rules.ExpenseDeductionRate, rules.ExpenseDeductionCap)
+	netIncome := annualIncome - expenses -
+		rules.PersonalAllowance -
+		float64(dependents)*rules.ChildAllowance -
+		annualSSO
+
+	return progressiveTax(netIncome, rules.Brackets)
+}
+
+func progressiveTax(taxable float64, brackets []Bracket) float64 {
+	tax := 0.0
+	lower := 0.0
+
+	for _, b := range brackets {
+		if taxable <= lower {
+			break
+		}
+
+		upper := taxable
+		if b.UpTo > 0 {
+			upper = math.Min(taxable, b.UpTo)
+		}
+
+		tax += (upper - lower) * b.Rate
+		lower = b.UpTo
+
+		if b.UpTo == 0 {
+			break
+		}
+	}
+
+	return tax
+}
+
+// round rounds to two decimal places (satang).
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,101 @@
+package thrules
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+type calculateCase struct {
+	Name  string        `json:"name"`
+	Input domain.Input  `json:"input"`
+	Lines []domain.Line `json:"lines,omitempty"`
+	Error string        `json:"error,omitempty"`
+}
+
+type finalizeCase struct {
+	Name   string              `json:"name"`
+	Input  domain.AnnualInput  `json:"input"`
+	Result domain.AnnualResult `json:"result"`
+	Error  string              `json:"error,omitempty"`
+}
+
+func TestCalculateGolden(t *testing.T) {
+	mar2025 := date(2025, time.March, 1)
+	mar2026 := date(2026, time.March, 1)
+
+	cases := []calculateCase{
+		{Name: "below threshold", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 20_000, ContributionSalary: 20_000}},
+		{Name: "middle income", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 50_000, ContributionSalary: 50_000}},
+		{Name: "two children", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 50_000, ContributionSalary: 50_000, Dependents: 2}},
+		{Name: "top bracket", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 500_000, ContributionSalary: 500_000}},
+		{Name: "social security floor", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 1_000, ContributionSalary: 1_000}},
+		{Name: "2026 wage cap", Input: domain.Input{PeriodStart: mar2026, TaxableIncome: 50_000, ContributionSalary: 50_000}},
+		{Name: "off-cycle bonus", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 100_000, OffCycle: true, PriorTaxableIncome: 50_000, PriorContributionSalary: 50_000}},
+		{Name: "before first rule set", Input: domain.Input{PeriodStart: date(2016, time.January, 1), TaxableIncome: 50_000, ContributionSalary: 50_000}},
+	}
+
+	for i, c := range cases {
+		lines, err := Calculate(c.Input)
+		cases[i].Lines = lines
+		if err != nil {
+			cases[i].Error = err.Error()
+		}
+	}
+
+	checkGolden(t, "calculate.golden.json", cases)
+}
+
+func TestFinalizeYearGolden(t *testing.T) {
+	cases := []finalizeCase{
+		{Name: "full year", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 600_000, Contributions: 9_000, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "one child", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 600_000, Contributions: 9_000, Dependents: 1, DependentMonths: 12, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "high income", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 6_000_000, Contributions: 9_000, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "below threshold", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 240_000, Contributions: 9_000, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+	}
+
+	for i, c := range cases {
+		result, err := FinalizeYear(c.Input)
+		cases[i].Result = result
+		if err != nil {
+			cases[i].Error = err.Error()
+		}
+	}
+
+	checkGolden(t, "finalize_year.golden.json", cases)
+}
+
+// checkGolden compares got with testdata/name, or rewrites the file when
+// the tests run with -update.
+func checkGolden(t *testing.T, name string, got any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s (run with -update to create it): %v", path, err)
+	}
+	if string(want) != string(data) {
+		t.Errorf("%s differs from the computed result; run with -update and review the diff.\ngot:\n%s", path, data)
+	}
+}
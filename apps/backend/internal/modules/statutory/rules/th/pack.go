@@ -0,0 +1,22 @@
+package thrules
+
+import "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+
+// Pack registers the Thai rules with a statutory rule registry.
+type Pack struct{}
+
+func (Pack) Country() string { return "TH" }
+
+func (Pack) Calculate(in domain.Input) ([]domain.Line, error) {
+	return Calculate(in)
+}
+
+func (Pack) IncomeTaxLine() string { return LinePersonalIncomeTax }
+
+func (Pack) ContributionLines() []string {
+	return []string{LineSocialSecurity}
+}
+
+func (Pack) FinalizeYear(in domain.AnnualInput) (domain.AnnualResult, error) {
+	return FinalizeYear(in)
+}
@@ -0,0 +1,94 @@
+// Package thrules implements Thai personal income tax withholding and
+// social security contributions for monthly salary.
+package thrules
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var ErrNoRuleSet = errors.New("no Thai payroll rule set in force for period")
+
+// Bracket is one band of the annual progressive PIT schedule. UpTo is the
+// upper bound of the band in annual net income; zero means unbounded.
+type Bracket struct {
+	UpTo float64
+	Rate float64
+}
+
+// RuleSet is the set of statutory parameters in force from EffectiveFrom
+// until the next rule set starts.
+type RuleSet struct {
+	EffectiveFrom time.Time
+
+	// Employment expense deduction: a share of income up to a cap.
+	ExpenseDeductionRate float64
+	ExpenseDeductionCap  float64
+
+	PersonalAllowance float64
+	ChildAllowance    float64
+	Brackets          []Bracket
+
+	// Social security wage basis is clamped to [WageFloor, WageCap].
+	SocialSecurityWageFloor float64
+	SocialSecurityWageCap   float64
+	SocialSecurityEmployee  float64
+	SocialSecurityEmployer  float64
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var annualBrackets = []Bracket{
+	{UpTo: 150_000, Rate: 0},
+	{UpTo: 300_000, Rate: 0.05},
+	{UpTo: 500_000, Rate: 0.10},
+	{UpTo: 750_000, Rate: 0.15},
+	{UpTo: 1_000_000, Rate: 0.20},
+	{UpTo: 2_000_000, Rate: 0.25},
+	{UpTo: 5_000_000, Rate: 0.30},
+	{UpTo: 0, Rate: 0.35},
+}
+
+// ruleSets must stay sorted by EffectiveFrom. Add a new entry whenever a
+// parameter changes instead of editing an existing one.
+var ruleSets = []RuleSet{
+	{
+		EffectiveFrom:           date(2017, time.January, 1),
+		ExpenseDeductionRate:    0.5,
+		ExpenseDeductionCap:     100_000,
+		PersonalAllowance:       60_000,
+		ChildAllowance:          30_000,
+		Brackets:                annualBrackets,
+		SocialSecurityWageFloor: 1_650,
+		SocialSecurityWageCap:   15_000,
+		SocialSecurityEmployee:  0.05,
+		SocialSecurityEmployer:  0.05,
+	},
+	{
+		// Social security wage cap raised for 2026-2028.
+		EffectiveFrom:           date(2026, time.January, 1),
+		ExpenseDeductionRate:    0.5,
+		ExpenseDeductionCap:     100_000,
+		PersonalAllowance:       60_000,
+		ChildAllowance:          30_000,
+		Brackets:                annualBrackets,
+		SocialSecurityWageFloor: 1_650,
+		SocialSecurityWageCap:   17_500,
+		SocialSecurityEmployee:  0.05,
+		SocialSecurityEmployer:  0.05,
+	},
+}
+
+// RuleSetAt returns the rule set in force on the given day.
+func RuleSetAt(at time.Time) (*RuleSet, error) {
+	i := sort.Search(len(ruleSets), func(i int) bool {
+		return ruleSets[i].EffectiveFrom.After(at)
+	})
+	if i == 0 {
+		return nil, ErrNoRuleSet
+	}
+	return &ruleSets[i-1], nil
+}
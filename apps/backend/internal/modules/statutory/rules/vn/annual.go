@@ -0,0 +1,42 @@
+package vnrules
+
+import (
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+// minMonthsForEmployerSettlement is the shortest contract for which the
+// employer may finalize tax on the employee's behalf.
+const minMonthsForEmployerSettlement = 3
+
+// FinalizeYear computes the tax due on a year of employment income with
+// the rule set in force at year end. The personal allowance counts for
+// the whole year, and the dependent allowance for each month a dependent
+// was registered.
+// The employer settles only for employees still employed at year end who
+// worked at least three months; others receive a certificate instead.
+func FinalizeYear(in domain.AnnualInput) (domain.AnnualResult, error) {
+	rules, err := RuleSetAt(date(in.Year, time.December, 31))
+	if err != nil {
+		return domain.AnnualResult{}, err
+	}
+
+	taxable := in.TaxableIncome - in.Contributions -
+		12*rules.PersonalAllowance -
+		float64(in.DependentMonths)*rules.DependentAllowance
+
+	return domain.AnnualResult{
+		TaxDue:          round(progressiveTax(taxable, annualise(rules.Brackets))),
+		EmployerSettles: in.EmployedAtYearEnd && in.MonthsEmployed >= minMonthsForEmployerSettlement,
+	}, nil
+}
+
+// annualise scales the monthly schedule to a year.
+func annualise(brackets []Bracket) []Bracket {
+	annual := make([]Bracket, len(brackets))
+	for i, b := range brackets {
+		annual[i] = Bracket{UpTo: b.UpTo * 12, Rate: b.Rate}
+	}
+	return annual
+}
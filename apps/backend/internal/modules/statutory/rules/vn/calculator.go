@@ -0,0 +1,128 @@
+package vnrules
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+const (
+	LineSocialInsurance               = "vn_si"
+	LineHealthInsurance               = "vn_hi"
+	LineUnemploymentInsurance         = "vn_ui"
+	LineEmployerSocialInsurance       = "vn_si_employer"
+	LineEmployerHealthInsurance       = "vn_hi_employer"
+	LineEmployerUnemploymentInsurance = "vn_ui_employer"
+	LinePersonalIncomeTax             = "vn_pit"
+)
+
+// Calculate returns the employee deductions and employer contributions
+// for one month of salary, or only the income tax for off-cycle pay.
+func Calculate(in domain.Input) ([]domain.Line, error) {
+	rules, err := RuleSetAt(in.PeriodStart)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.OffCycle {
+		return calculateOffCycle(in, rules)
+	}
+
+	si, hi, ui, err := employeeInsurance(in.ContributionSalary, in.WageRegion, rules)
+	if err != nil {
+		return nil, err
+	}
+	siBasis, uiBasis := contributionBases(in.ContributionSalary, rules.RegionalMinimumWages[in.WageRegion], rules)
+
+	taxable := in.TaxableIncome - si - hi - ui -
+		rules.PersonalAllowance -
+		float64(in.Dependents)*rules.DependentAllowance
+
+	lines := []domain.Line{
+		{Code: LineSocialInsurance, Name: "Social insurance", Kind: domain.EmployeeDeduction, Amount: si},
+		{Code: LineHealthInsurance, Name: "Health insurance", Kind: domain.EmployeeDeduction, Amount: hi},
+		{Code: LineUnemploymentInsurance, Name: "Unemployment insurance", Kind: domain.EmployeeDeduction, Amount: ui},
+		{Code: LinePersonalIncomeTax, Name: "Personal income tax", Kind: domain.EmployeeDeduction, Amount: round(progressiveTax(taxable, rules.Brackets))},
+		{Code: LineEmployerSocialInsurance, Name: "Employer social insurance", Kind: domain.EmployerContribution, Amount: round(siBasis * rules.SocialInsurance.Employer)},
+		{Code: LineEmployerHealthInsurance, Name: "Employer health insurance", Kind: domain.EmployerContribution, Amount: round(siBasis * rules.HealthInsurance.Employer)},
+		{Code: LineEmployerUnemploymentInsurance, Name: "Employer unemployment insurance", Kind: domain.EmployerContribution, Amount: round(uiBasis * rules.UnemploymentInsurance.Employer)},
+	}
+
+	return lines, nil
+}
+
+// calculateOffCycle withholds tax on one-off pay as the increase in the
+// month's tax over the regular salary alone. No insurance is due on it.
+func calculateOffCycle(in domain.Input, rules *RuleSet) ([]domain.Line, error) {
+	si, hi, ui, err := employeeInsurance(in.PriorContributionSalary, in.WageRegion, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	prior := in.PriorTaxableIncome - si - hi - ui -
+		rules.PersonalAllowance -
+		float64(in.Dependents)*rules.DependentAllowance
+
+	pit := progressiveTax(prior+in.TaxableIncome, rules.Brackets) - progressiveTax(prior, rules.Brackets)
+
+	return []domain.Line{
+		{Code: LinePersonalIncomeTax, Name: "Personal income tax", Kind: domain.EmployeeDeduction, Amount: round(pit)},
+	}, nil
+}
+
+// contributionBases returns the capped SI/HI and UI bases. SI and HI are
+// capped on the statutory base salary, UI on the regional minimum wage.
+// Contributions never go below the minimum wage.
+func contributionBases(salary, minWage float64, rules *RuleSet) (float64, float64) {
+	if salary > 0 {
+		salary = math.Max(salary, minWage)
+	}
+	return math.Min(salary, rules.StatutoryBaseSalary*rules.CapMultiplier),
+		math.Min(salary, minWage*rules.CapMultiplier)
+}
+
+// employeeInsurance returns the employee's SI, HI and UI on a salary.
+func employeeInsurance(salary float64, wageRegion int, rules *RuleSet) (float64, float64, float64, error) {
+	minWage, ok := rules.RegionalMinimumWages[wageRegion]
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("wage region %d: %w", wageRegion, domain.ErrInvalidWageRegion)
+	}
+
+	siBasis, uiBasis := contributionBases(salary, minWage, rules)
+
+	return round(siBasis * rules.SocialInsurance.Employee),
+		round(siBasis * rules.HealthInsurance.Employee),
+		round(uiBasis * rules.UnemploymentInsurance.Employee),
+		nil
+}
+
+func progressiveTax(taxable float64, brackets []Bracket) float64 {
+	tax := 0.0
+	lower := 0.0
+
+	for _, b := range brackets {
+		if taxable <= lower {
+			break
+		}
+
+		upper := taxable
+		if b.UpTo > 0 {
+			upper = math.Min(taxable, b.UpTo)
+		}
+
+		tax += (upper - lower) * b.Rate
+		lower = b.UpTo
+
+		if b.UpTo == 0 {
+			break
+		}
+	}
+
+	return tax
+}
+
+// round rounds to whole dong.
+func round(v float64) float64 {
+	return math.Round(v)
+}
@@ -0,0 +1,108 @@
+package vnrules
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+type calculateCase struct {
+	Name  string        `json:"name"`
+	Input domain.Input  `json:"input"`
+	Lines []domain.Line `json:"lines,omitempty"`
+	Error string        `json:"error,omitempty"`
+}
+
+type finalizeCase struct {
+	Name   string              `json:"name"`
+	Input  domain.AnnualInput  `json:"input"`
+	Result domain.AnnualResult `json:"result"`
+	Error  string              `json:"error,omitempty"`
+}
+
+func TestCalculateGolden(t *testing.T) {
+	mar2025 := date(2025, time.March, 1)
+	mar2026 := date(2026, time.March, 1)
+
+	cases := []calculateCase{
+		{Name: "below personal allowance", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 10_000_000, ContributionSalary: 10_000_000, WageRegion: 1}},
+		{Name: "third bracket", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 30_000_000, ContributionSalary: 30_000_000, WageRegion: 1}},
+		{Name: "two dependents", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 30_000_000, ContributionSalary: 30_000_000, WageRegion: 1, Dependents: 2}},
+		{Name: "SI and HI capped", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 120_000_000, ContributionSalary: 120_000_000, WageRegion: 1}},
+		{Name: "top bracket", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 200_000_000, ContributionSalary: 50_000_000, WageRegion: 2}},
+		{Name: "floored at regional minimum", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 3_000_000, ContributionSalary: 3_000_000, WageRegion: 4}},
+		{Name: "before July 2024 base salary", Input: domain.Input{PeriodStart: date(2024, time.March, 1), TaxableIncome: 60_000_000, ContributionSalary: 60_000_000, WageRegion: 1}},
+		{Name: "2026 allowances", Input: domain.Input{PeriodStart: mar2026, TaxableIncome: 30_000_000, ContributionSalary: 30_000_000, WageRegion: 1, Dependents: 1}},
+		{Name: "off-cycle bonus", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 20_000_000, OffCycle: true, WageRegion: 1, PriorTaxableIncome: 30_000_000, PriorContributionSalary: 30_000_000}},
+		{Name: "off-cycle without regular pay", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 20_000_000, OffCycle: true, WageRegion: 1}},
+		{Name: "unknown wage region", Input: domain.Input{PeriodStart: mar2025, TaxableIncome: 30_000_000, ContributionSalary: 30_000_000, WageRegion: 5}},
+		{Name: "before first rule set", Input: domain.Input{PeriodStart: date(2020, time.January, 1), TaxableIncome: 30_000_000, ContributionSalary: 30_000_000, WageRegion: 1}},
+	}
+
+	for i, c := range cases {
+		lines, err := Calculate(c.Input)
+		cases[i].Lines = lines
+		if err != nil {
+			cases[i].Error = err.Error()
+		}
+	}
+
+	checkGolden(t, "calculate.golden.json", cases)
+}
+
+func TestFinalizeYearGolden(t *testing.T) {
+	cases := []finalizeCase{
+		{Name: "full year", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 360_000_000, Contributions: 37_800_000, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "one dependent all year", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 360_000_000, Contributions: 37_800_000, Dependents: 1, DependentMonths: 12, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "dependent registered in July", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 360_000_000, Contributions: 37_800_000, Dependents: 1, DependentMonths: 6, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "left during the year", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 180_000_000, Contributions: 18_900_000, MonthsEmployed: 6}},
+		{Name: "too short to settle", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 60_000_000, Contributions: 6_300_000, MonthsEmployed: 2, EmployedAtYearEnd: true}},
+		{Name: "under allowances", Input: domain.AnnualInput{Year: 2025, TaxableIncome: 100_000_000, Contributions: 10_500_000, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+		{Name: "2026 allowances", Input: domain.AnnualInput{Year: 2026, TaxableIncome: 360_000_000, Contributions: 37_800_000, Dependents: 1, DependentMonths: 12, MonthsEmployed: 12, EmployedAtYearEnd: true}},
+	}
+
+	for i, c := range cases {
+		result, err := FinalizeYear(c.Input)
+		cases[i].Result = result
+		if err != nil {
+			cases[i].Error = err.Error()
+		}
+	}
+
+	checkGolden(t, "finalize_year.golden.json", cases)
+}
+
+// checkGolden compares got with testdata/name, or rewrites the file when
+// the tests run with -update.
+func checkGolden(t *testing.T, name string, got any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s (run with -update to create it): %v", path, err)
+	}
+	if string(want) != string(data) {
+		t.Errorf("%s differs from the computed result; run with -update and review the diff.\ngot:\n%s", path, data)
+	}
+}
@@ -0,0 +1,22 @@
+package vnrules
+
+import "github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+
+// Pack registers the Vietnamese rules with a statutory rule registry.
+type Pack struct{}
+
+func (Pack) Country() string { return "VN" }
+
+func (Pack) Calculate(in domain.Input) ([]domain.Line, error) {
+	return Calculate(in)
+}
+
+func (Pack) IncomeTaxLine() string { return LinePersonalIncomeTax }
+
+func (Pack) ContributionLines() []string {
+	return []string{LineSocialInsurance, LineHealthInsurance, LineUnemploymentInsurance}
+}
+
+func (Pack) FinalizeYear(in domain.AnnualInput) (domain.AnnualResult, error) {
+	return FinalizeYear(in)
+}
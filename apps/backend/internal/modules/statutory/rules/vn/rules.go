@@ -0,0 +1,156 @@
+// Package vnrules implements Vietnamese personal income tax and
+// compulsory insurance contributions for monthly salary.
+package vnrules
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var ErrNoRuleSet = errors.New("no Vietnamese payroll rule set in force for period")
+
+// Bracket is one band of the progressive PIT schedule. UpTo is the
+// upper bound of the band in monthly taxable income; zero means unbounded.
+type Bracket struct {
+	UpTo float64
+	Rate float64
+}
+
+// Rates splits a contribution between employee and employer.
+type Rates struct {
+	Employee float64
+	Employer float64
+}
+
+// RuleSet is the set of statutory parameters in force from EffectiveFrom
+// until the next rule set starts.
+type RuleSet struct {
+	EffectiveFrom time.Time
+
+	PersonalAllowance  float64
+	DependentAllowance float64
+	Brackets           []Bracket
+
+	// StatutoryBaseSalary (lương cơ sở) caps social and health insurance.
+	StatutoryBaseSalary float64
+	// RegionalMinimumWages caps unemployment insurance and floors the
+	// contribution salary, indexed by wage region 1-4.
+	RegionalMinimumWages map[int]float64
+	// CapMultiplier applies to both caps above.
+	CapMultiplier float64
+
+	SocialInsurance       Rates
+	HealthInsurance       Rates
+	UnemploymentInsurance Rates
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// monthlyBrackets is the seven-band schedule for employment income.
+var monthlyBrackets = []Bracket{
+	{UpTo: 5_000_000, Rate: 0.05},
+	{UpTo: 10_000_000, Rate: 0.10},
+	{UpTo: 18_000_000, Rate: 0.15},
+	{UpTo: 32_000_000, Rate: 0.20},
+	{UpTo: 52_000_000, Rate: 0.25},
+	{UpTo: 80_000_000, Rate: 0.30},
+	{UpTo: 0, Rate: 0.35},
+}
+
+var (
+	socialInsurance       = Rates{Employee: 0.08, Employer: 0.175}
+	healthInsurance       = Rates{Employee: 0.015, Employer: 0.03}
+	unemploymentInsurance = Rates{Employee: 0.01, Employer: 0.01}
+)
+
+// ruleSets must stay sorted by EffectiveFrom. Add a new entry whenever a
+// parameter changes instead of editing an existing one, so historical
+// periods keep recomputing with the values that applied at the time.
+var ruleSets = []RuleSet{
+	{
+		// Resolution 954/2020, Decree 38/2022.
+		EffectiveFrom:       date(2022, time.July, 1),
+		PersonalAllowance:   11_000_000,
+		DependentAllowance:  4_400_000,
+		Brackets:            monthlyBrackets,
+		StatutoryBaseSalary: 1_490_000,
+		RegionalMinimumWages: map[int]float64{
+			1: 4_680_000,
+			2: 4_160_000,
+			3: 3_640_000,
+			4: 3_250_000,
+		},
+		CapMultiplier:         20,
+		SocialInsurance:       socialInsurance,
+		HealthInsurance:       healthInsurance,
+		UnemploymentInsurance: unemploymentInsurance,
+	},
+	{
+		// Decree 24/2023.
+		EffectiveFrom:       date(2023, time.July, 1),
+		PersonalAllowance:   11_000_000,
+		DependentAllowance:  4_400_000,
+		Brackets:            monthlyBrackets,
+		StatutoryBaseSalary: 1_800_000,
+		RegionalMinimumWages: map[int]float64{
+			1: 4_680_000,
+			2: 4_160_000,
+			3: 3_640_000,
+			4: 3_250_000,
+		},
+		CapMultiplier:         20,
+		SocialInsurance:       socialInsurance,
+		HealthInsurance:       healthInsurance,
+		UnemploymentInsurance: unemploymentInsurance,
+	},
+	{
+		// Decree 73/2024, Decree 74/2024.
+		EffectiveFrom:       date(2024, time.July, 1),
+		PersonalAllowance:   11_000_000,
+		DependentAllowance:  4_400_000,
+		Brackets:            monthlyBrackets,
+		StatutoryBaseSalary: 2_340_000,
+		RegionalMinimumWages: map[int]float64{
+			1: 4_960_000,
+			2: 4_410_000,
+			3: 3_860_000,
+			4: 3_450_000,
+		},
+		CapMultiplier:         20,
+		SocialInsurance:       socialInsurance,
+		HealthInsurance:       healthInsurance,
+		UnemploymentInsurance: unemploymentInsurance,
+	},
+	{
+		// Resolution 110/2025, Decree 293/2025.
+		EffectiveFrom:       date(2026, time.January, 1),
+		PersonalAllowance:   15_500_000,
+		DependentAllowance:  6_200_000,
+		Brackets:            monthlyBrackets,
+		StatutoryBaseSalary: 2_340_000,
+		RegionalMinimumWages: map[int]float64{
+			1: 5_310_000,
+			2: 4_730_000,
+			3: 4_140_000,
+			4: 3_700_000,
+		},
+		CapMultiplier:         20,
+		SocialInsurance:       socialInsurance,
+		HealthInsurance:       healthInsurance,
+		UnemploymentInsurance: unemploymentInsurance,
+	},
+}
+
+// RuleSetAt returns the rule set in force on the given day.
+func RuleSetAt(at time.Time) (*RuleSet, error) {
+	i := sort.Search(len(ruleSets), func(i int) bool {
+		return ruleSets[i].EffectiveFrom.After(at)
+	})
+	if i == 0 {
+		return nil, ErrNoRuleSet
+	}
+	return &ruleSets[i-1], nil
+}
@@ -0,0 +1,26 @@
+package statutoryusecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
+)
+
+type GetEmployeeProfileUsecase struct {
+	repo statutoryrepository.EmployeeProfileRepository
+}
+
+func NewGetEmployeeProfileUsecase(repo statutoryrepository.EmployeeProfileRepository) *GetEmployeeProfileUsecase {
+	return &GetEmployeeProfileUsecase{repo: repo}
+}
+
+// Execute falls back to the default profile when none has been stored.
+func (uc *GetEmployeeProfileUsecase) Execute(ctx context.Context, employeeID string) (*domain.EmployeeProfile, error) {
+	profile, err := uc.repo.GetByEmployeeID(ctx, employeeID)
+	if errors.Is(err, statutoryrepository.ErrEmployeeProfileNotFound) {
+		return domain.DefaultEmployeeProfile(employeeID), nil
+	}
+	return profile, err
+}
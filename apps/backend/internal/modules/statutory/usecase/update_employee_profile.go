@@ -0,0 +1,64 @@
+package statutoryusecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smart-hmm/smart-hmm/internal/modules/statutory/domain"
+	statutoryrepository "github.com/smart-hmm/smart-hmm/internal/modules/statutory/repository"
+	userdomain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+	userrepository "github.com/smart-hmm/smart-hmm/internal/modules/user/repository"
+)
+
+type UpdateEmployeeProfileUsecase struct {
+	repo     statutoryrepository.EmployeeProfileRepository
+	userRepo userrepository.UserRepository
+	getUC    *GetEmployeeProfileUsecase
+}
+
+func NewUpdateEmployeeProfileUsecase(
+	repo statutoryrepository.EmployeeProfileRepository,
+	userRepo userrepository.UserRepository,
+	getUC *GetEmployeeProfileUsecase,
+) *UpdateEmployeeProfileUsecase {
+	return &UpdateEmployeeProfileUsecase{repo: repo, userRepo: userRepo, getUC: getUC}
+}
+
+type UpdateEmployeeProfileInput struct {
+	EmployeeID      string
+	WageRegion      int
+	Dependents      int
+	InsuranceSalary *float64
+	TaxCode         *string
+}
+
+// Execute replaces the employee's statutory profile. Only admins and HR
+// change it; employees request a new tax code through a change request.
+func (uc *UpdateEmployeeProfileUsecase) Execute(
+	ctx context.Context,
+	in UpdateEmployeeProfileInput,
+	userID string,
+) (*domain.EmployeeProfile, error) {
+	user, err := uc.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("load user %s: %w", userID, err)
+	}
+	if user.Role != userdomain.Admin && user.Role != userdomain.HR {
+		return nil, domain.ErrNotHR
+	}
+
+	profile, err := uc.getUC.Execute(ctx, in.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := profile.Update(in.WageRegion, in.Dependents, in.InsuranceSalary, in.TaxCode); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Upsert(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
@@ -1,9 +1,13 @@
 package userrepository
 
-import domain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+import (
+	"context"
+
+	domain "github.com/smart-hmm/smart-hmm/internal/modules/user/domain"
+)
 
 type UserRepository interface {
-	Create(user *domain.User) error
+	Create(ctx context.Context, user *domain.User) error
 	Update(user *domain.User) error
 
 	FindByID(id string) (*domain.User, error)
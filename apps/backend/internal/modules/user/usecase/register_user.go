@@ -32,7 +32,7 @@ func (uc *RegisterUserUsecase) Execute(
 		return err
 	}
 
-	err = uc.repo.Create(newUser)
+	err = uc.repo.Create(ctx, newUser)
 	if err != nil {
 		return err
 	}
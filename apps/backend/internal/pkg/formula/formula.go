@@ -0,0 +1,160 @@
+package formula
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Knetic/govaluate"
+)
+
+var ErrUnknownVariable = errors.New("formula references an unknown variable")
+
+// Functions returns the helpers available to every formula expression.
+func Functions() map[string]govaluate.ExpressionFunction {
+	return map[string]govaluate.ExpressionFunction{
+		"if": func(args ...interface{}) (interface{}, error) {
+			if len(args) != 3 {
+				return nil, fmt.Errorf("if expects 3 args")
+			}
+			cond, ok := args[0].(bool)
+			if !ok {
+				return nil, fmt.Errorf("if condition must be bool")
+			}
+			if cond {
+				return args[1], nil
+			}
+			return args[2], nil
+		},
+		"max": func(args ...interface{}) (interface{}, error) {
+			n, err := numbers("max", 2, args)
+			if err != nil {
+				return nil, err
+			}
+			return math.Max(n[0], n[1]), nil
+		},
+		"min": func(args ...interface{}) (interface{}, error) {
+			n, err := numbers("min", 2, args)
+			if err != nil {
+				return nil, err
+			}
+			return math.Min(n[0], n[1]), nil
+		},
+		"floor": func(args ...interface{}) (interface{}, error) {
+			n, err := numbers("floor", 1, args)
+			if err != nil {
+				return nil, err
+			}
+			return math.Floor(n[0]), nil
+		},
+		"ceil": func(args ...interface{}) (interface{}, error) {
+			n, err := numbers("ceil", 1, args)
+			if err != nil {
+				return nil, err
+			}
+			return math.Ceil(n[0]), nil
+		},
+		"round": func(args ...interface{}) (interface{}, error) {
+			n, err := numbers("round", 1, args)
+			if err != nil {
+				return nil, err
+			}
+			return math.Round(n[0]), nil
+		},
+	}
+}
+
+// Compile parses an expression with the shared function set.
+func Compile(expression string) (*govaluate.EvaluableExpression, error) {
+	return govaluate.NewEvaluableExpressionWithFunctions(expression, Functions())
+}
+
+// Evaluate compiles and evaluates an expression to a number. A panic in
+// the expression library is returned as an error.
+func Evaluate(expression string, vars map[string]interface{}) (value float64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			value, err = 0, fmt.Errorf("formula %q: %v", expression, r)
+		}
+	}()
+
+	expr, err := Compile(expression)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := expr.Evaluate(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	n, ok := number(result)
+	if !ok {
+		return 0, fmt.Errorf("formula %q did not evaluate to a number", expression)
+	}
+	return n, nil
+}
+
+// Validate compiles the expression and evaluates it once against sample,
+// so unknown variables, wrong argument counts and non-numeric results are
+// caught when the formula is saved rather than during payroll. sample must
+// hold every variable the expression may reference.
+func Validate(expression string, sample map[string]interface{}) error {
+	expr, err := Compile(expression)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range expr.Vars() {
+		if _, ok := sample[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownVariable, name)
+		}
+	}
+
+	_, err = Evaluate(expression, sample)
+	return err
+}
+
+// numbers checks that a function got want numeric arguments.
+func numbers(name string, want int, args []interface{}) ([]float64, error) {
+	if len(args) != want {
+		return nil, fmt.Errorf("%s expects %d args, got %d", name, want, len(args))
+	}
+
+	n := make([]float64, len(args))
+	for i, a := range args {
+		f, ok := number(a)
+		if !ok {
+			return nil, fmt.Errorf("%s expects numeric args", name)
+		}
+		n[i] = f
+	}
+	return n, nil
+}
+
+func number(v interface{}) (float64, bool) {
+	switch v.(type) {
+	case float64, float32, int, int64, json.Number:
+		return ToFloat(v), true
+	}
+	return 0, false
+}
+
+func ToFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case float32:
+		return float64(n)
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case json.Number:
+		f, _ := n.Float64()
+		return f
+	default:
+		return 0
+	}
+}
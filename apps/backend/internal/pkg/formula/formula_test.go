@@ -0,0 +1,89 @@
+package formula
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	vars := map[string]interface{}{
+		"base_salary": 10_000_000.0,
+		"worked_days": 20.0,
+		"flag":        true,
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    float64
+		wantErr bool
+	}{
+		{name: "arithmetic", expr: "base_salary / 2", want: 5_000_000},
+		{name: "max", expr: "max(base_salary, 12000000)", want: 12_000_000},
+		{name: "min", expr: "min(worked_days, 22)", want: 20},
+		{name: "floor", expr: "floor(10.7)", want: 10},
+		{name: "ceil", expr: "ceil(10.2)", want: 11},
+		{name: "round", expr: "round(10.5)", want: 11},
+		{name: "if true", expr: "if(worked_days > 15, 100, 0)", want: 100},
+		{name: "if false", expr: "if(worked_days > 25, 100, 0)", want: 0},
+		{name: "max one arg", expr: "max(base_salary)", wantErr: true},
+		{name: "max three args", expr: "max(1, 2, 3)", wantErr: true},
+		{name: "min one arg", expr: "min(base_salary)", wantErr: true},
+		{name: "round two args", expr: "round(1, 2)", wantErr: true},
+		{name: "max non-numeric", expr: "max(flag, 1)", wantErr: true},
+		{name: "floor string", expr: "floor('a')", wantErr: true},
+		{name: "if two args", expr: "if(flag, 1)", wantErr: true},
+		{name: "boolean result", expr: "worked_days > 1", wantErr: true},
+		{name: "unknown variable", expr: "bonus * 2", wantErr: true},
+		{name: "syntax error", expr: "base_salary *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(tt.expr, vars)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Evaluate(%q) = %v, want error", tt.expr, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Evaluate(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	sample := map[string]interface{}{"base_salary": 10_000_000.0, "lunch_allowance": 1.0}
+
+	tests := []struct {
+		expr    string
+		wantErr bool
+		unknown bool
+	}{
+		{expr: "base_salary * 0.1"},
+		{expr: "max(lunch_allowance, base_salary * 0.05)"},
+		{expr: "base_salry * 0.1", wantErr: true, unknown: true},
+		{expr: "if(base_salary > 0, bonus, 0)", wantErr: true, unknown: true},
+		{expr: "max(base_salary)", wantErr: true},
+		{expr: "floor()", wantErr: true},
+		{expr: "base_salary > 0", wantErr: true},
+		{expr: "base_salary +", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			err := Validate(tt.expr, sample)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if errors.Is(err, ErrUnknownVariable) != tt.unknown {
+				t.Fatalf("Validate(%q) error = %v, want unknown variable %v", tt.expr, err, tt.unknown)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package money
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCurrency = errors.New("currency must be a three-letter ISO 4217 code")
+
+// Currency is an ISO 4217 currency code.
+type Currency string
+
+const DefaultCurrency Currency = "VND"
+
+// Rule is how amounts in a currency are rounded: half away from zero to
+// Scale decimal places.
+type Rule struct {
+	Scale int
+}
+
+// rules lists the currencies tenants can pick during onboarding. Currencies
+// that are not listed round to two decimal places.
+var rules = map[Currency]Rule{
+	"VND": {Scale: 0},
+	"JPY": {Scale: 0},
+	"KRW": {Scale: 0},
+	"IDR": {Scale: 0},
+	"USD": {Scale: 2},
+	"SGD": {Scale: 2},
+	"CNY": {Scale: 2},
+	"INR": {Scale: 2},
+	"THB": {Scale: 2},
+	"PHP": {Scale: 2},
+	"AUD": {Scale: 2},
+	"GBP": {Scale: 2},
+	"EUR": {Scale: 2},
+	"CAD": {Scale: 2},
+	"BRL": {Scale: 2},
+}
+
+var defaultRule = Rule{Scale: 2}
+
+func ParseCurrency(s string) (Currency, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return Currency(s), nil
+}
+
+// RoundingRule returns the rounding rule of the currency.
+func (c Currency) RoundingRule() Rule {
+	if r, ok := rules[c]; ok {
+		return r
+	}
+	return defaultRule
+}
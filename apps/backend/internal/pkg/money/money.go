@@ -0,0 +1,344 @@
+// Package money is an exact fixed-point amount tagged with its currency.
+//
+// Amounts are held as an integer number of 1/10000 units, so sums never
+// drift. Conversions from float64, such as formula results, are rounded
+// with the currency's rule.
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// precision is the number of decimal places held internally. It must be
+// at least the largest currency scale.
+const precision = 4
+
+var pow10 = [...]int64{1, 10, 100, 1_000, 10_000}
+
+var (
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+	ErrInvalidAmount    = errors.New("money: invalid amount")
+	ErrOverflow         = errors.New("money: amount out of range")
+)
+
+type Money struct {
+	units    int64
+	currency Currency
+}
+
+func Zero(c Currency) Money {
+	return Money{currency: c}
+}
+
+// maxFloat bounds the float amounts FromFloat accepts, keeping their
+// units well inside int64.
+const maxFloat = 1e14
+
+// FromFloat converts v and rounds it with the currency's rule. NaN and
+// infinities, such as a formula dividing by zero, are ErrInvalidAmount;
+// amounts beyond ±1e14 are ErrOverflow.
+func FromFloat(v float64, c Currency) (Money, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return Money{}, ErrInvalidAmount
+	}
+	if math.Abs(v) > maxFloat {
+		return Money{}, ErrOverflow
+	}
+
+	scale := c.RoundingRule().Scale
+	rounded := math.Round(v * float64(pow10[scale]))
+	return Money{units: int64(rounded) * pow10[precision-scale], currency: c}, nil
+}
+
+// Parse reads a decimal string such as "-1234.5". Digits beyond the
+// internal precision are rounded half away from zero.
+func Parse(s string, c Currency) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Money{}, ErrInvalidAmount
+	}
+
+	neg := false
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return Money{}, ErrInvalidAmount
+	}
+	if intPart == "" {
+		intPart = "0"
+	}
+
+	roundUp := false
+	if len(fracPart) > precision {
+		roundUp = fracPart[precision] >= '5'
+		fracPart = fracPart[:precision]
+	}
+	fracPart += strings.Repeat("0", precision-len(fracPart))
+
+	units, err := strconv.ParseUint(intPart+fracPart, 10, 63)
+	if err != nil {
+		var numErr *strconv.NumError
+		if errors.As(err, &numErr) && numErr.Err == strconv.ErrRange {
+			return Money{}, ErrOverflow
+		}
+		return Money{}, ErrInvalidAmount
+	}
+
+	n := int64(units)
+	if roundUp {
+		n++
+	}
+	if neg {
+		n = -n
+	}
+
+	return Money{units: n, currency: c}, nil
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// WithCurrency returns m tagged with c. It is used after reading a bare
+// amount whose currency is stored separately.
+func (m Money) WithCurrency(c Currency) Money {
+	m.currency = c
+	return m
+}
+
+func (m Money) IsZero() bool {
+	return m.units == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.units < 0
+}
+
+// Cmp compares the amounts of m and o, ignoring currency.
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m.units < o.units:
+		return -1
+	case m.units > o.units:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) Neg() Money {
+	m.units = -m.units
+	return m
+}
+
+// Add returns m+o. An untagged operand takes the other's currency.
+func (m Money) Add(o Money) (Money, error) {
+	c, err := m.commonCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+
+	sum := m.units + o.units
+	if (o.units > 0 && sum < m.units) || (o.units < 0 && sum > m.units) {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{units: sum, currency: c}, nil
+}
+
+// MulRat returns m*num/den rounded half away from zero with the
+// currency's rule, computed exactly.
+func (m Money) MulRat(num, den int64) (Money, error) {
+	if den == 0 {
+		return Money{}, ErrInvalidAmount
+	}
+	if den < 0 {
+		num, den = -num, -den
+	}
+
+	step := big.NewInt(pow10[precision-m.currency.RoundingRule().Scale])
+
+	n := new(big.Int).Mul(big.NewInt(m.units), big.NewInt(num))
+	d := new(big.Int).Mul(big.NewInt(den), step)
+
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Abs(r).Lsh(r, 1).Cmp(d) >= 0 {
+		q.Add(q, big.NewInt(int64(n.Sign())))
+	}
+
+	q.Mul(q, step)
+	if !q.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{units: q.Int64(), currency: m.currency}, nil
+}
+
+// Sub returns m-o. An untagged operand takes the other's currency.
+func (m Money) Sub(o Money) (Money, error) {
+	return m.Add(o.Neg())
+}
+
+func (m Money) commonCurrency(o Money) (Currency, error) {
+	switch {
+	case m.currency == "":
+		return o.currency, nil
+	case o.currency == "" || o.currency == m.currency:
+		return m.currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, o.currency)
+}
+
+// Round rounds m half away from zero with the currency's rule.
+func (m Money) Round() Money {
+	step := pow10[precision-m.currency.RoundingRule().Scale]
+	if step == 1 {
+		return m
+	}
+
+	q, r := m.units/step, m.units%step
+	if 2*abs(r) >= step {
+		if m.units < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	m.units = q * step
+	return m
+}
+
+// Float64 is for formula evaluation and statutory rates only; never sum
+// the result.
+func (m Money) Float64() float64 {
+	return float64(m.units) / float64(pow10[precision])
+}
+
+// String formats the amount with at least the currency's scale and no
+// trailing zeros beyond it.
+func (m Money) String() string {
+	units := m.units
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+
+	intPart := units / pow10[precision]
+	frac := fmt.Sprintf("%0*d", precision, units%pow10[precision])
+
+	minDigits := m.currency.RoundingRule().Scale
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) < minDigits {
+		frac += strings.Repeat("0", minDigits-len(frac))
+	}
+
+	if frac == "" {
+		return fmt.Sprintf("%s%d", sign, intPart)
+	}
+	return fmt.Sprintf("%s%d.%s", sign, intPart, frac)
+}
+
+// jsonMoney carries the amount as a string so clients never parse it
+// into a float. Decoding also accepts a JSON number.
+type jsonMoney struct {
+	Amount   json.Number `json:"amount"`
+	Currency Currency    `json:"currency"`
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string   `json:"amount"`
+		Currency Currency `json:"currency"`
+	}{
+		Amount:   m.String(),
+		Currency: m.currency,
+	})
+}
+
+// UnmarshalJSON accepts {"amount": ..., "currency": ...} as well as a bare
+// number, which leaves the currency unset.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '{' {
+		var v jsonMoney
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		parsed, err := Parse(v.Amount.String(), v.Currency)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	parsed, err := Parse(n.String(), "")
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value stores the amount as a decimal string for NUMERIC columns. The
+// currency is stored in its own column.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan reads a NUMERIC column. The currency is left unset; callers tag it
+// with WithCurrency.
+func (m *Money) Scan(src any) error {
+	var s string
+
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Errorf("money: cannot scan %T", src)
+	}
+
+	parsed, err := Parse(s, m.currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,200 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        float64
+		currency Currency
+		want     string
+		wantErr  error
+	}{
+		{name: "VND rounds to units", v: 1234.5, currency: "VND", want: "1235"},
+		{name: "VND negative half", v: -1234.5, currency: "VND", want: "-1235"},
+		{name: "USD rounds to cents", v: 10.005, currency: "USD", want: "10.01"},
+		{name: "USD keeps two places", v: 10, currency: "USD", want: "10.00"},
+		{name: "third of a salary", v: 10_000_000.0 / 3, currency: "VND", want: "3333333"},
+		{name: "large VND", v: 1e14, currency: "VND", want: "100000000000000"},
+		{name: "NaN", v: math.NaN(), currency: "VND", wantErr: ErrInvalidAmount},
+		{name: "positive infinity", v: math.Inf(1), currency: "VND", wantErr: ErrInvalidAmount},
+		{name: "negative infinity", v: math.Inf(-1), currency: "USD", wantErr: ErrInvalidAmount},
+		{name: "overflow", v: 1e16, currency: "VND", wantErr: ErrOverflow},
+		{name: "negative overflow", v: -1e16, currency: "USD", wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromFloat(tt.v, tt.currency)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FromFloat(%v) error = %v, want %v", tt.v, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("FromFloat(%v) = %s, want %s", tt.v, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{in: "1234.5", want: "1234.50"},
+		{in: "-0.25", want: "-0.25"},
+		{in: "+7", want: "7.00"},
+		{in: ".5", want: "0.50"},
+		{in: "1.23456", want: "1.2346"},
+		{in: "1.23454", want: "1.2345"},
+		{in: "", wantErr: ErrInvalidAmount},
+		{in: "-", wantErr: ErrInvalidAmount},
+		{in: "1.2.3", wantErr: ErrInvalidAmount},
+		{in: "abc", wantErr: ErrInvalidAmount},
+		{in: "99999999999999999999", wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Parse(tt.in, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.in, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in       string
+		currency Currency
+		want     string
+	}{
+		{in: "1234.5", currency: "VND", want: "1235"},
+		{in: "1234.4999", currency: "VND", want: "1234"},
+		{in: "-1234.5", currency: "VND", want: "-1235"},
+		{in: "10.005", currency: "USD", want: "10.01"},
+		{in: "10.0049", currency: "USD", want: "10.00"},
+		{in: "-10.005", currency: "USD", want: "-10.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.currency)+" "+tt.in, func(t *testing.T) {
+			m, err := Parse(tt.in, tt.currency)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := m.Round().String(); got != tt.want {
+				t.Fatalf("Round(%s) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	max := Money{units: math.MaxInt64, currency: "VND"}
+
+	tests := []struct {
+		name    string
+		a, b    Money
+		want    string
+		wantErr error
+	}{
+		{name: "exact tenths", a: mustParse(t, "0.1", "USD"), b: mustParse(t, "0.2", "USD"), want: "0.30"},
+		{name: "untagged takes currency", a: mustParse(t, "1", ""), b: mustParse(t, "2", "VND"), want: "3"},
+		{name: "currency mismatch", a: mustParse(t, "1", "USD"), b: mustParse(t, "1", "VND"), wantErr: ErrCurrencyMismatch},
+		{name: "overflow", a: max, b: mustParse(t, "1", "VND"), wantErr: ErrOverflow},
+		{name: "negative overflow", a: max.Neg(), b: mustParse(t, "-1", "VND"), wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Add(tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Add() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("Add() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMulRat(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		currency Currency
+		num, den int64
+		want     string
+		wantErr  error
+	}{
+		{name: "13th month pro-rated", in: "30000000", currency: "VND", num: 7, den: 12, want: "17500000"},
+		{name: "thirds round half up", in: "10000000", currency: "VND", num: 1, den: 3, want: "3333333"},
+		{name: "bonus multiplier", in: "12345678", currency: "VND", num: 1_500, den: 1_000, want: "18518517"},
+		{name: "half rounds away from zero", in: "1", currency: "VND", num: 1, den: 2, want: "1"},
+		{name: "negative half", in: "-1", currency: "VND", num: 1, den: 2, want: "-1"},
+		{name: "negative denominator", in: "10.00", currency: "USD", num: 1, den: -3, want: "-3.33"},
+		{name: "cents", in: "1000.01", currency: "USD", num: 2_500, den: 1_000, want: "2500.03"},
+		{name: "zero denominator", in: "1", currency: "VND", num: 1, den: 0, wantErr: ErrInvalidAmount},
+		{name: "overflow", in: "900000000000000", currency: "VND", num: 999_999, den: 1_000, wantErr: ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mustParse(t, tt.in, tt.currency).MulRat(tt.num, tt.den)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MulRat() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Fatalf("MulRat() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	m := mustParse(t, "1500.5", "USD")
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"amount":"1500.50","currency":"USD"}` {
+		t.Fatalf("Marshal = %s", data)
+	}
+
+	var back Money
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back != m {
+		t.Fatalf("round trip = %+v, want %+v", back, m)
+	}
+
+	var bare Money
+	if err := json.Unmarshal([]byte(`12.5`), &bare); err != nil {
+		t.Fatal(err)
+	}
+	if bare.String() != "12.50" || bare.Currency() != "" {
+		t.Fatalf("bare number = %+v", bare)
+	}
+}
+
+func mustParse(t *testing.T, s string, c Currency) Money {
+	t.Helper()
+	m, err := Parse(s, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
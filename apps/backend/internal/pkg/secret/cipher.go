@@ -0,0 +1,65 @@
+// Package secret encrypts sensitive columns at rest with AES-256-GCM.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// Cipher seals values with a random nonce prefixed to the ciphertext, so
+// equal plaintexts never produce equal column values.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher takes a base64 encoded 32 byte key.
+func NewCipher(key string) (*Cipher, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decode encryption key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (c *Cipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	size := c.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, ErrInvalidCiphertext
+	}
+
+	plaintext, err := c.aead.Open(nil, ciphertext[:size], ciphertext[size:], nil)
+	if err != nil {
+		return nil, ErrInvalidCiphertext
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,130 @@
+// Package spreadsheet reads tabular uploads and writes tabular exports.
+// Every format comes back as rows of trimmed cell text with the header
+// first.
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+	// JSON is only written, as an array of objects keyed by the header.
+	JSON Format = "json"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unsupported spreadsheet format")
+	ErrEmpty         = errors.New("spreadsheet has no rows")
+)
+
+// FormatOf picks the format from the file extension.
+func FormatOf(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(path.Ext(name), ".")) {
+	case "csv":
+		return CSV, nil
+	case "xlsx":
+		return XLSX, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+// ParseFormat reads a format name such as "csv".
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case CSV, XLSX, JSON:
+		return f, nil
+	default:
+		return "", ErrUnknownFormat
+	}
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case JSON:
+		return "application/json"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Read returns the rows of a CSV file or of the first worksheet of a
+// workbook. XLSX cells are read raw, so dates come back as Excel serial
+// numbers rather than in the display format of the sheet.
+func Read(format Format, r io.Reader) ([][]string, error) {
+	var rows [][]string
+	var err error
+
+	switch format {
+	case CSV:
+		rows, err = readCSV(r)
+	case XLSX:
+		rows, err = readXLSX(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		for i := range row {
+			row[i] = strings.TrimSpace(row[i])
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmpty
+	}
+	return rows, nil
+}
+
+func readCSV(r io.Reader) ([][]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Excel saves UTF-8 CSV with a byte order mark.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	// Spreadsheets exported in locales with a decimal comma use semicolons.
+	if firstLine, _, _ := bytes.Cut(data, []byte("\n")); bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	return reader.ReadAll()
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, ErrEmpty
+	}
+
+	return f.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+}
@@ -0,0 +1,168 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Writer writes rows one at a time so that large exports are not held
+// in memory. Close must be called to complete the file.
+type Writer interface {
+	Write(row []string) error
+	Close() error
+}
+
+// NewWriter starts a file in format on w with the header row.
+func NewWriter(format Format, w io.Writer, header []string) (Writer, error) {
+	switch format {
+	case CSV:
+		return newCSVWriter(w, header)
+	case XLSX:
+		return newXLSXWriter(w, header)
+	case JSON:
+		return newJSONWriter(w, header)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, header []string) (*csvWriter, error) {
+	// Without the byte order mark Excel reads UTF-8 as the ANSI code page.
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (c *csvWriter) Write(row []string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// xlsxWriter builds the workbook with the excelize stream writer, which
+// spills rows to a temporary file. The workbook is only written to w on
+// Close since the zip directory comes last.
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXWriter(w io.Writer, header []string) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+
+	sw, err := f.NewStreamWriter(f.GetSheetName(0))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	xw := &xlsxWriter{out: w, file: f, sw: sw}
+	if err := xw.Write(header); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return xw, nil
+}
+
+func (x *xlsxWriter) Write(row []string) error {
+	x.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(row))
+	for i, v := range row {
+		values[i] = v
+	}
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
+
+type jsonWriter struct {
+	w    *bufio.Writer
+	keys [][]byte
+	rows int
+}
+
+func newJSONWriter(w io.Writer, header []string) (*jsonWriter, error) {
+	keys := make([][]byte, len(header))
+	for i, h := range header {
+		key, err := json.Marshal(h)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	jw := &jsonWriter{w: bufio.NewWriter(w), keys: keys}
+	if _, err := jw.w.WriteString("["); err != nil {
+		return nil, err
+	}
+	return jw, nil
+}
+
+// Write encodes the row as an object with the keys in header order,
+// which encoding a map would not keep. Errors of the buffered writer
+// stick and surface from Close.
+func (j *jsonWriter) Write(row []string) error {
+	if j.rows > 0 {
+		j.w.WriteByte(',')
+	}
+	j.rows++
+
+	j.w.WriteString("\n{")
+	for i, key := range j.keys {
+		if i > 0 {
+			j.w.WriteByte(',')
+		}
+
+		value := ""
+		if i < len(row) {
+			value = row[i]
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		j.w.Write(key)
+		j.w.WriteByte(':')
+		j.w.Write(encoded)
+	}
+	_, err := j.w.WriteString("}")
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	j.w.WriteString("\n]\n")
+	return j.w.Flush()
+}
@@ -20,6 +20,14 @@ type OnboardingEmailData struct {
 	CheckinTime       string
 	PortalURL         string
 	HrEmail           string
+
+	// Tasks are the new hire's own onboarding tasks.
+	Tasks []OnboardingEmailTask
+}
+
+type OnboardingEmailTask struct {
+	Title   string
+	DueDate string
 }
 
 func RenderOnboardingEmail(data OnboardingEmailData) (string, error) {
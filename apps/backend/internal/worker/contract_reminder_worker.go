@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const SendContractRemindersTopic = "send_contract_reminders"
+
+type SendContractRemindersPayload struct {
+	// Day is the reminder date, YYYY-MM-DD; empty means today.
+	Day        string `json:"day,omitempty"`
+	DaysBefore int    `json:"days_before"`
+}
+
+// ContractReminderSender e-mails HR about contract and probation ends
+// coming up within daysBefore days of day.
+type ContractReminderSender interface {
+	Execute(ctx context.Context, day time.Time, daysBefore int) error
+}
+
+type SendContractRemindersWorker struct {
+	sender ContractReminderSender
+}
+
+func NewSendContractRemindersWorker(sender ContractReminderSender) *SendContractRemindersWorker {
+	return &SendContractRemindersWorker{
+		sender: sender,
+	}
+}
+
+func (w *SendContractRemindersWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload SendContractRemindersPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid contract reminder payload:", err)
+		return err
+	}
+
+	if payload.DaysBefore <= 0 {
+		return errors.New("days before must be positive")
+	}
+
+	day := time.Now().UTC()
+	if payload.Day != "" {
+		parsed, err := time.Parse(time.DateOnly, payload.Day)
+		if err != nil {
+			log.Println("invalid contract reminder day:", err)
+			return err
+		}
+		day = parsed
+	}
+
+	log.Println("sending contract reminders for:", day.Format(time.DateOnly))
+
+	if err := w.sender.Execute(ctx, day, payload.DaysBefore); err != nil {
+		log.Println("send contract reminders failed:", err)
+		return err
+	}
+
+	log.Println("contract reminders sent for:", day.Format(time.DateOnly))
+	return nil // ACK
+}
+
+// ScheduleContractReminders publishes a reminder run now and then every
+// interval until ctx is done. Runs only e-mail dates not reminded yet, so
+// several worker instances scheduling at once send nothing twice.
+func ScheduleContractReminders(
+	ctx context.Context,
+	producer queueports.Producer,
+	interval time.Duration,
+	daysBefore int,
+) {
+	publish := func() {
+		data, _ := json.Marshal(SendContractRemindersPayload{DaysBefore: daysBefore})
+		if err := producer.Publish(ctx, SendContractRemindersTopic, queueports.Message{Body: data}); err != nil {
+			log.Println("schedule contract reminders failed:", err)
+		}
+	}
+
+	publish()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			publish()
+		}
+	}
+}
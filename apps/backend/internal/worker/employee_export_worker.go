@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const ExportEmployeesTopic = "export_employees"
+
+type ExportEmployeesPayload struct {
+	ExportID string `json:"export_id"`
+}
+
+// EmployeeExporter writes the file of a pending employee export.
+type EmployeeExporter interface {
+	Execute(ctx context.Context, exportID string) error
+}
+
+type ExportEmployeesWorker struct {
+	exporter EmployeeExporter
+}
+
+func NewExportEmployeesWorker(exporter EmployeeExporter) *ExportEmployeesWorker {
+	return &ExportEmployeesWorker{
+		exporter: exporter,
+	}
+}
+
+func (w *ExportEmployeesWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload ExportEmployeesPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid employee export payload:", err)
+		return err
+	}
+
+	if payload.ExportID == "" {
+		return errors.New("missing export id")
+	}
+
+	log.Println("exporting employees:", payload.ExportID)
+
+	if err := w.exporter.Execute(ctx, payload.ExportID); err != nil {
+		log.Println("export employees failed:", err)
+		return err
+	}
+
+	log.Println("employees exported:", payload.ExportID)
+	return nil // ACK
+}
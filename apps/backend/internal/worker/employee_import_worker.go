@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const ImportEmployeesTopic = "import_employees"
+
+type ImportEmployeesPayload struct {
+	ImportID string `json:"import_id"`
+}
+
+// EmployeeImporter imports the rows of a pending employee import.
+type EmployeeImporter interface {
+	Execute(ctx context.Context, importID string) error
+}
+
+type ImportEmployeesWorker struct {
+	importer EmployeeImporter
+}
+
+func NewImportEmployeesWorker(importer EmployeeImporter) *ImportEmployeesWorker {
+	return &ImportEmployeesWorker{
+		importer: importer,
+	}
+}
+
+func (w *ImportEmployeesWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload ImportEmployeesPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid employee import payload:", err)
+		return err
+	}
+
+	if payload.ImportID == "" {
+		return errors.New("missing import id")
+	}
+
+	log.Println("importing employees:", payload.ImportID)
+
+	if err := w.importer.Execute(ctx, payload.ImportID); err != nil {
+		log.Println("import employees failed:", err)
+		return err
+	}
+
+	log.Println("employees imported:", payload.ImportID)
+	return nil // ACK
+}
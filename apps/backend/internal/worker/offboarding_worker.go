@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const RevokeOffboardedSessionsTopic = "revoke_offboarded_sessions"
+
+type RevokeOffboardedSessionsPayload struct {
+	// Day is the revocation date, YYYY-MM-DD; empty means today.
+	Day string `json:"day,omitempty"`
+}
+
+// OffboardedSessionRevoker logs out the employees whose last working day
+// is on or before day.
+type OffboardedSessionRevoker interface {
+	Execute(ctx context.Context, day time.Time) error
+}
+
+type RevokeOffboardedSessionsWorker struct {
+	revoker OffboardedSessionRevoker
+}
+
+func NewRevokeOffboardedSessionsWorker(revoker OffboardedSessionRevoker) *RevokeOffboardedSessionsWorker {
+	return &RevokeOffboardedSessionsWorker{
+		revoker: revoker,
+	}
+}
+
+func (w *RevokeOffboardedSessionsWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload RevokeOffboardedSessionsPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid session revocation payload:", err)
+		return err
+	}
+
+	day := time.Now().UTC()
+	if payload.Day != "" {
+		parsed, err := time.Parse(time.DateOnly, payload.Day)
+		if err != nil {
+			log.Println("invalid session revocation day:", err)
+			return err
+		}
+		day = parsed
+	}
+
+	if err := w.revoker.Execute(ctx, day); err != nil {
+		log.Println("revoke offboarded sessions failed:", err)
+		return err
+	}
+
+	log.Println("offboarded sessions revoked for:", day.Format(time.DateOnly))
+	return nil // ACK
+}
+
+// ScheduleSessionRevocations publishes a revocation run now and then every
+// interval until ctx is done. Revoked offboardings are claimed, so several
+// worker instances scheduling at once revoke nothing twice.
+func ScheduleSessionRevocations(
+	ctx context.Context,
+	producer queueports.Producer,
+	interval time.Duration,
+) {
+	publish := func() {
+		data, _ := json.Marshal(RevokeOffboardedSessionsPayload{})
+		if err := producer.Publish(ctx, RevokeOffboardedSessionsTopic, queueports.Message{Body: data}); err != nil {
+			log.Println("schedule session revocations failed:", err)
+		}
+	}
+
+	publish()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			publish()
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const CalculatePayrollRunTopic = "calculate_payroll_run"
+
+type CalculatePayrollRunPayload struct {
+	RunID string `json:"run_id"`
+}
+
+// PayrollRunCalculator generates the records of a payroll run.
+type PayrollRunCalculator interface {
+	Execute(ctx context.Context, runID string) error
+}
+
+type CalculatePayrollRunWorker struct {
+	calculator PayrollRunCalculator
+}
+
+func NewCalculatePayrollRunWorker(calculator PayrollRunCalculator) *CalculatePayrollRunWorker {
+	return &CalculatePayrollRunWorker{
+		calculator: calculator,
+	}
+}
+
+func (w *CalculatePayrollRunWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload CalculatePayrollRunPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid payroll run payload:", err)
+		return err
+	}
+
+	if payload.RunID == "" {
+		return errors.New("missing payroll run id")
+	}
+
+	log.Println("calculating payroll run:", payload.RunID)
+
+	if err := w.calculator.Execute(ctx, payload.RunID); err != nil {
+		log.Println("calculate payroll run failed:", err)
+		return err
+	}
+
+	log.Println("payroll run calculated:", payload.RunID)
+	return nil // ACK
+}
@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const GeneratePayslipsTopic = "generate_payslips"
+
+type GeneratePayslipsPayload struct {
+	RunID string `json:"run_id"`
+}
+
+// PayslipGenerator renders and stores the payslips of an approved run.
+type PayslipGenerator interface {
+	Execute(ctx context.Context, runID string) error
+}
+
+type GeneratePayslipsWorker struct {
+	generator PayslipGenerator
+}
+
+func NewGeneratePayslipsWorker(generator PayslipGenerator) *GeneratePayslipsWorker {
+	return &GeneratePayslipsWorker{
+		generator: generator,
+	}
+}
+
+func (w *GeneratePayslipsWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload GeneratePayslipsPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid payslip payload:", err)
+		return err
+	}
+
+	if payload.RunID == "" {
+		return errors.New("missing payroll run id")
+	}
+
+	log.Println("generating payslips:", payload.RunID)
+
+	if err := w.generator.Execute(ctx, payload.RunID); err != nil {
+		log.Println("generate payslips failed:", err)
+		return err
+	}
+
+	log.Println("payslips generated:", payload.RunID)
+	return nil // ACK
+}
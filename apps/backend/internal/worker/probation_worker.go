@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const IssueProbationEvaluationsTopic = "issue_probation_evaluations"
+
+type IssueProbationEvaluationsPayload struct {
+	// Day is the issue date, YYYY-MM-DD; empty means today.
+	Day string `json:"day,omitempty"`
+}
+
+// ProbationEvaluationIssuer issues the manager evaluations of the
+// probations ending soon after day.
+type ProbationEvaluationIssuer interface {
+	Execute(ctx context.Context, day time.Time) error
+}
+
+type IssueProbationEvaluationsWorker struct {
+	issuer ProbationEvaluationIssuer
+}
+
+func NewIssueProbationEvaluationsWorker(issuer ProbationEvaluationIssuer) *IssueProbationEvaluationsWorker {
+	return &IssueProbationEvaluationsWorker{
+		issuer: issuer,
+	}
+}
+
+func (w *IssueProbationEvaluationsWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload IssueProbationEvaluationsPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid probation evaluation payload:", err)
+		return err
+	}
+
+	day := time.Now().UTC()
+	if payload.Day != "" {
+		parsed, err := time.Parse(time.DateOnly, payload.Day)
+		if err != nil {
+			log.Println("invalid probation evaluation day:", err)
+			return err
+		}
+		day = parsed
+	}
+
+	if err := w.issuer.Execute(ctx, day); err != nil {
+		log.Println("issue probation evaluations failed:", err)
+		return err
+	}
+
+	log.Println("probation evaluations issued for:", day.Format(time.DateOnly))
+	return nil // ACK
+}
+
+// ScheduleProbationEvaluations publishes an issue run now and then every
+// interval until ctx is done. A probation end gets one evaluation, so
+// several worker instances scheduling at once issue nothing twice.
+func ScheduleProbationEvaluations(
+	ctx context.Context,
+	producer queueports.Producer,
+	interval time.Duration,
+) {
+	publish := func() {
+		data, _ := json.Marshal(IssueProbationEvaluationsPayload{})
+		if err := producer.Publish(ctx, IssueProbationEvaluationsTopic, queueports.Message{Body: data}); err != nil {
+			log.Println("schedule probation evaluations failed:", err)
+		}
+	}
+
+	publish()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			publish()
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	queueports "github.com/smart-hmm/smart-hmm/internal/interface/core/ports/queue"
+)
+
+const GenerateTaxCertificatesTopic = "generate_tax_certificates"
+
+type GenerateTaxCertificatesPayload struct {
+	TenantID       string `json:"tenant_id"`
+	Year           int    `json:"year"`
+	CompanyTaxCode string `json:"company_tax_code"`
+}
+
+// TaxCertificateGenerator renders and stores the withholding certificates
+// of a tax year.
+type TaxCertificateGenerator interface {
+	Execute(ctx context.Context, tenantID string, year int, companyTaxCode string) error
+}
+
+type GenerateTaxCertificatesWorker struct {
+	generator TaxCertificateGenerator
+}
+
+func NewGenerateTaxCertificatesWorker(generator TaxCertificateGenerator) *GenerateTaxCertificatesWorker {
+	return &GenerateTaxCertificatesWorker{
+		generator: generator,
+	}
+}
+
+func (w *GenerateTaxCertificatesWorker) Handle(
+	ctx context.Context,
+	msg queueports.Message,
+) error {
+	var payload GenerateTaxCertificatesPayload
+
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		log.Println("invalid tax certificate payload:", err)
+		return err
+	}
+
+	if payload.TenantID == "" || payload.Year == 0 {
+		return errors.New("missing tenant id or tax year")
+	}
+
+	log.Println("generating tax certificates:", payload.TenantID, payload.Year)
+
+	if err := w.generator.Execute(ctx, payload.TenantID, payload.Year, payload.CompanyTaxCode); err != nil {
+		log.Println("generate tax certificates failed:", err)
+		return err
+	}
+
+	log.Println("tax certificates generated:", payload.TenantID, payload.Year)
+	return nil // ACK
+}
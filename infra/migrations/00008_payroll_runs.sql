@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE payroll_run_status AS ENUM (
+    'DRAFT',
+    'CALCULATED',
+    'APPROVED',
+    'PAID'
+);
+
+CREATE TABLE IF NOT EXISTS payroll_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    period VARCHAR(7) NOT NULL,
+    -- YYYY-MM
+    status payroll_run_status NOT NULL DEFAULT 'DRAFT',
+    total_employees INT NOT NULL DEFAULT 0,
+    calculated_at TIMESTAMPTZ,
+    approved_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        approved_at TIMESTAMPTZ,
+        paid_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ DEFAULT NOW(),
+        updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payroll_runs_tenant_period ON payroll_runs(tenant_id, period);
+
+ALTER TABLE
+    payroll_records
+ADD
+    COLUMN IF NOT EXISTS run_id UUID REFERENCES payroll_runs(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_payroll_run ON payroll_records(run_id);
+
+-- Records of an approved or paid run are immutable.
+CREATE OR REPLACE FUNCTION prevent_locked_payroll_record_change() RETURNS trigger AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM payroll_runs r
+        WHERE r.id = OLD.run_id
+          AND r.status IN ('APPROVED', 'PAID')
+    ) THEN
+        RAISE EXCEPTION 'payroll record % belongs to a locked payroll run', OLD.id;
+    END IF;
+
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_payroll_records_locked
+BEFORE UPDATE OR DELETE ON payroll_records
+FOR EACH ROW EXECUTE FUNCTION prevent_locked_payroll_record_change();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trg_payroll_records_locked ON payroll_records;
+
+DROP FUNCTION IF EXISTS prevent_locked_payroll_record_change();
+
+DROP INDEX IF EXISTS idx_payroll_run;
+
+ALTER TABLE
+    payroll_records DROP COLUMN IF EXISTS run_id;
+
+DROP INDEX IF EXISTS idx_payroll_runs_tenant_period;
+
+DROP TABLE IF EXISTS payroll_runs;
+
+DROP TYPE IF EXISTS payroll_run_status;
+
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE salary_component_kind AS ENUM (
+    'EARNING',
+    'DEDUCTION'
+);
+
+CREATE TABLE IF NOT EXISTS salary_components (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    kind salary_component_kind NOT NULL,
+    taxable BOOLEAN NOT NULL DEFAULT TRUE,
+    formula TEXT NOT NULL,
+    sort_order INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_salary_components_tenant_code ON salary_components(tenant_id, code);
+
+ALTER TABLE
+    payroll_records
+ADD
+    COLUMN IF NOT EXISTS lines JSONB NOT NULL DEFAULT '[]' :: jsonb;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE
+    payroll_records DROP COLUMN IF EXISTS lines;
+
+DROP INDEX IF EXISTS idx_salary_components_tenant_code;
+
+DROP TABLE IF EXISTS salary_components;
+
+DROP TYPE IF EXISTS salary_component_kind;
+
+-- +goose StatementEnd
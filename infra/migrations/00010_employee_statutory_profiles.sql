@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_statutory_profiles (
+    employee_id UUID PRIMARY KEY REFERENCES employees(id) ON DELETE CASCADE,
+    wage_region SMALLINT NOT NULL DEFAULT 1 CHECK (wage_region BETWEEN 1 AND 4),
+    dependents INT NOT NULL DEFAULT 0 CHECK (dependents >= 0),
+    insurance_salary NUMERIC(12, 2),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_statutory_profiles;
+
+-- +goose StatementEnd
@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE
+    employees
+ADD
+    COLUMN IF NOT EXISTS salary_currency VARCHAR(3) NOT NULL DEFAULT 'VND';
+
+ALTER TABLE
+    employees
+ALTER COLUMN
+    base_salary TYPE NUMERIC(19, 4);
+
+UPDATE
+    employees e
+SET
+    salary_currency = upper(tp.currency)
+FROM
+    tenant_profiles tp
+WHERE
+    tp.tenant_id = e.tenant_id
+    AND length(tp.currency) = 3;
+
+ALTER TABLE
+    payroll_records
+ADD
+    COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'VND';
+
+-- Widening the columns rewrites locked rows; let it through.
+ALTER TABLE
+    payroll_records DISABLE TRIGGER trg_payroll_records_locked;
+
+ALTER TABLE
+    payroll_records
+ALTER COLUMN
+    base_salary TYPE NUMERIC(19, 4),
+ALTER COLUMN
+    net_salary TYPE NUMERIC(19, 4);
+
+UPDATE
+    payroll_records p
+SET
+    currency = e.salary_currency
+FROM
+    employees e
+WHERE
+    e.id = p.employee_id;
+
+ALTER TABLE
+    payroll_records ENABLE TRIGGER trg_payroll_records_locked;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE
+    payroll_records DISABLE TRIGGER trg_payroll_records_locked;
+
+ALTER TABLE
+    payroll_records
+ALTER COLUMN
+    base_salary TYPE NUMERIC(12, 2),
+ALTER COLUMN
+    net_salary TYPE NUMERIC(12, 2);
+
+ALTER TABLE
+    payroll_records DROP COLUMN IF EXISTS currency;
+
+ALTER TABLE
+    payroll_records ENABLE TRIGGER trg_payroll_records_locked;
+
+ALTER TABLE
+    employees
+ALTER COLUMN
+    base_salary TYPE NUMERIC(12, 2);
+
+ALTER TABLE
+    employees DROP COLUMN IF EXISTS salary_currency;
+
+-- +goose StatementEnd
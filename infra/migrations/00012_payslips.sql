@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payslips (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    record_id UUID NOT NULL UNIQUE REFERENCES payroll_records(id) ON DELETE CASCADE,
+    run_id UUID NOT NULL REFERENCES payroll_runs(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    period VARCHAR(7) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('GENERATED', 'FAILED')),
+    storage_path TEXT NOT NULL DEFAULT '',
+    password_protected BOOLEAN NOT NULL DEFAULT FALSE,
+    error TEXT,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payslips_employee_period ON payslips(employee_id, period DESC);
+
+CREATE TABLE IF NOT EXISTS payslip_preferences (
+    employee_id UUID PRIMARY KEY REFERENCES employees(id) ON DELETE CASCADE,
+    password_protected BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payslip_preferences;
+
+DROP TABLE IF EXISTS payslips;
+
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_bank_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    bank_name VARCHAR(255) NOT NULL,
+    bank_code VARCHAR(35) NOT NULL,
+    branch_code VARCHAR(35),
+    account_holder VARCHAR(255) NOT NULL,
+    -- AES-256-GCM ciphertext; the key never reaches the database.
+    account_number_enc BYTEA NOT NULL,
+    account_last4 VARCHAR(4) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_bank_accounts_employee ON employee_bank_accounts(employee_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_employee_bank_accounts_primary ON employee_bank_accounts(employee_id)
+WHERE
+    is_primary;
+
+CREATE TABLE IF NOT EXISTS payroll_bank_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    run_id UUID NOT NULL REFERENCES payroll_runs(id) ON DELETE CASCADE,
+    format VARCHAR(50) NOT NULL,
+    file_name TEXT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    transfer_count INT NOT NULL,
+    total_amount NUMERIC(19, 4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_bank_exports_run ON payroll_bank_exports(run_id, created_at DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payroll_bank_exports;
+
+DROP TABLE IF EXISTS employee_bank_accounts;
+
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS payroll_adjustments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    source_record_id UUID NOT NULL REFERENCES payroll_records(id) ON DELETE CASCADE,
+    source_run_id UUID NOT NULL REFERENCES payroll_runs(id) ON DELETE CASCADE,
+    source_period VARCHAR(7) NOT NULL,
+    -- The run paying the adjustment, set when that run is calculated.
+    target_run_id UUID REFERENCES payroll_runs(id) ON DELETE
+    SET
+        NULL,
+        reason TEXT NOT NULL,
+        currency VARCHAR(3) NOT NULL,
+        lines JSONB NOT NULL DEFAULT '[]',
+        net_delta NUMERIC(19, 4) NOT NULL,
+        created_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_adjustments_source_record ON payroll_adjustments(source_record_id);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_adjustments_source_run ON payroll_adjustments(source_run_id);
+
+CREATE INDEX IF NOT EXISTS idx_payroll_adjustments_pending ON payroll_adjustments(tenant_id, source_period)
+WHERE
+    target_run_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_payroll_adjustments_target_run ON payroll_adjustments(target_run_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payroll_adjustments;
+
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_salary_changes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    effective_from DATE NOT NULL,
+    base_salary NUMERIC(19, 4) NOT NULL CHECK (base_salary >= 0),
+    currency VARCHAR(3) NOT NULL,
+    reason VARCHAR(20) NOT NULL CHECK (
+        reason IN ('HIRE', 'PROMOTION', 'MERIT', 'ADJUSTMENT')
+    ),
+    note TEXT,
+    approved_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_salary_changes_employee ON employee_salary_changes(employee_id, effective_from DESC);
+
+-- Seed every employee's history with the salary they have today.
+INSERT INTO
+    employee_salary_changes (
+        employee_id,
+        effective_from,
+        base_salary,
+        currency,
+        reason
+    )
+SELECT
+    id,
+    join_date,
+    COALESCE(base_salary, 0),
+    salary_currency,
+    'HIRE'
+FROM
+    employees;
+
+-- employees.base_salary keeps the hire salary; reads take today's salary
+-- from the history.
+CREATE OR REPLACE VIEW employee_current_salaries AS
+SELECT
+    DISTINCT ON (employee_id) employee_id,
+    base_salary,
+    currency
+FROM
+    employee_salary_changes
+WHERE
+    effective_from <= CURRENT_DATE
+ORDER BY
+    employee_id,
+    effective_from DESC,
+    created_at DESC;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS employee_current_salaries;
+
+DROP TABLE IF EXISTS employee_salary_changes;
+
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE payroll_run_type AS ENUM (
+    'REGULAR',
+    'BONUS',
+    'THIRTEENTH_MONTH',
+    'TERMINATION'
+);
+
+ALTER TABLE
+    payroll_runs
+ADD
+    COLUMN IF NOT EXISTS run_type payroll_run_type NOT NULL DEFAULT 'REGULAR',
+ADD
+    COLUMN IF NOT EXISTS salary_multiplier NUMERIC(6, 3) NOT NULL DEFAULT 1,
+ADD
+    COLUMN IF NOT EXISTS prorate_year INT,
+ADD
+    COLUMN IF NOT EXISTS employee_ids UUID [] NOT NULL DEFAULT '{}';
+
+-- Off-cycle runs may share a period with the regular run and each other.
+DROP INDEX IF EXISTS idx_payroll_runs_tenant_period;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payroll_runs_tenant_period ON payroll_runs(tenant_id, period)
+WHERE
+    run_type = 'REGULAR';
+
+-- Components apply to regular runs unless configured otherwise.
+ALTER TABLE
+    salary_components
+ADD
+    COLUMN IF NOT EXISTS run_types TEXT [] NOT NULL DEFAULT '{REGULAR}';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE
+    salary_components DROP COLUMN IF EXISTS run_types;
+
+DELETE FROM
+    payroll_runs
+WHERE
+    run_type <> 'REGULAR';
+
+DROP INDEX IF EXISTS idx_payroll_runs_tenant_period;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payroll_runs_tenant_period ON payroll_runs(tenant_id, period);
+
+ALTER TABLE
+    payroll_runs DROP COLUMN IF EXISTS employee_ids,
+    DROP COLUMN IF EXISTS prorate_year,
+    DROP COLUMN IF EXISTS salary_multiplier,
+    DROP COLUMN IF EXISTS run_type;
+
+DROP TYPE IF EXISTS payroll_run_type;
+
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE
+    employee_statutory_profiles
+ADD
+    COLUMN IF NOT EXISTS tax_code VARCHAR(20);
+
+CREATE TABLE IF NOT EXISTS tax_certificates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    year INT NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('GENERATED', 'FAILED')),
+    storage_path TEXT NOT NULL DEFAULT '',
+    error TEXT,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (employee_id, year)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tax_certificates_tenant_year ON tax_certificates(tenant_id, year);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tax_certificates;
+
+ALTER TABLE
+    employee_statutory_profiles DROP COLUMN IF EXISTS tax_code;
+
+-- +goose StatementEnd
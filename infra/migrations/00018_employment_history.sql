@@ -0,0 +1,118 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_job_records (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    effective_from DATE NOT NULL,
+    position TEXT NOT NULL,
+    department_id UUID REFERENCES departments(id) ON DELETE
+    SET
+        NULL,
+        manager_id UUID REFERENCES employees(id) ON DELETE
+    SET
+        NULL,
+        employment_type employment_type NOT NULL,
+        employment_status employment_status NOT NULL,
+        reason VARCHAR(20) NOT NULL CHECK (
+            reason IN (
+                'HIRE',
+                'PROMOTION',
+                'TRANSFER',
+                'DEMOTION',
+                'REORGANIZATION',
+                'STATUS_CHANGE',
+                'CORRECTION'
+            )
+        ),
+        note TEXT,
+        changed_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_job_records_employee ON employee_job_records(employee_id, effective_from DESC);
+
+CREATE INDEX IF NOT EXISTS idx_employee_job_records_department ON employee_job_records(department_id, effective_from);
+
+CREATE INDEX IF NOT EXISTS idx_employee_job_records_reason ON employee_job_records(reason, effective_from);
+
+-- Seed every employee's history with the job they hold today.
+INSERT INTO
+    employee_job_records (
+        employee_id,
+        effective_from,
+        position,
+        department_id,
+        manager_id,
+        employment_type,
+        employment_status,
+        reason
+    )
+SELECT
+    id,
+    join_date,
+    position,
+    department_id,
+    manager_id,
+    employment_type,
+    employment_status,
+    'HIRE'
+FROM
+    employees;
+
+-- employees keeps the hire job; reads take today's job from the history
+-- and fall back to the hire job before the first record takes effect.
+CREATE OR REPLACE VIEW employee_current_jobs AS
+SELECT
+    e.id AS employee_id,
+    COALESCE(j.position, e.position) AS position,
+    CASE
+        WHEN j.id IS NULL THEN e.department_id
+        ELSE j.department_id
+    END AS department_id,
+    CASE
+        WHEN j.id IS NULL THEN e.manager_id
+        ELSE j.manager_id
+    END AS manager_id,
+    COALESCE(j.employment_type, e.employment_type) AS employment_type,
+    COALESCE(j.employment_status, e.employment_status) AS employment_status
+FROM
+    employees e
+    LEFT JOIN LATERAL (
+        SELECT
+            *
+        FROM
+            employee_job_records r
+        WHERE
+            r.employee_id = e.id
+            AND r.effective_from <= CURRENT_DATE
+        ORDER BY
+            r.effective_from DESC,
+            r.created_at DESC
+        LIMIT
+            1
+    ) j ON TRUE;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- Keep today's job on the employee once the history is gone.
+UPDATE
+    employees e
+SET
+    position = cj.position,
+    department_id = cj.department_id,
+    manager_id = cj.manager_id,
+    employment_type = cj.employment_type,
+    employment_status = cj.employment_status
+FROM
+    employee_current_jobs cj
+WHERE
+    cj.employee_id = e.id;
+
+DROP VIEW IF EXISTS employee_current_jobs;
+
+DROP TABLE IF EXISTS employee_job_records;
+
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_contracts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    contract_type VARCHAR(20) NOT NULL CHECK (
+        contract_type IN ('INDEFINITE', 'FIXED_TERM', 'PROBATION')
+    ),
+    contract_number TEXT NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    probation_end_date DATE,
+    signed_file_id UUID REFERENCES files(id) ON DELETE
+    SET
+        NULL,
+        renewal_count INT NOT NULL DEFAULT 0 CHECK (renewal_count >= 0),
+        previous_contract_id UUID REFERENCES employee_contracts(id) ON DELETE
+    SET
+        NULL,
+        note TEXT,
+        end_reminder_sent_at TIMESTAMPTZ,
+        probation_reminder_sent_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        CONSTRAINT uq_employee_contracts_number UNIQUE (tenant_id, contract_number),
+        CONSTRAINT uq_employee_contracts_previous UNIQUE (previous_contract_id),
+        CHECK (
+            end_date IS NULL
+            OR end_date > start_date
+        )
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_contracts_employee ON employee_contracts(employee_id, start_date DESC);
+
+CREATE INDEX IF NOT EXISTS idx_employee_contracts_end_reminder ON employee_contracts(end_date)
+WHERE
+    end_reminder_sent_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employee_contracts_probation_reminder ON employee_contracts(probation_end_date)
+WHERE
+    probation_reminder_sent_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_contracts;
+
+-- +goose StatementEnd
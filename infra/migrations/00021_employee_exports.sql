@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    format VARCHAR(10) NOT NULL CHECK (format IN ('csv', 'xlsx', 'json')),
+    columns JSONB NOT NULL DEFAULT '[]',
+    filter JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (
+        status IN ('PENDING', 'RUNNING', 'COMPLETED', 'FAILED')
+    ),
+    row_count INT NOT NULL DEFAULT 0,
+    file_path TEXT,
+    error TEXT,
+    requested_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        started_at TIMESTAMPTZ,
+        finished_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_exports_tenant ON employee_exports(tenant_id, created_at DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_exports;
+
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS custom_field_definitions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    label VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (
+        type IN ('TEXT', 'NUMBER', 'DATE', 'BOOLEAN', 'SELECT')
+    ),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    options JSONB NOT NULL DEFAULT '[]',
+    pattern TEXT,
+    max_length INT,
+    min_value DOUBLE PRECISION,
+    max_value DOUBLE PRECISION,
+    visible_to JSONB NOT NULL DEFAULT '[]',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_custom_field_definitions_key UNIQUE (tenant_id, key)
+);
+
+ALTER TABLE employees
+ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_employees_custom_fields ON employees USING GIN (custom_fields);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_employees_custom_fields;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS custom_fields;
+
+DROP TABLE IF EXISTS custom_field_definitions;
+
+-- +goose StatementEnd
@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS employee_offboardings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    -- Offboarded employees are kept for legal retention; refuse to
+    -- hard-delete them.
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE RESTRICT,
+    last_working_day DATE NOT NULL,
+    reason VARCHAR(30) NOT NULL CHECK (
+        reason IN (
+            'RESIGNATION',
+            'DISMISSAL',
+            'END_OF_CONTRACT',
+            'RETIREMENT',
+            'MUTUAL_AGREEMENT'
+        )
+    ),
+    note TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'IN_PROGRESS' CHECK (status IN ('IN_PROGRESS', 'COMPLETED')),
+    leave_balances JSONB NOT NULL DEFAULT '[]',
+    leave_payout NUMERIC(19, 4) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'VND',
+    sessions_revoked_at TIMESTAMPTZ,
+    initiated_by UUID NOT NULL REFERENCES users(id),
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_employee_offboardings_in_progress ON employee_offboardings(employee_id)
+WHERE
+    status = 'IN_PROGRESS';
+
+CREATE INDEX IF NOT EXISTS idx_employee_offboardings_tenant ON employee_offboardings(tenant_id, last_working_day DESC);
+
+CREATE INDEX IF NOT EXISTS idx_employee_offboardings_revocation ON employee_offboardings(last_working_day)
+WHERE
+    sessions_revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS employee_offboarding_tasks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    offboarding_id UUID NOT NULL REFERENCES employee_offboardings(id) ON DELETE CASCADE,
+    kind VARCHAR(30) NOT NULL CHECK (
+        kind IN (
+            'ASSET_RETURN',
+            'ACCESS_REVOCATION',
+            'FINAL_SETTLEMENT'
+        )
+    ),
+    title TEXT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    done_at TIMESTAMPTZ,
+    done_by UUID REFERENCES users(id),
+    note TEXT
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_offboarding_tasks_offboarding ON employee_offboarding_tasks(offboarding_id, position);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_offboarding_tasks;
+
+DROP TABLE IF EXISTS employee_offboardings;
+
+-- +goose StatementEnd
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS onboarding_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    department_id UUID REFERENCES departments(id) ON DELETE CASCADE,
+    tasks JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One template per department and one default per tenant.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_onboarding_templates_department ON onboarding_templates(
+    tenant_id,
+    COALESCE(department_id, '00000000-0000-0000-0000-000000000000')
+);
+
+CREATE TABLE IF NOT EXISTS onboarding_tasks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    template_id UUID REFERENCES onboarding_templates(id) ON DELETE
+    SET
+        NULL,
+        title TEXT NOT NULL,
+        description TEXT,
+        assignee VARCHAR(20) NOT NULL CHECK (
+            assignee IN ('HR', 'IT', 'MANAGER', 'NEW_HIRE')
+        ),
+        assignee_employee_id UUID REFERENCES employees(id) ON DELETE
+    SET
+        NULL,
+        due_date DATE NOT NULL,
+        status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DONE')),
+        completed_at TIMESTAMPTZ,
+        completed_by UUID REFERENCES users(id),
+        note TEXT,
+        position INT NOT NULL DEFAULT 0,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_onboarding_tasks_employee ON onboarding_tasks(employee_id, position);
+
+CREATE INDEX IF NOT EXISTS idx_onboarding_tasks_open ON onboarding_tasks(tenant_id, due_date)
+WHERE
+    status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_onboarding_tasks_assignee ON onboarding_tasks(assignee_employee_id)
+WHERE
+    assignee_employee_id IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS onboarding_tasks;
+
+DROP TABLE IF EXISTS onboarding_templates;
+
+-- +goose StatementEnd
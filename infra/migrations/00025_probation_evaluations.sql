@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TYPE employment_status
+ADD
+    VALUE IF NOT EXISTS 'PROBATION';
+
+ALTER TABLE
+    employees
+ADD
+    COLUMN IF NOT EXISTS probation_end_date DATE;
+
+CREATE TABLE IF NOT EXISTS probation_evaluations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    evaluator_id UUID REFERENCES employees(id) ON DELETE
+    SET
+        NULL,
+        probation_end_date DATE NOT NULL,
+        status VARCHAR(20) NOT NULL DEFAULT 'PENDING' CHECK (status IN ('PENDING', 'DECIDED')),
+        performance_rating INT CHECK (performance_rating BETWEEN 1 AND 5),
+        conduct_rating INT CHECK (conduct_rating BETWEEN 1 AND 5),
+        comments TEXT,
+        decision VARCHAR(20) CHECK (decision IN ('PASS', 'EXTEND', 'TERMINATE')),
+        extended_until DATE,
+        decided_by UUID REFERENCES users(id),
+        decided_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One evaluation per probation end; an extension gets a new one.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_probation_evaluations_end ON probation_evaluations(employee_id, probation_end_date);
+
+CREATE INDEX IF NOT EXISTS idx_probation_evaluations_pending ON probation_evaluations(tenant_id, probation_end_date)
+WHERE
+    status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_employees_probation_end ON employees(probation_end_date)
+WHERE
+    probation_end_date IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS probation_evaluations;
+
+DROP INDEX IF EXISTS idx_employees_probation_end;
+
+ALTER TABLE
+    employees DROP COLUMN IF EXISTS probation_end_date;
+
+-- Enum values cannot be dropped; probations count as active again.
+UPDATE
+    employee_job_records
+SET
+    employment_status = 'ACTIVE'
+WHERE
+    employment_status = 'PROBATION';
+
+UPDATE
+    employees
+SET
+    employment_status = 'ACTIVE'
+WHERE
+    employment_status = 'PROBATION';
+
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE
+    departments
+ADD
+    COLUMN IF NOT EXISTS parent_id UUID REFERENCES departments(id) ON DELETE RESTRICT,
+ADD
+    COLUMN IF NOT EXISTS cost_center VARCHAR(20);
+
+CREATE INDEX IF NOT EXISTS idx_departments_parent ON departments(parent_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_departments_cost_center ON departments(tenant_id, cost_center)
+WHERE
+    cost_center IS NOT NULL;
+
+-- Every re-parenting, so rollups of past periods use the tree of the time.
+CREATE TABLE IF NOT EXISTS department_moves (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    department_id UUID NOT NULL REFERENCES departments(id) ON DELETE CASCADE,
+    from_parent_id UUID REFERENCES departments(id) ON DELETE
+    SET
+        NULL,
+        to_parent_id UUID REFERENCES departments(id) ON DELETE
+    SET
+        NULL,
+        effective_from DATE NOT NULL,
+        changed_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_department_moves_department ON department_moves(department_id, effective_from, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS department_moves;
+
+DROP INDEX IF EXISTS uq_departments_cost_center;
+
+DROP INDEX IF EXISTS idx_departments_parent;
+
+ALTER TABLE
+    departments DROP COLUMN IF EXISTS cost_center,
+    DROP COLUMN IF EXISTS parent_id;
+
+-- +goose StatementEnd
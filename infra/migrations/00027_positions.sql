@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS positions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    department_id UUID NOT NULL REFERENCES departments(id) ON DELETE RESTRICT,
+    title TEXT NOT NULL,
+    grade VARCHAR(20),
+    salary_min NUMERIC(19, 4) NOT NULL,
+    salary_max NUMERIC(19, 4) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    fte NUMERIC(3, 2) NOT NULL CHECK (
+        fte > 0
+        AND fte <= 1
+    ),
+    status VARCHAR(20) NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'FILLED', 'FROZEN')),
+    employee_id UUID REFERENCES employees(id) ON DELETE
+    SET
+        NULL,
+        filled_at TIMESTAMPTZ,
+        created_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        CHECK (salary_min <= salary_max)
+);
+
+-- An employee fills one position at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_positions_employee ON positions(employee_id)
+WHERE
+    employee_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_positions_tenant_department ON positions(tenant_id, department_id, status);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS positions;
+
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- unaccent() is only STABLE because its dictionary can change; pinning
+-- the dictionary lets generated columns and indexes use it.
+CREATE
+OR REPLACE FUNCTION immutable_unaccent(text) RETURNS text LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS $$
+SELECT
+    public.unaccent('public.unaccent' :: regdictionary, $1) $$;
+
+-- Lower-cased text without diacritics, so "nguyen van" finds
+-- "Nguyễn Văn". Phones are also kept as bare digits.
+ALTER TABLE
+    employees
+ADD
+    COLUMN IF NOT EXISTS search_name TEXT GENERATED ALWAYS AS (
+        lower(immutable_unaccent(first_name || ' ' || last_name))
+    ) STORED,
+ADD
+    COLUMN IF NOT EXISTS search_text TEXT GENERATED ALWAYS AS (
+        lower(
+            immutable_unaccent(
+                first_name || ' ' || last_name || ' ' || last_name || ' ' || first_name || ' ' || email || ' ' || code || ' ' || coalesce(phone, '') || ' ' || regexp_replace(coalesce(phone, ''), '\D', '', 'g')
+            )
+        )
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_employees_search_text ON employees USING GIN (search_text gin_trgm_ops);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_employees_search_text;
+
+ALTER TABLE
+    employees DROP COLUMN IF EXISTS search_text,
+    DROP COLUMN IF EXISTS search_name;
+
+DROP FUNCTION IF EXISTS immutable_unaccent(text);
+
+-- +goose StatementEnd
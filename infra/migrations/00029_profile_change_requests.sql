@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE employees
+ADD COLUMN IF NOT EXISTS address TEXT;
+
+-- Changes employees propose to their own record. Contact details are
+-- applied at once and kept here for the audit; sensitive fields wait for
+-- HR. pending_enc holds the proposed sensitive values, encrypted, until
+-- the request is reviewed or cancelled.
+CREATE TABLE IF NOT EXISTS profile_change_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL CHECK (
+        status IN ('PENDING', 'APPLIED', 'REJECTED', 'CANCELLED')
+    ),
+    changes JSONB NOT NULL,
+    pending_enc BYTEA,
+    reason TEXT,
+    requested_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        reviewed_by UUID REFERENCES users(id) ON DELETE
+    SET
+        NULL,
+        reviewed_at TIMESTAMPTZ,
+        review_note TEXT,
+        applied_at TIMESTAMPTZ,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- An employee has one request waiting for HR at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS uq_profile_change_requests_pending ON profile_change_requests(employee_id)
+WHERE
+    status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_profile_change_requests_tenant ON profile_change_requests(tenant_id, status, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS profile_change_requests;
+
+ALTER TABLE employees DROP COLUMN IF EXISTS address;
+
+-- +goose StatementEnd
@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Dependents registered for the family allowance and insurance. A
+-- dependent counts for every month its registration overlaps.
+CREATE TABLE IF NOT EXISTS employee_dependents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    full_name TEXT NOT NULL,
+    relationship VARCHAR(20) NOT NULL CHECK (
+        relationship IN ('CHILD', 'SPOUSE', 'PARENT', 'OTHER')
+    ),
+    date_of_birth DATE NOT NULL,
+    tax_code VARCHAR(20),
+    registered_from DATE NOT NULL,
+    registered_until DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (
+        registered_until IS NULL
+        OR registered_until >= registered_from
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_dependents_employee ON employee_dependents(employee_id, registered_from);
+
+CREATE TABLE IF NOT EXISTS employee_emergency_contacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    full_name TEXT NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    email TEXT,
+    address TEXT,
+    priority SMALLINT NOT NULL DEFAULT 1 CHECK (priority BETWEEN 1 AND 9),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_employee_emergency_contacts_employee ON employee_emergency_contacts(employee_id, priority);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS employee_emergency_contacts;
+
+DROP TABLE IF EXISTS employee_dependents;
+
+-- +goose StatementEnd